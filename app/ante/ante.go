@@ -28,6 +28,9 @@ func NewAnteHandler(options HandlerOptions) sdk.AnteHandler {
 				case "/cosmos.evm.vm.v1.ExtensionOptionDynamicFeeTx":
 					// cosmos-sdk tx with dynamic fee extension
 					anteHandler = NewCosmosAnteHandler(options)
+				case "/cosmos.evm.types.v1.ExtensionOptionsWeb3Tx":
+					// cosmos-sdk tx signed in the legacy EIP-712 flow
+					anteHandler = newLegacyCosmosAnteHandlerEIP712(options)
 				default:
 					return ctx, errorsmod.Wrapf(
 						errortypes.ErrUnknownExtensionOptions,
@@ -10,6 +10,8 @@ import (
 
 	circuitante "cosmossdk.io/x/circuit/ante"
 	ibcante "github.com/cosmos/ibc-go/v8/modules/core/ante"
+
+	"github.com/rollchains/flora/app/decorators"
 )
 
 // newCosmosAnteHandler creates the default ante handler for Cosmos transactions
@@ -24,6 +26,9 @@ func NewCosmosAnteHandler(options HandlerOptions) sdk.AnteHandler {
 
 		ante.NewSetUpContextDecorator(),
 		circuitante.NewCircuitBreakerDecorator(options.CircuitKeeper),
+		decorators.NewDenylistDecorator(options.DenylistKeeper),
+		decorators.NewPOAStakingDecorator(options.PoaKeeper),
+		decorators.NewValidatorPolicyDecorator(options.ValidatorPolicyKeeper, options.StakingKeeper),
 		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		ante.NewValidateBasicDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
@@ -35,7 +40,40 @@ func NewCosmosAnteHandler(options HandlerOptions) sdk.AnteHandler {
 		ante.NewSetPubKeyDecorator(options.AccountKeeper),
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
 		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
-		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		decorators.NewSigVerificationDecorator(options.AccountKeeper, options.EIP1271Keeper, options.ContractAccountsKeeper, options.SignModeHandler),
+		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
+		ibcante.NewRedundantRelayDecorator(options.IBCKeeper),
+		evmante.NewGasWantedDecorator(options.EvmKeeper, options.FeeMarketKeeper),
+	)
+}
+
+// newLegacyCosmosAnteHandlerEIP712 creates the ante handler for Cosmos
+// transactions signed in the legacy EIP-712 flow, with the Web3Tx extension
+// option holding the signature of the typed data.
+func newLegacyCosmosAnteHandlerEIP712(options HandlerOptions) sdk.AnteHandler {
+	return sdk.ChainAnteDecorators(
+		evmoscosmosante.NewRejectMessagesDecorator(), // reject MsgEthereumTxs
+		evmoscosmosante.NewAuthzLimiterDecorator( // disable the Msg types that cannot be included on an authz.MsgExec msgs field
+			sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}),
+			sdk.MsgTypeURL(&sdkvesting.MsgCreateVestingAccount{}),
+		),
+
+		ante.NewSetUpContextDecorator(),
+		circuitante.NewCircuitBreakerDecorator(options.CircuitKeeper),
+		decorators.NewDenylistDecorator(options.DenylistKeeper),
+		decorators.NewPOAStakingDecorator(options.PoaKeeper),
+		decorators.NewValidatorPolicyDecorator(options.ValidatorPolicyKeeper, options.StakingKeeper),
+		ante.NewValidateBasicDecorator(),
+		ante.NewTxTimeoutHeightDecorator(),
+		ante.NewValidateMemoDecorator(options.AccountKeeper),
+		evmoscosmosante.NewMinGasPriceDecorator(options.FeeMarketKeeper, options.EvmKeeper),
+		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		// SetPubKeyDecorator must be called before all signature verification decorators
+		ante.NewSetPubKeyDecorator(options.AccountKeeper),
+		ante.NewValidateSigCountDecorator(options.AccountKeeper),
+		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
+		decorators.NewEIP712SigVerificationDecorator(options.AccountKeeper, options.Cdc),
 		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
 		ibcante.NewRedundantRelayDecorator(options.IBCKeeper),
 		evmante.NewGasWantedDecorator(options.EvmKeeper, options.FeeMarketKeeper),
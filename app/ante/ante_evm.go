@@ -1,18 +1,32 @@
 package ante
 
 import (
+	circuitante "cosmossdk.io/x/circuit/ante"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	evmante "github.com/cosmos/evm/ante/evm"
+
+	"github.com/rollchains/flora/app/decorators"
 )
 
 // newMonoEVMAnteHandler creates the sdk.AnteHandler implementation for the EVM transactions.
 func newMonoEVMAnteHandler(options HandlerOptions) sdk.AnteHandler {
+	var monoDecorator sdk.AnteDecorator = evmante.NewEVMMonoDecorator(
+		options.AccountKeeper,
+		options.FeeMarketKeeper,
+		options.EvmKeeper,
+		options.MaxTxGasWanted,
+	)
+	if options.Mempool != nil {
+		monoDecorator = decorators.NewNonceQueueDecorator(monoDecorator, options.AccountKeeper, options.Mempool)
+	}
+
 	return sdk.ChainAnteDecorators(
-		evmante.NewEVMMonoDecorator(
-			options.AccountKeeper,
-			options.FeeMarketKeeper,
-			options.EvmKeeper,
-			options.MaxTxGasWanted,
-		),
+		circuitante.NewCircuitBreakerDecorator(options.CircuitKeeper),
+		decorators.NewEVMCircuitDecorator(options.EVMCircuitKeeper),
+		decorators.NewDenylistDecorator(options.DenylistKeeper),
+		decorators.NewDeployPolicyDecorator(options.DeployPolicyKeeper),
+		decorators.NewValidatorPolicyDecorator(options.ValidatorPolicyKeeper, options.StakingKeeper),
+		monoDecorator,
 	)
 }
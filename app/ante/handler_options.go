@@ -16,6 +16,9 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
+
+	"github.com/rollchains/flora/app/decorators"
+	appmempool "github.com/rollchains/flora/app/mempool"
 )
 
 // BankKeeper defines the contract needed for supply related APIs (noalias)
@@ -48,12 +51,23 @@ type HandlerOptions struct {
 	SigGasConsumer         func(meter storetypes.GasMeter, sig signing.SignatureV2, params authtypes.Params) error
 	TxFeeChecker           ante.TxFeeChecker // safe to be nil
 
-	MaxTxGasWanted  uint64
-	FeeMarketKeeper anteinterfaces.FeeMarketKeeper
-	EvmKeeper       anteinterfaces.EVMKeeper
+	MaxTxGasWanted         uint64
+	FeeMarketKeeper        anteinterfaces.FeeMarketKeeper
+	EvmKeeper              anteinterfaces.EVMKeeper
+	EIP1271Keeper          decorators.EIP1271Keeper
+	ContractAccountsKeeper decorators.ContractAccountsKeeper
+
+	IBCKeeper          *ibckeeper.Keeper
+	CircuitKeeper      *circuitkeeper.Keeper
+	EVMCircuitKeeper   decorators.EVMCircuitKeeper
+	DeployPolicyKeeper decorators.DeployPolicyKeeper
+	DenylistKeeper     decorators.DenylistKeeper
+	PoaKeeper          decorators.POAKeeper
+
+	StakingKeeper         decorators.ValidatorKeeper
+	ValidatorPolicyKeeper decorators.ValidatorPolicyKeeper
 
-	IBCKeeper     *ibckeeper.Keeper
-	CircuitKeeper *circuitkeeper.Keeper
+	Mempool *appmempool.PriorityNonceMempool // safe to be nil
 }
 
 // Validate checks if the keepers are defined
@@ -76,6 +90,30 @@ func (options HandlerOptions) Validate() error {
 	if options.CircuitKeeper == nil {
 		return errorsmod.Wrap(errortypes.ErrLogic, "circuit keeper is required for ante builder")
 	}
+	if options.EVMCircuitKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "evm circuit keeper is required for ante builder")
+	}
+	if options.DeployPolicyKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "deploy policy keeper is required for ante builder")
+	}
+	if options.EIP1271Keeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "eip1271 keeper is required for ante builder")
+	}
+	if options.ContractAccountsKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "contract accounts keeper is required for ante builder")
+	}
+	if options.DenylistKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "denylist keeper is required for ante builder")
+	}
+	if options.PoaKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "poa keeper is required for ante builder")
+	}
+	if options.StakingKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "staking keeper is required for ante builder")
+	}
+	if options.ValidatorPolicyKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "validator policy keeper is required for ante builder")
+	}
 
 	if options.TxFeeChecker == nil {
 		return errorsmod.Wrap(errortypes.ErrLogic, "tx fee checker is required for AnteHandler")
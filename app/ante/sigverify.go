@@ -0,0 +1,65 @@
+package ante
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	evmante "github.com/cosmos/evm/ante"
+
+	"github.com/rollchains/flora/crypto/keys/eip1271"
+	"github.com/rollchains/flora/crypto/keys/passkey"
+)
+
+// SigVerificationGasConsumer consumes the gas of the passkey and contract
+// account signatures, including the ones of multisig members, and defers the
+// other keys to the EVM signature gas consumer.
+func SigVerificationGasConsumer(meter storetypes.GasMeter, sig signing.SignatureV2, params authtypes.Params) error {
+	switch pubkey := sig.PubKey.(type) {
+	case *passkey.PubKey:
+		meter.ConsumeGas(params.SigVerifyCostSecp256r1(), "ante verify: passkey")
+		return nil
+
+	case *eip1271.PubKey:
+		// the gas of the isValidSignature call is consumed on verification
+		return nil
+
+	case multisig.PubKey:
+		multisignature, ok := sig.Data.(*signing.MultiSignatureData)
+		if !ok {
+			return fmt.Errorf("expected %T, got, %T", &signing.MultiSignatureData{}, sig.Data)
+		}
+		return consumeMultisignatureVerificationGas(meter, multisignature, pubkey, params, sig.Sequence)
+
+	default:
+		return evmante.SigVerificationGasConsumer(meter, sig, params)
+	}
+}
+
+// consumeMultisignatureVerificationGas consumes the gas of the signatures of
+// the multisig members.
+func consumeMultisignatureVerificationGas(
+	meter storetypes.GasMeter, sig *signing.MultiSignatureData, pubkey multisig.PubKey,
+	params authtypes.Params, accSeq uint64,
+) error {
+	sigIndex := 0
+	for i := 0; i < sig.BitArray.Count(); i++ {
+		if !sig.BitArray.GetIndex(i) {
+			continue
+		}
+		sigV2 := signing.SignatureV2{
+			PubKey:   pubkey.GetPubKeys()[i],
+			Data:     sig.Signatures[sigIndex],
+			Sequence: accSeq,
+		}
+		if err := SigVerificationGasConsumer(meter, sigV2, params); err != nil {
+			return err
+		}
+		sigIndex++
+	}
+
+	return nil
+}
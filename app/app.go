@@ -6,9 +6,9 @@ import (
 	"io"
 	"math/big"
 	"os"
+	"slices"
 	"sort"
 	"strings"
-	"sync"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -39,6 +39,7 @@ import (
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
+	solomachine "github.com/cosmos/ibc-go/v8/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	"github.com/spf13/cast"
 
@@ -80,6 +81,7 @@ import (
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	signingtype "github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -140,9 +142,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 
-	evmosante "github.com/cosmos/evm/ante"
 	evmosevmante "github.com/cosmos/evm/ante/evm"
 	evmosencoding "github.com/cosmos/evm/encoding"
+	"github.com/cosmos/evm/ethereum/eip712"
 	srvflags "github.com/cosmos/evm/server/flags"
 	evmostypes "github.com/cosmos/evm/types"
 	evmosutils "github.com/cosmos/evm/utils"
@@ -158,6 +160,42 @@ import (
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 	chainante "github.com/rollchains/flora/app/ante"
+	appmempool "github.com/rollchains/flora/app/mempool"
+	"github.com/rollchains/flora/crypto/keys/eip1271"
+	"github.com/rollchains/flora/crypto/keys/passkey"
+	"github.com/rollchains/flora/x/contractaccounts"
+	contractaccountskeeper "github.com/rollchains/flora/x/contractaccounts/keeper"
+	contractaccountstypes "github.com/rollchains/flora/x/contractaccounts/types"
+	"github.com/rollchains/flora/x/cron"
+	cronkeeper "github.com/rollchains/flora/x/cron/keeper"
+	crontypes "github.com/rollchains/flora/x/cron/types"
+	"github.com/rollchains/flora/x/denylist"
+	denylistkeeper "github.com/rollchains/flora/x/denylist/keeper"
+	denylisttypes "github.com/rollchains/flora/x/denylist/types"
+	"github.com/rollchains/flora/x/deploypolicy"
+	deploypolicykeeper "github.com/rollchains/flora/x/deploypolicy/keeper"
+	deploypolicytypes "github.com/rollchains/flora/x/deploypolicy/types"
+	"github.com/rollchains/flora/x/evmcircuit"
+	evmcircuitkeeper "github.com/rollchains/flora/x/evmcircuit/keeper"
+	evmcircuittypes "github.com/rollchains/flora/x/evmcircuit/types"
+	"github.com/rollchains/flora/x/inflation"
+	inflationkeeper "github.com/rollchains/flora/x/inflation/keeper"
+	inflationtypes "github.com/rollchains/flora/x/inflation/types"
+	"github.com/rollchains/flora/x/lanes"
+	laneskeeper "github.com/rollchains/flora/x/lanes/keeper"
+	lanestypes "github.com/rollchains/flora/x/lanes/types"
+	"github.com/rollchains/flora/x/oracle"
+	oraclekeeper "github.com/rollchains/flora/x/oracle/keeper"
+	oracletypes "github.com/rollchains/flora/x/oracle/types"
+	"github.com/rollchains/flora/x/poa"
+	poakeeper "github.com/rollchains/flora/x/poa/keeper"
+	poatypes "github.com/rollchains/flora/x/poa/types"
+	"github.com/rollchains/flora/x/precisebank"
+	precisebankkeeper "github.com/rollchains/flora/x/precisebank/keeper"
+	precisebanktypes "github.com/rollchains/flora/x/precisebank/types"
+	"github.com/rollchains/flora/x/validatorpolicy"
+	validatorpolicykeeper "github.com/rollchains/flora/x/validatorpolicy/keeper"
+	validatorpolicytypes "github.com/rollchains/flora/x/validatorpolicy/types"
 )
 
 const (
@@ -179,7 +217,8 @@ var (
 )
 
 func init() {
-	// manually update the power reduction based on the base denom unit (10^18 [evm] or 10^6 [cosmos])
+	// manually update the power reduction based on the base denom unit, the
+	// decimals of the chain info override it in configureEVM
 	sdk.DefaultPowerReduction = math.NewIntFromBigInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(BaseDenomUnit), nil))
 }
 
@@ -195,7 +234,7 @@ var (
 	BaseDenomUnit int64 = 18
 
 	BaseDenom    = "petal"
-	DisplayDenom = "MY_DENOM_DISPLAY"
+	DisplayDenom = "flora"
 
 	// Bech32PrefixAccAddr defines the Bech32 prefix of an account's address
 	Bech32PrefixAccAddr = Bech32Prefix
@@ -228,6 +267,9 @@ var maccPerms = map[string][]string{
 	evmtypes.ModuleName:          {authtypes.Minter, authtypes.Burner},
 	feemarkettypes.ModuleName:    nil,
 	erc20types.ModuleName:        {authtypes.Minter, authtypes.Burner},
+	poatypes.ModuleName:          {authtypes.Minter},
+	crontypes.ModuleName:         nil,
+	precisebanktypes.ModuleName:  {authtypes.Minter, authtypes.Burner},
 }
 
 var (
@@ -248,6 +290,17 @@ type ChainApp struct {
 	tkeys   map[string]*storetypes.TransientStoreKey
 	memKeys map[string]*storetypes.MemoryStoreKey
 
+	mempool *appmempool.PriorityNonceMempool
+
+	oracleConfig oracle.Config
+
+	// genesisDir is the directory of the genesis file, the directory of a
+	// streamed genesis is relative to it
+	genesisDir string
+
+	// VoteExtensionHandler reports the values of the local oracle provider
+	VoteExtensionHandler *oracle.VoteExtensionHandler
+
 	// keepers
 	AccountKeeper         authkeeper.AccountKeeper
 	BankKeeper            bankkeeper.BaseKeeper
@@ -279,6 +332,18 @@ type ChainApp struct {
 	FeeMarketKeeper    feemarketkeeper.Keeper
 	EVMKeeper          *evmkeeper.Keeper
 	Erc20Keeper        erc20keeper.Keeper
+	PoaKeeper          poakeeper.Keeper
+
+	ValidatorPolicyKeeper  validatorpolicykeeper.Keeper
+	CronKeeper             cronkeeper.Keeper
+	InflationKeeper        inflationkeeper.Keeper
+	LanesKeeper            laneskeeper.Keeper
+	OracleKeeper           oraclekeeper.Keeper
+	EVMCircuitKeeper       evmcircuitkeeper.Keeper
+	DeployPolicyKeeper     deploypolicykeeper.Keeper
+	DenylistKeeper         denylistkeeper.Keeper
+	ContractAccountsKeeper contractaccountskeeper.Keeper
+	PreciseBankKeeper      precisebankkeeper.Keeper
 
 	ScopedIBCKeeper           capabilitykeeper.ScopedKeeper
 	ScopedICAHostKeeper       capabilitykeeper.ScopedKeeper
@@ -295,7 +360,6 @@ type ChainApp struct {
 
 	// module configurator
 	configurator module.Configurator
-	once         sync.Once
 }
 
 // NewChainApp returns a reference to an initialized ChainApp.
@@ -315,38 +379,12 @@ func NewChainApp(
 	legacyAmino := encodingConfig.Amino
 	txConfig := encodingConfig.TxConfig
 
-	// Below we could construct and set an application specific mempool and
-	// ABCI 1.0 PrepareProposal and ProcessProposal handlers. These defaults are
-	// already set in the SDK's BaseApp, this shows an example of how to override
-	// them.
-	//
-	// Example:
-	//
-	// bApp := baseapp.NewBaseApp(...)
-	// nonceMempool := mempool.NewSenderNonceMempool()
-	// abciPropHandler := NewDefaultProposalHandler(nonceMempool, bApp)
-	//
-	// bApp.SetMempool(nonceMempool)
-	// bApp.SetPrepareProposal(abciPropHandler.PrepareProposalHandler())
-	// bApp.SetProcessProposal(abciPropHandler.ProcessProposalHandler())
-	//
-	// Alternatively, you can construct BaseApp options, append those to
-	// baseAppOptions and pass them to NewBaseApp.
-	//
-	// Example:
-	//
-	// prepareOpt = func(app *baseapp.BaseApp) {
-	// 	abciPropHandler := baseapp.NewDefaultProposalHandler(nonceMempool, app)
-	// 	app.SetPrepareProposal(abciPropHandler.PrepareProposalHandler())
-	// }
-	// baseAppOptions = append(baseAppOptions, prepareOpt)
-
-	// create and set dummy vote extension handler
-	// voteExtOp := func(bApp *baseapp.BaseApp) {
-	//	voteExtHandler := NewVoteExtensionHandler()
-	//	voteExtHandler.SetHandlers(bApp)
-	// }
-	// baseAppOptions = append(baseAppOptions, voteExtOp)
+	// passkeys sign the Cosmos txs with WebAuthn assertions and contract
+	// accounts through EIP-1271
+	passkey.RegisterInterfaces(interfaceRegistry)
+	passkey.RegisterLegacyAminoCodec(legacyAmino)
+	eip1271.RegisterInterfaces(interfaceRegistry)
+	eip1271.RegisterLegacyAminoCodec(legacyAmino)
 
 	bApp := baseapp.NewBaseApp(appName, logger, db, txConfig.TxDecoder(), baseAppOptions...)
 	bApp.SetCommitMultiStoreTracer(traceStore)
@@ -388,6 +426,17 @@ func NewChainApp(
 		evmtypes.StoreKey,
 		feemarkettypes.StoreKey,
 		erc20types.StoreKey,
+		poatypes.StoreKey,
+		validatorpolicytypes.StoreKey,
+		crontypes.StoreKey,
+		inflationtypes.StoreKey,
+		lanestypes.StoreKey,
+		oracletypes.StoreKey,
+		evmcircuittypes.StoreKey,
+		deploypolicytypes.StoreKey,
+		denylisttypes.StoreKey,
+		contractaccountstypes.StoreKey,
+		precisebanktypes.StoreKey,
 	)
 
 	tkeys := storetypes.NewTransientStoreKeys(
@@ -411,6 +460,7 @@ func NewChainApp(
 		keys:              keys,
 		tkeys:             tkeys,
 		memKeys:           memKeys,
+		genesisDir:        genesisFileDir(appOpts),
 	}
 
 	app.ParamsKeeper = initParamsKeeper(
@@ -534,6 +584,23 @@ func NewChainApp(
 	)
 	app.BaseApp.SetCircuitBreaker(&app.CircuitKeeper)
 
+	app.EVMCircuitKeeper = evmcircuitkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[evmcircuittypes.StoreKey]),
+		&app.CircuitKeeper,
+	)
+
+	// the EVM, ERC-20 and fee deduction see the balances of the extended denom
+	// through x/precisebank when the denom of the chain has 6 decimals
+	app.PreciseBankKeeper = precisebankkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[precisebanktypes.StoreKey]),
+		app.BankKeeper,
+		app.AccountKeeper,
+		configuredChainInfo.Denom,
+		preciseBankExtendedDenom(configuredChainInfo),
+	)
+
 	app.AuthzKeeper = authzkeeper.NewKeeper(
 		runtime.NewKVStoreService(keys[authzkeeper.StoreKey]),
 		appCodec,
@@ -653,10 +720,14 @@ func NewChainApp(
 		tkeys[evmtypes.TransientKey],
 		authtypes.NewModuleAddress(govtypes.ModuleName),
 		app.AccountKeeper,
-		app.BankKeeper,
+		app.PreciseBankKeeper,
 		app.StakingKeeper,
 		app.FeeMarketKeeper,
-		&app.Erc20Keeper,
+		app.EVMCircuitKeeper.CallHook(dynamicPrecompiles{
+			erc20Keeper:    &app.Erc20Keeper,
+			oracleKeeper:   &app.OracleKeeper,
+			denylistKeeper: &app.DenylistKeeper,
+		}),
 		tracer, app.GetSubspace(evmtypes.ModuleName),
 	)
 
@@ -665,13 +736,41 @@ func NewChainApp(
 		appCodec,
 		authtypes.NewModuleAddress(govtypes.ModuleName),
 		app.AccountKeeper,
-		app.BankKeeper,
+		app.PreciseBankKeeper,
 		app.EVMKeeper,
 		app.StakingKeeper,
 		app.AuthzKeeper,
 		&app.TransferKeeper,
 	)
 
+	app.CronKeeper = cronkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[crontypes.StoreKey]),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.FeeGrantKeeper,
+		app.DistrKeeper,
+		app.EVMKeeper,
+		app.FeeMarketKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	app.DeployPolicyKeeper = deploypolicykeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[deploypolicytypes.StoreKey]),
+		app.EVMKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	app.DenylistKeeper = denylistkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[denylisttypes.StoreKey]),
+		app.AccountKeeper,
+		app.EVMKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	app.BankKeeper.AppendSendRestriction(app.DenylistKeeper.SendRestriction)
+
 	// NOTE: we are adding all available EVM extensions.
 	// Not all of them need to be enabled, which can be configured on a per-chain basis.
 	corePrecompiles := NewAvailableStaticPrecompiles(
@@ -686,6 +785,9 @@ func NewChainApp(
 		app.GovKeeper,
 		app.SlashingKeeper,
 		app.EvidenceKeeper,
+		app.CronKeeper,
+		app.EVMCircuitKeeper,
+		&app.PoaKeeper, // set below
 	)
 	app.EVMKeeper.WithStaticPrecompiles(
 		corePrecompiles,
@@ -710,6 +812,52 @@ func NewChainApp(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	app.PoaKeeper = poakeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[poatypes.StoreKey]),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.StakingKeeper,
+		stakingkeeper.NewMsgServerImpl(app.StakingKeeper),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	app.ValidatorPolicyKeeper = validatorpolicykeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[validatorpolicytypes.StoreKey]),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	app.ContractAccountsKeeper = contractaccountskeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[contractaccountstypes.StoreKey]),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	app.InflationKeeper = inflationkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[inflationtypes.StoreKey]),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.DistrKeeper,
+		app.MintKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	app.LanesKeeper = laneskeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[lanestypes.StoreKey]),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	app.OracleKeeper = oraclekeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[oracletypes.StoreKey]),
+		app.SlashingKeeper,
+		app.StakingKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	// IBC Fee Module keeper
 	app.IBCFeeKeeper = ibcfeekeeper.NewKeeper(
 		appCodec, keys[ibcfeetypes.StoreKey],
@@ -723,7 +871,7 @@ func NewChainApp(
 		appCodec,
 		keys[ibctransfertypes.StoreKey],
 		app.GetSubspace(ibctransfertypes.ModuleName),
-		app.IBCFeeKeeper,
+		denylist.NewICS4Wrapper(app.IBCFeeKeeper, app.DenylistKeeper),
 		app.IBCKeeper.ChannelKeeper,
 		app.IBCKeeper.PortKeeper,
 		app.AccountKeeper,
@@ -767,6 +915,7 @@ func NewChainApp(
 	// Create Transfer Stack
 	var transferStack porttypes.IBCModule
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
+	transferStack = denylist.NewIBCMiddleware(transferStack, app.DenylistKeeper)
 	transferStack = ibcfee.NewIBCMiddleware(transferStack, app.IBCFeeKeeper)
 
 	// Create Interchain Accounts Stack
@@ -812,7 +961,7 @@ func NewChainApp(
 		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper, app.GetSubspace(banktypes.ModuleName)),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		gov.NewAppModule(appCodec, &app.GovKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(govtypes.ModuleName)),
-		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper, nil, app.GetSubspace(minttypes.ModuleName)),
+		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper, app.InflationKeeper.InflationCalculationFn, app.GetSubspace(minttypes.ModuleName)),
 		slashing.NewAppModule(
 			appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper,
 			app.StakingKeeper,
@@ -834,12 +983,24 @@ func NewChainApp(
 		ibcfee.NewAppModule(app.IBCFeeKeeper),
 		ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper),
 		ibctm.NewAppModule(),
+		solomachine.NewAppModule(),
 		crisis.NewAppModule(app.CrisisKeeper, skipGenesisInvariants, app.GetSubspace(crisistypes.ModuleName)),
 		// custom
 		tokenfactory.NewAppModule(app.TokenFactoryKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(tokenfactorytypes.ModuleName)),
 		cosmosevmvm.NewAppModule(app.EVMKeeper, app.AccountKeeper, app.GetSubspace(evmtypes.ModuleName)),
 		feemarket.NewAppModule(app.FeeMarketKeeper, app.GetSubspace(feemarkettypes.ModuleName)),
 		erc20.NewAppModule(app.Erc20Keeper, app.AccountKeeper, app.GetSubspace(erc20types.ModuleName)),
+		poa.NewAppModule(appCodec, app.PoaKeeper),
+		validatorpolicy.NewAppModule(appCodec, app.ValidatorPolicyKeeper),
+		cron.NewAppModule(appCodec, app.CronKeeper),
+		inflation.NewAppModule(appCodec, app.InflationKeeper),
+		lanes.NewAppModule(appCodec, app.LanesKeeper),
+		oracle.NewAppModule(appCodec, app.OracleKeeper),
+		evmcircuit.NewAppModule(appCodec, app.EVMCircuitKeeper),
+		deploypolicy.NewAppModule(appCodec, app.DeployPolicyKeeper),
+		denylist.NewAppModule(appCodec, app.DenylistKeeper),
+		contractaccounts.NewAppModule(appCodec, app.ContractAccountsKeeper),
+		precisebank.NewAppModule(appCodec, app.PreciseBankKeeper),
 	)
 
 	// BasicModuleManager defines the module BasicManager is in charge of setting up basic,
@@ -854,6 +1015,10 @@ func NewChainApp(
 	app.BasicModuleManager.RegisterLegacyAminoCodec(legacyAmino)
 	app.BasicModuleManager.RegisterInterfaces(interfaceRegistry)
 
+	// the ethsecp256k1 keys verify the EIP-712 signatures against the typed
+	// data of the decoded sign docs
+	eip712.SetEncodingConfig(legacyAmino, interfaceRegistry)
+
 	// NOTE: upgrade module is required to be prioritized
 	app.ModuleManager.SetOrderPreBlockers(
 		upgradetypes.ModuleName,
@@ -865,6 +1030,7 @@ func NewChainApp(
 	// NOTE: capability module's beginblocker must come before any modules using capabilities (e.g. IBC)
 	app.ModuleManager.SetOrderBeginBlockers(
 		minttypes.ModuleName,
+		inflationtypes.ModuleName, // NOTE: must come after mint and before distribution
 		erc20types.ModuleName,
 		feemarkettypes.ModuleName,
 		evmtypes.ModuleName, // NOTE: EVM BeginBlocker must come after FeeMarket BeginBlocker
@@ -892,6 +1058,7 @@ func NewChainApp(
 		feegrant.ModuleName,
 		group.ModuleName,
 		// additional non simd modules
+		crontypes.ModuleName,
 		evmtypes.ModuleName, erc20types.ModuleName, feemarkettypes.ModuleName,
 		capabilitytypes.ModuleName,
 		ibctransfertypes.ModuleName,
@@ -913,6 +1080,11 @@ func NewChainApp(
 		capabilitytypes.ModuleName,
 		// simd modules
 		authtypes.ModuleName,
+		// NOTE: tokenfactory sets a default metadata for its denoms, it must
+		// be initialized before bank so the genesis denom metadata is kept.
+		// The export order doesn't change the exported state, which imports
+		// back as is
+		tokenfactorytypes.ModuleName,
 		banktypes.ModuleName,
 		distrtypes.ModuleName,
 		stakingtypes.ModuleName,
@@ -942,7 +1114,19 @@ func NewChainApp(
 		ibcexported.ModuleName,
 		icatypes.ModuleName,
 		ibcfeetypes.ModuleName,
-		tokenfactorytypes.ModuleName,
+		poatypes.ModuleName,
+		validatorpolicytypes.ModuleName,
+		crontypes.ModuleName,
+		inflationtypes.ModuleName,
+		lanestypes.ModuleName,
+		oracletypes.ModuleName,
+		evmcircuittypes.ModuleName,
+		deploypolicytypes.ModuleName,
+		denylisttypes.ModuleName,
+		contractaccountstypes.ModuleName,
+		// NOTE: precisebank checks the reserve balance, so it must be
+		// initialized after bank
+		precisebanktypes.ModuleName,
 	}
 	app.ModuleManager.SetOrderInitGenesis(genesisModuleOrder...)
 	app.ModuleManager.SetOrderExportGenesis(genesisModuleOrder...)
@@ -994,21 +1178,35 @@ func NewChainApp(
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
 
+	app.setMempool(appmempool.ConfigFromAppOptions(appOpts))
+	app.setProposalHandler()
+	app.setVoteExtensionHandler(oracle.ConfigFromAppOptions(appOpts))
+
 	app.setAnteHandler(chainante.HandlerOptions{
 		Cdc:             app.appCodec,
 		AccountKeeper:   app.AccountKeeper,
-		BankKeeper:      app.BankKeeper,
+		BankKeeper:      app.PreciseBankKeeper,
 		FeegrantKeeper:  app.FeeGrantKeeper,
 		FeeMarketKeeper: app.FeeMarketKeeper,
 		SignModeHandler: txConfig.SignModeHandler(),
 		IBCKeeper:       app.IBCKeeper,
 		CircuitKeeper:   &app.CircuitKeeper,
+		PoaKeeper:       app.PoaKeeper,
+
+		StakingKeeper:         app.StakingKeeper,
+		ValidatorPolicyKeeper: app.ValidatorPolicyKeeper,
 
 		EvmKeeper:              app.EVMKeeper,
+		EIP1271Keeper:          app.EVMKeeper,
+		ContractAccountsKeeper: app.ContractAccountsKeeper,
+		EVMCircuitKeeper:       app.EVMCircuitKeeper,
+		DeployPolicyKeeper:     app.DeployPolicyKeeper,
+		DenylistKeeper:         app.DenylistKeeper,
 		ExtensionOptionChecker: evmostypes.HasDynamicFeeExtensionOption,
-		SigGasConsumer:         evmosante.SigVerificationGasConsumer,
+		SigGasConsumer:         chainante.SigVerificationGasConsumer,
 		MaxTxGasWanted:         cast.ToUint64(appOpts.Get(srvflags.EVMMaxTxGasWanted)),
 		TxFeeChecker:           evmosevmante.NewDynamicFeeChecker(app.FeeMarketKeeper),
+		Mempool:                app.mempool,
 	})
 
 	// must be before Loading version
@@ -1059,33 +1257,23 @@ func NewChainApp(
 			panic(fmt.Errorf("error loading last version: %w", err))
 		}
 
-		ctx := app.BaseApp.NewUncachedContext(true, tmproto.Header{})
-		_ = ctx
-
-	}
-
-	return app
-}
-
-func (app *ChainApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
-	// when skipping sdk 47 for sdk 50, the upgrade handler is called too late in BaseApp
-	// this is a hack to ensure that the migration is executed when needed and not panics
-	app.once.Do(func() {
-		ctx := app.NewUncachedContext(false, tmproto.Header{})
-		if _, err := app.ConsensusParamsKeeper.Params(ctx, &consensusparamtypes.QueryParamsRequest{}); err != nil {
-			// prevents panic: consensus key is nil: collections: not found: key 'no_key' of type github.com/cosmos/gogoproto/tendermint.types.ConsensusParams
-			// sdk 47:
-			// Migrate Tendermint consensus parameters from x/params module to a dedicated x/consensus module.
-			// see https://github.com/cosmos/cosmos-sdk/blob/v0.47.0/simapp/upgrades.go#L66
-			baseAppLegacySS := app.GetSubspace(baseapp.Paramspace)
-			err := baseapp.MigrateParams(sdk.UnwrapSDKContext(ctx), baseAppLegacySS, app.ConsensusParamsKeeper.ParamsStore)
-			if err != nil {
+		// the chain info is checked once the state exists, the genesis one is
+		// checked by InitChainer
+		if app.LastBlockHeight() > 0 {
+			ctx := app.BaseApp.NewUncachedContext(true, tmproto.Header{})
+			if err := app.checkConsensusParams(ctx); err != nil {
+				panic(err)
+			}
+			if err := app.validateChainInfo(ctx); err != nil {
+				panic(fmt.Errorf("invalid chain info: %w", err))
+			}
+			if err := app.checkOracleProvider(ctx); err != nil {
 				panic(err)
 			}
 		}
-	})
+	}
 
-	return app.BaseApp.FinalizeBlock(req)
+	return app
 }
 
 func (app *ChainApp) setAnteHandler(options chainante.HandlerOptions) {
@@ -1096,6 +1284,53 @@ func (app *ChainApp) setAnteHandler(options chainante.HandlerOptions) {
 	app.SetAnteHandler(chainante.NewAnteHandler(options))
 }
 
+// setMempool replaces the no-op mempool with the priority nonce mempool,
+// unless it is disabled in app.toml.
+func (app *ChainApp) setMempool(cfg appmempool.Config) {
+	if !cfg.Enabled() {
+		return
+	}
+	if err := cfg.Validate(); err != nil {
+		panic(err)
+	}
+
+	app.mempool = appmempool.NewPriorityNonceMempool(cfg, app.AccountKeeper, app.txConfig.TxEncoder())
+	app.SetMempool(app.mempool)
+}
+
+// setProposalHandler lays out the proposals in lanes with a reserved share
+// of the block gas.
+func (app *ChainApp) setProposalHandler() {
+	var mp sdkmempool.Mempool = sdkmempool.NoOpMempool{}
+	if app.mempool != nil {
+		mp = app.mempool
+	}
+
+	lanesHandler := lanes.NewProposalHandler(app.LanesKeeper, mp, app.BaseApp)
+	proposalHandler := oracle.NewProposalHandler(
+		app.OracleKeeper,
+		app.StakingKeeper,
+		lanesHandler.PrepareProposalHandler(),
+		lanesHandler.ProcessProposalHandler(),
+	)
+	app.SetPrepareProposal(proposalHandler.PrepareProposalHandler())
+	app.SetProcessProposal(proposalHandler.ProcessProposalHandler())
+}
+
+// setVoteExtensionHandler reports the values of the oracle provider set in
+// app.toml in the vote extensions.
+func (app *ChainApp) setVoteExtensionHandler(cfg oracle.Config) {
+	provider, err := cfg.NewProvider()
+	if err != nil {
+		panic(err)
+	}
+
+	app.oracleConfig = cfg
+	app.VoteExtensionHandler = oracle.NewVoteExtensionHandler(app.Logger(), provider)
+	app.SetExtendVoteHandler(app.VoteExtensionHandler.ExtendVoteHandler())
+	app.SetVerifyVoteExtensionHandler(app.VoteExtensionHandler.VerifyVoteExtensionHandler())
+}
+
 func (app *ChainApp) setPostHandler() {
 	postHandler, err := posthandler.NewPostHandler(
 		posthandler.HandlerOptions{},
@@ -1111,8 +1346,17 @@ func (app *ChainApp) setPostHandler() {
 func (app *ChainApp) Name() string { return app.BaseApp.Name() }
 
 // PreBlocker application updates every pre block
-func (app *ChainApp) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
-	return app.ModuleManager.PreBlock(ctx)
+func (app *ChainApp) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+	res, err := app.ModuleManager.PreBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := app.OracleKeeper.PreBlocker(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return res, nil
 }
 
 // BeginBlocker application updates every begin block
@@ -1140,8 +1384,61 @@ func (app *ChainApp) InitChainer(ctx sdk.Context, req *abci.RequestInitChain) (*
 	if err != nil {
 		panic(err)
 	}
-	response, err := app.ModuleManager.InitGenesis(ctx, app.appCodec, genesisState)
-	return response, err
+	var response *abci.ResponseInitChain
+	if stream, ok := genesisState[StreamedGenesisKey]; ok {
+		response, err = app.initStreamedGenesis(ctx, stream)
+	} else {
+		response, err = app.ModuleManager.InitGenesis(ctx, app.appCodec, genesisState)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := app.validateChainInfo(ctx); err != nil {
+		return nil, fmt.Errorf("invalid chain info: %w", err)
+	}
+	if err := app.checkOracleProvider(ctx); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// validateChainInfo checks the chain info the EVM is configured with against
+// the state: the EVM denom, when set, and the bank metadata of the denom, when
+// registered. The chains predating the chain info have no metadata for their
+// denom, the chain info is the only source of its display denom and decimals.
+func (app *ChainApp) validateChainInfo(ctx sdk.Context) error {
+	if !sealed {
+		return nil
+	}
+	info := configuredChainInfo
+
+	if denom := app.EVMKeeper.GetParams(ctx).EvmDenom; denom != "" && denom != info.Denom {
+		return fmt.Errorf("evm denom %s doesn't match the denom %s of the chain info", denom, info.Denom)
+	}
+
+	metadata, found := app.BankKeeper.GetDenomMetaData(ctx, info.Denom)
+	if !found {
+		return nil
+	}
+	return info.ValidateMetadata(metadata)
+}
+
+// checkOracleProvider refuses to run a chain with oracle pairs on a node
+// without an oracle provider in app.toml, as a validator without one misses
+// all its reports. The nodes which don't validate opt out with "none".
+func (app *ChainApp) checkOracleProvider(ctx sdk.Context) error {
+	if app.oracleConfig.IsSet() {
+		return nil
+	}
+
+	params, err := app.OracleKeeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if len(params.Pairs) > 0 {
+		return fmt.Errorf("the chain has %d oracle pairs but no oracle provider is set, set %s in app.toml (%q on the nodes which don't validate)", len(params.Pairs), oracle.FlagProvider, oracle.ProviderNone)
+	}
+	return nil
 }
 
 // LoadHeight loads a particular height
@@ -1204,8 +1501,18 @@ func (a *ChainApp) DefaultGenesis() map[string]json.RawMessage {
 	mintGenState.Params.MintDenom = BaseDenom
 	genesis[minttypes.ModuleName] = a.appCodec.MustMarshalJSON(mintGenState)
 
+	// the display denom and decimals of the chain info are loaded from the
+	// bank metadata of the genesis
+	info := ChainsCoinInfo[ChainID]
+	if sealed {
+		info = configuredChainInfo
+	}
+	bankGenState := banktypes.DefaultGenesisState()
+	bankGenState.DenomMetadata = []banktypes.Metadata{info.Metadata()}
+	genesis[banktypes.ModuleName] = a.appCodec.MustMarshalJSON(bankGenState)
+
 	evmGenState := evmtypes.DefaultGenesisState()
-	evmGenState.Params.ActiveStaticPrecompiles = evmtypes.AvailableStaticPrecompiles
+	evmGenState.Params.ActiveStaticPrecompiles = AvailableStaticPrecompiles
 	genesis[evmtypes.ModuleName] = a.appCodec.MustMarshalJSON(evmGenState)
 
 	// NOTE: for the example chain implementation we are also adding a default token pair,
@@ -1329,7 +1636,7 @@ func BlockedAddresses() map[string]bool {
 	// allow the following addresses to receive funds
 	delete(blockedAddrs, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
-	blockedPrecompilesHex := evmtypes.AvailableStaticPrecompiles
+	blockedPrecompilesHex := slices.Clone(AvailableStaticPrecompiles)
 	for _, addr := range vm.PrecompiledAddressesBerlin {
 		blockedPrecompilesHex = append(blockedPrecompilesHex, addr.Hex())
 	}
@@ -1344,8 +1651,9 @@ func BlockedAddresses() map[string]bool {
 func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino, key, tkey storetypes.StoreKey) paramskeeper.Keeper {
 	paramsKeeper := paramskeeper.NewKeeper(appCodec, legacyAmino, key, tkey)
 
-	// required for testing finalized block migration
-	paramsKeeper.Subspace(baseapp.Paramspace)
+	// the consensus params of the states predating SDK v0.47, migrated to
+	// x/consensus by the v2 upgrade
+	paramsKeeper.Subspace(baseapp.Paramspace).WithKeyTable(paramstypes.ConsensusParamsKeyTable())
 
 	paramsKeeper.Subspace(authtypes.ModuleName)
 	paramsKeeper.Subspace(banktypes.ModuleName)
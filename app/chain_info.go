@@ -0,0 +1,297 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	evmostypes "github.com/cosmos/evm/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// ChainInfoFile is the file of the config directory of the node home holding
+// the chain info, taking precedence over the genesis.
+const ChainInfoFile = "chain.json"
+
+// ChainInfo is the identity and coin info of a chain, configured once at
+// startup.
+type ChainInfo struct {
+	// ChainID is the Cosmos chain id.
+	ChainID string `json:"chain_id"`
+	// EVMChainID is the EIP-155 chain id. It is parsed from chain ids in the
+	// <name>_<evm chain id>-<epoch> format when not set.
+	EVMChainID uint64 `json:"evm_chain_id,omitempty"`
+	// Denom is the base denom of the coin used in the EVM.
+	Denom string `json:"denom"`
+	// DisplayDenom is the display denom of the coin.
+	DisplayDenom string `json:"display_denom"`
+	// Decimals is the exponent of the display denom, either 6 or 18.
+	Decimals uint8 `json:"decimals"`
+	// ExtendedDenom is the 18 decimals denom of the EVM when the denom has 6
+	// decimals, kept by x/precisebank. It is the denom with its "u" prefix
+	// replaced by "a" when not set.
+	ExtendedDenom string `json:"extended_denom,omitempty"`
+}
+
+// EVMDenom returns the denom of the EVM: the denom when it has 18 decimals,
+// the extended denom otherwise.
+func (ci ChainInfo) EVMDenom() string {
+	if evmtypes.Decimals(ci.Decimals) == evmtypes.EighteenDecimals {
+		return ci.Denom
+	}
+	if ci.ExtendedDenom != "" {
+		return ci.ExtendedDenom
+	}
+	if strings.HasPrefix(ci.Denom, "u") {
+		return "a" + strings.TrimPrefix(ci.Denom, "u")
+	}
+	return ""
+}
+
+// CoinInfo returns the EVM coin info of the chain, always with 18 decimals
+// as x/precisebank extends the denoms of 6 decimals.
+func (ci ChainInfo) CoinInfo() evmtypes.EvmCoinInfo {
+	return evmtypes.EvmCoinInfo{
+		Denom:        ci.EVMDenom(),
+		DisplayDenom: ci.DisplayDenom,
+		Decimals:     evmtypes.EighteenDecimals,
+	}
+}
+
+// Validate checks the chain info is complete.
+func (ci ChainInfo) Validate() error {
+	if ci.ChainID == "" {
+		return errors.New("chain id cannot be empty")
+	}
+	if ci.EVMChainID == 0 {
+		return fmt.Errorf("evm chain id of %s cannot be zero", ci.ChainID)
+	}
+	if err := sdk.ValidateDenom(ci.Denom); err != nil {
+		return fmt.Errorf("invalid denom: %w", err)
+	}
+	if err := sdk.ValidateDenom(ci.DisplayDenom); err != nil {
+		return fmt.Errorf("invalid display denom: %w", err)
+	}
+	if ci.Denom == ci.DisplayDenom {
+		return fmt.Errorf("display denom must differ from the denom %s", ci.Denom)
+	}
+	if err := evmtypes.Decimals(ci.Decimals).Validate(); err != nil {
+		return err
+	}
+
+	if evmtypes.Decimals(ci.Decimals) == evmtypes.EighteenDecimals {
+		if ci.ExtendedDenom != "" {
+			return fmt.Errorf("extended denom %s can only be set for denoms of 6 decimals", ci.ExtendedDenom)
+		}
+		return nil
+	}
+	extendedDenom := ci.EVMDenom()
+	if extendedDenom == "" {
+		return fmt.Errorf("extended denom of %s must be set, as it can't be derived from a denom without the \"u\" prefix", ci.Denom)
+	}
+	if err := sdk.ValidateDenom(extendedDenom); err != nil {
+		return fmt.Errorf("invalid extended denom: %w", err)
+	}
+	if extendedDenom == ci.Denom || extendedDenom == ci.DisplayDenom {
+		return fmt.Errorf("extended denom %s must differ from the denom and the display denom", extendedDenom)
+	}
+	return nil
+}
+
+// ValidateMetadata checks the chain info matches the bank metadata of its
+// denom.
+func (ci ChainInfo) ValidateMetadata(metadata banktypes.Metadata) error {
+	if metadata.Base != ci.Denom {
+		return fmt.Errorf("metadata of %s is not for the denom %s", metadata.Base, ci.Denom)
+	}
+	if metadata.Display != ci.DisplayDenom {
+		return fmt.Errorf("display denom %s doesn't match the display %s of the metadata of %s", ci.DisplayDenom, metadata.Display, ci.Denom)
+	}
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom != ci.DisplayDenom {
+			continue
+		}
+		if unit.Exponent != uint32(ci.Decimals) {
+			return fmt.Errorf("decimals %d don't match the exponent %d of %s in the metadata of %s", ci.Decimals, unit.Exponent, unit.Denom, ci.Denom)
+		}
+		return nil
+	}
+	return fmt.Errorf("metadata of %s has no unit for the display denom %s", ci.Denom, ci.DisplayDenom)
+}
+
+// Metadata returns the bank metadata of the denom of the chain info.
+func (ci ChainInfo) Metadata() banktypes.Metadata {
+	return banktypes.Metadata{
+		Description: "The native staking and gas token of the chain.",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: ci.Denom, Exponent: 0},
+			{Denom: ci.DisplayDenom, Exponent: uint32(ci.Decimals)},
+		},
+		Base:    ci.Denom,
+		Display: ci.DisplayDenom,
+		Name:    ci.DisplayDenom,
+		Symbol:  strings.ToUpper(ci.DisplayDenom),
+	}
+}
+
+// withEVMChainID returns the chain info with the EVM chain id parsed from the
+// chain id when not set.
+func (ci ChainInfo) withEVMChainID() (ChainInfo, error) {
+	if ci.EVMChainID != 0 {
+		return ci, nil
+	}
+	id, err := evmostypes.ParseChainID(ci.ChainID)
+	if err != nil {
+		return ci, fmt.Errorf("evm chain id of %s must be set, as it can't be parsed from the chain id: %w", ci.ChainID, err)
+	}
+	ci.EVMChainID = id.Uint64()
+	return ci, nil
+}
+
+// LoadChainInfo loads the info of the chain from the node home, from the
+// first of:
+//   - the chain info file in the config directory,
+//   - the genesis file, with the EVM denom and its bank metadata,
+//   - ChainsCoinInfo.
+//
+// The chain id is the one of the genesis when empty.
+func LoadChainInfo(homeDir, chainID string) (ChainInfo, error) {
+	configDir := filepath.Join(homeDir, "config")
+
+	info, found, err := chainInfoFromFile(filepath.Join(configDir, ChainInfoFile))
+	if err != nil {
+		return ChainInfo{}, err
+	}
+	if found {
+		if chainID != "" && info.ChainID != chainID {
+			return ChainInfo{}, fmt.Errorf("chain id %s of %s doesn't match the chain id %s", info.ChainID, ChainInfoFile, chainID)
+		}
+		return info.withEVMChainID()
+	}
+
+	info, found, err = chainInfoFromGenesis(filepath.Join(configDir, "genesis.json"), chainID)
+	if err != nil {
+		return ChainInfo{}, err
+	}
+	if found {
+		return info.withEVMChainID()
+	}
+
+	if chainID == "" {
+		chainID = ChainID
+	}
+	return registeredChainInfo(chainID)
+}
+
+// WriteChainInfo writes the chain info file of the node home, with the EVM
+// chain id parsed from the chain id when not set.
+func WriteChainInfo(homeDir string, info ChainInfo) error {
+	info, err := info.withEVMChainID()
+	if err != nil {
+		return err
+	}
+	if err := info.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := json.MarshalIndent(info, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(homeDir, "config", ChainInfoFile), append(bz, '\n'), 0o644)
+}
+
+// registeredChainInfo returns the chain info listed in ChainsCoinInfo, by
+// chain id or by chain id without epoch.
+func registeredChainInfo(chainID string) (ChainInfo, error) {
+	info, found := ChainsCoinInfo[strings.Split(chainID, "-")[0]]
+	if !found {
+		info, found = ChainsCoinInfo[chainID]
+		if !found {
+			return ChainInfo{}, fmt.Errorf("unknown chain id: %s, set its info in the %s file of the config directory or in the genesis", chainID, ChainInfoFile)
+		}
+	}
+	info.ChainID = chainID
+	return info.withEVMChainID()
+}
+
+// chainInfoFromFile reads the chain info file, if any.
+func chainInfoFromFile(path string) (ChainInfo, bool, error) {
+	bz, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return ChainInfo{}, false, nil
+	} else if err != nil {
+		return ChainInfo{}, false, err
+	}
+
+	var info ChainInfo
+	if err := json.Unmarshal(bz, &info); err != nil {
+		return ChainInfo{}, false, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return info, true, nil
+}
+
+// chainInfoFromGenesis derives the chain info from the genesis file, if any,
+// for the chain id: the denom is the EVM denom, or the bond denom when not
+// set, and its bank metadata gives the display denom and the decimals. The
+// genesis without metadata for the denom is skipped.
+func chainInfoFromGenesis(path, chainID string) (ChainInfo, bool, error) {
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+		return ChainInfo{}, false, nil
+	}
+
+	appGenesis, err := genutiltypes.AppGenesisFromFile(path)
+	if err != nil {
+		return ChainInfo{}, false, err
+	}
+	if chainID != "" && appGenesis.ChainID != chainID {
+		return ChainInfo{}, false, nil
+	}
+
+	var appState struct {
+		Bank struct {
+			DenomMetadata []banktypes.Metadata `json:"denom_metadata"`
+		} `json:"bank"`
+		EVM struct {
+			Params struct {
+				EvmDenom string `json:"evm_denom"`
+			} `json:"params"`
+		} `json:"evm"`
+		Staking struct {
+			Params struct {
+				BondDenom string `json:"bond_denom"`
+			} `json:"params"`
+		} `json:"staking"`
+	}
+	if err := json.Unmarshal(appGenesis.AppState, &appState); err != nil {
+		return ChainInfo{}, false, fmt.Errorf("failed to parse the app state of %s: %w", path, err)
+	}
+
+	denom := appState.EVM.Params.EvmDenom
+	if denom == "" {
+		denom = appState.Staking.Params.BondDenom
+	}
+	for _, metadata := range appState.Bank.DenomMetadata {
+		if metadata.Base != denom {
+			continue
+		}
+		info := ChainInfo{
+			ChainID:      appGenesis.ChainID,
+			Denom:        denom,
+			DisplayDenom: metadata.Display,
+		}
+		for _, unit := range metadata.DenomUnits {
+			if unit.Denom == metadata.Display {
+				info.Decimals = uint8(unit.Exponent)
+			}
+		}
+		return info, true, nil
+	}
+	return ChainInfo{}, false, nil
+}
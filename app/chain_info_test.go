@@ -0,0 +1,150 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// writeTestGenesis writes a genesis with the app state in the config
+// directory of the home.
+func writeTestGenesis(t *testing.T, homeDir, chainID, appState string) {
+	t.Helper()
+
+	require.NoError(t, os.MkdirAll(filepath.Join(homeDir, "config"), 0o755))
+	appGenesis := genutiltypes.NewAppGenesisWithVersion(chainID, []byte(appState))
+	require.NoError(t, appGenesis.SaveAs(filepath.Join(homeDir, "config", "genesis.json")))
+}
+
+func TestLoadChainInfo(t *testing.T) {
+	const appState = `{
+		"bank": {"denom_metadata": [
+			{"base": "other", "display": "OTHER", "denom_units": [{"denom": "other"}, {"denom": "OTHER", "exponent": 6}]},
+			{"base": "ubloom", "display": "bloom", "denom_units": [{"denom": "ubloom"}, {"denom": "bloom", "exponent": 6}]}
+		]},
+		"evm": {"params": {"evm_denom": "ubloom"}},
+		"staking": {"params": {"bond_denom": "other", "unbonding_time": "1814400s"}}
+	}`
+
+	t.Run("genesis", func(t *testing.T) {
+		homeDir := t.TempDir()
+		writeTestGenesis(t, homeDir, "bloom_7668-1", appState)
+
+		info, err := LoadChainInfo(homeDir, "")
+		require.NoError(t, err)
+		require.Equal(t, ChainInfo{ChainID: "bloom_7668-1", EVMChainID: 7668, Denom: "ubloom", DisplayDenom: "bloom", Decimals: 6}, info)
+		require.NoError(t, info.Validate())
+
+		// the genesis of another chain is skipped
+		_, err = LoadChainInfo(homeDir, "bloom_7669-1")
+		require.ErrorContains(t, err, "unknown chain id: bloom_7669-1")
+	})
+
+	t.Run("file", func(t *testing.T) {
+		homeDir := t.TempDir()
+		writeTestGenesis(t, homeDir, "bloom-1", appState)
+
+		// the evm chain id can't be parsed from the chain id
+		_, err := LoadChainInfo(homeDir, "bloom-1")
+		require.ErrorContains(t, err, "evm chain id of bloom-1 must be set")
+
+		file := `{"chain_id": "bloom-1", "evm_chain_id": 7668, "denom": "abloom", "display_denom": "bloom", "decimals": 18}`
+		require.NoError(t, os.WriteFile(filepath.Join(homeDir, "config", ChainInfoFile), []byte(file), 0o600))
+
+		info, err := LoadChainInfo(homeDir, "bloom-1")
+		require.NoError(t, err)
+		require.Equal(t, ChainInfo{ChainID: "bloom-1", EVMChainID: 7668, Denom: "abloom", DisplayDenom: "bloom", Decimals: 18}, info)
+
+		_, err = LoadChainInfo(homeDir, "bloom-2")
+		require.ErrorContains(t, err, "doesn't match the chain id bloom-2")
+	})
+
+	t.Run("write", func(t *testing.T) {
+		homeDir := t.TempDir()
+		writeTestGenesis(t, homeDir, "bloom_7668-1", appState)
+
+		info, err := LoadChainInfo(homeDir, "")
+		require.NoError(t, err)
+
+		// the evm chain id is parsed from the new chain id
+		info.ChainID, info.EVMChainID = "bloomfork_7669-1", 0
+		require.NoError(t, WriteChainInfo(homeDir, info))
+
+		info, err = LoadChainInfo(homeDir, "bloomfork_7669-1")
+		require.NoError(t, err)
+		require.Equal(t, ChainInfo{ChainID: "bloomfork_7669-1", EVMChainID: 7669, Denom: "ubloom", DisplayDenom: "bloom", Decimals: 6}, info)
+
+		info.ChainID, info.EVMChainID = "bloomfork", 0
+		require.ErrorContains(t, WriteChainInfo(homeDir, info), "evm chain id of bloomfork must be set")
+	})
+
+	t.Run("registered", func(t *testing.T) {
+		info, err := LoadChainInfo(t.TempDir(), "")
+		require.NoError(t, err)
+		require.Equal(t, ChainInfo{ChainID: ChainID, EVMChainID: 9000, Denom: BaseDenom, DisplayDenom: DisplayDenom, Decimals: 18}, info)
+	})
+}
+
+func TestValidateChainInfo(t *testing.T) {
+	gapp := Setup(t)
+	ctx := gapp.BaseApp.NewContext(false)
+
+	// the genesis has the metadata of the denom
+	info := configuredChainInfo
+	require.NoError(t, gapp.validateChainInfo(ctx))
+
+	// the chains predating the chain info have no metadata for the denom
+	require.NoError(t, gapp.BankKeeper.BaseViewKeeper.DenomMetadata.Remove(ctx, info.Denom))
+	require.NoError(t, gapp.validateChainInfo(ctx))
+
+	metadata := banktypes.Metadata{
+		Base:       info.Denom,
+		Display:    info.DisplayDenom,
+		DenomUnits: []*banktypes.DenomUnit{{Denom: info.Denom}, {Denom: info.DisplayDenom, Exponent: 18}},
+	}
+	gapp.BankKeeper.SetDenomMetaData(ctx, metadata)
+	require.NoError(t, gapp.validateChainInfo(ctx))
+
+	metadata.DenomUnits[1].Exponent = 6
+	require.ErrorContains(t, info.ValidateMetadata(metadata), "don't match the exponent 6")
+
+	gapp.BankKeeper.SetDenomMetaData(ctx, metadata)
+	require.ErrorContains(t, gapp.validateChainInfo(ctx), "don't match the exponent 6")
+
+	metadata.Display = "other"
+	require.ErrorContains(t, info.ValidateMetadata(metadata), "doesn't match the display other")
+}
+
+func TestChainInfoExtendedDenom(t *testing.T) {
+	info := ChainInfo{ChainID: "bloom_7668-1", EVMChainID: 7668, Denom: "ubloom", DisplayDenom: "bloom", Decimals: 6}
+	require.NoError(t, info.Validate())
+	require.Equal(t, "abloom", info.EVMDenom())
+	require.Equal(t, "abloom", preciseBankExtendedDenom(info))
+
+	// the EVM always sees 18 decimals
+	coinInfo := info.CoinInfo()
+	require.Equal(t, "abloom", coinInfo.Denom)
+	require.Equal(t, evmtypes.EighteenDecimals, coinInfo.Decimals)
+
+	info.Denom = "bloomcoin"
+	require.ErrorContains(t, info.Validate(), "extended denom of bloomcoin must be set")
+	info.ExtendedDenom = "attobloom"
+	require.NoError(t, info.Validate())
+	require.Equal(t, "attobloom", info.EVMDenom())
+
+	info.ExtendedDenom = "bloom"
+	require.ErrorContains(t, info.Validate(), "must differ from the denom and the display denom")
+
+	// the denoms of 18 decimals are used by the EVM as is
+	info = ChainInfo{ChainID: ChainID, EVMChainID: 9000, Denom: BaseDenom, DisplayDenom: DisplayDenom, Decimals: 18}
+	require.Equal(t, BaseDenom, info.EVMDenom())
+	require.Empty(t, preciseBankExtendedDenom(info))
+	info.ExtendedDenom = "apetal"
+	require.ErrorContains(t, info.Validate(), "can only be set for denoms of 6 decimals")
+}
@@ -1,9 +1,6 @@
 package app
 
 import (
-	"fmt"
-	"strings"
-
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -22,19 +19,24 @@ func NoOpEVMOptions(_ string) error {
 
 var sealed = false
 
-// ChainsCoinInfo is a map of the chain id and its corresponding EvmCoinInfo
+// ChainsCoinInfo is a map of the chain id and its corresponding ChainInfo
 // that allows initializing the app with different coin info based on the
-// chain id
-var ChainsCoinInfo = map[string]evmtypes.EvmCoinInfo{
+// chain id. The chains not listed here load their info from the chain info
+// file or the genesis of the node, see NewEVMAppOptions.
+var ChainsCoinInfo = map[string]ChainInfo{
 	ChainID: {
 		Denom:        BaseDenom,
 		DisplayDenom: DisplayDenom,
-		Decimals:     evmtypes.EighteenDecimals,
+		Decimals:     uint8(evmtypes.EighteenDecimals),
 	},
 }
 
+// configuredChainInfo is the chain info the EVM is configured with, checked
+// against the state at startup.
+var configuredChainInfo ChainInfo
+
 // EVMAppOptions allows to setup the global configuration
-// for the chain.
+// for the chain, with the coin info listed in ChainsCoinInfo.
 func EVMAppOptions(chainID string) error {
 	if sealed {
 		return nil
@@ -44,48 +46,88 @@ func EVMAppOptions(chainID string) error {
 		chainID = ChainID
 	}
 
-	id := strings.Split(chainID, "-")[0]
-	coinInfo, found := ChainsCoinInfo[id]
-	if !found {
-		coinInfo, found = ChainsCoinInfo[chainID]
-		if !found {
-			return fmt.Errorf("unknown chain id: %s, %+v", chainID, ChainsCoinInfo)
+	info, err := registeredChainInfo(chainID)
+	if err != nil {
+		return err
+	}
+	return configureEVM(info)
+}
+
+// NewEVMAppOptions returns the options setting up the global configuration of
+// the chain with the coin info loaded from the node home, see LoadChainInfo.
+func NewEVMAppOptions(homeDir string) EVMOptionsFn {
+	return func(chainID string) error {
+		if sealed {
+			return nil
+		}
+
+		info, err := LoadChainInfo(homeDir, chainID)
+		if err != nil {
+			return err
 		}
+		return configureEVM(info)
 	}
+}
 
-	// set the denom info for the chain
-	if err := setBaseDenom(coinInfo); err != nil {
+// configureEVM sets the denoms, the EVM coin info, the EVM chain config and
+// the power reduction of the chain. The configuration is global and can be
+// set once.
+func configureEVM(info ChainInfo) error {
+	if err := info.Validate(); err != nil {
 		return err
 	}
 
-	baseDenom, err := sdk.GetBaseDenom()
-	if err != nil {
+	// set the denom info for the chain
+	if err := setBaseDenom(info); err != nil {
 		return err
 	}
 
-	ethCfg := evmtypes.DefaultChainConfig(chainID)
+	ethCfg := evmtypes.DefaultChainConfig("")
+	ethCfg.ChainId = info.EVMChainID
 
-	err = evmtypes.NewEVMConfigurator().
+	// the EVM always sees 18 decimals, the denoms of 6 decimals are extended by
+	// x/precisebank
+	coinInfo := info.CoinInfo()
+	err := evmtypes.NewEVMConfigurator().
 		WithChainConfig(ethCfg).
-		// NOTE: we're using the 18 decimals
-		WithEVMCoinInfo(baseDenom, uint8(coinInfo.Decimals)).
+		WithEVMCoinInfo(coinInfo.Denom, uint8(coinInfo.Decimals)).
 		Configure()
 	if err != nil {
 		return err
 	}
 
+	// a unit of consensus power is a display unit of the denom
+	sdk.DefaultPowerReduction = math.NewIntWithDecimal(1, int(info.Decimals))
+
+	configuredChainInfo = info
 	sealed = true
 	return nil
 }
 
-// setBaseDenom registers the display denom and base denom and sets the
-// base denom for the chain.
-func setBaseDenom(ci evmtypes.EvmCoinInfo) error {
-	if err := sdk.RegisterDenom(ci.DisplayDenom, math.LegacyOneDec()); err != nil {
+// preciseBankExtendedDenom returns the denom extended by x/precisebank, empty
+// when the denom has 18 decimals and is used by the EVM as is.
+func preciseBankExtendedDenom(info ChainInfo) string {
+	if evmDenom := info.EVMDenom(); evmDenom != info.Denom {
+		return evmDenom
+	}
+	return ""
+}
+
+// setBaseDenom registers the display denom, the denom and the extended denom
+// of the chain, setting the base denom to the smallest of them.
+func setBaseDenom(info ChainInfo) error {
+	if err := sdk.RegisterDenom(info.DisplayDenom, math.LegacyOneDec()); err != nil {
 		return err
 	}
 
 	// sdk.RegisterDenom will automatically overwrite the base denom when the
 	// new setBaseDenom() are lower than the current base denom's units.
-	return sdk.RegisterDenom(ci.Denom, math.LegacyNewDecWithPrec(1, int64(ci.Decimals)))
+	if err := sdk.RegisterDenom(info.Denom, math.LegacyNewDecWithPrec(1, int64(info.Decimals))); err != nil {
+		return err
+	}
+
+	if evmDenom := info.EVMDenom(); evmDenom != info.Denom {
+		return sdk.RegisterDenom(evmDenom, math.LegacyNewDecWithPrec(1, int64(evmtypes.EighteenDecimals)))
+	}
+	return nil
 }
@@ -0,0 +1,209 @@
+package app
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	cronkeeper "github.com/rollchains/flora/x/cron/keeper"
+	crontypes "github.com/rollchains/flora/x/cron/types"
+)
+
+var (
+	// counterCode increments the storage slot 0 on every call.
+	counterCode = common.FromHex("0x60005460010160005500")
+	// revertCode reverts every call.
+	revertCode = common.FromHex("0x60006000fd")
+)
+
+// cronTestContext returns a context proposed by the genesis validator, as the
+// EVM resolves the coinbase from the block proposer.
+func cronTestContext(t *testing.T, gapp *ChainApp) sdk.Context {
+	t.Helper()
+
+	ctx := gapp.BaseApp.NewContext(false)
+	validators, err := gapp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	consAddr, err := validators[0].GetConsAddr()
+	require.NoError(t, err)
+
+	header := ctx.BlockHeader()
+	header.ProposerAddress = consAddr
+	return ctx.WithBlockHeader(header)
+}
+
+// deployCronTestContract sets the code of a new contract and funds it with one
+// EVM token.
+func deployCronTestContract(t *testing.T, gapp *ChainApp, ctx sdk.Context, code []byte) common.Address {
+	t.Helper()
+
+	addr := common.BytesToAddress(secp256k1.GenPrivKey().PubKey().Address())
+	codeHash := crypto.Keccak256(code)
+	gapp.EVMKeeper.SetCode(ctx, codeHash, code)
+	require.NoError(t, gapp.EVMKeeper.SetAccount(ctx, addr, statedb.Account{Balance: big.NewInt(0), CodeHash: codeHash}))
+
+	initAccountWithCoins(gapp, ctx, addr.Bytes(), sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewIntWithDecimal(1, 18))))
+	return addr
+}
+
+func TestCronJobExecution(t *testing.T) {
+	gapp := Setup(t)
+	ctx := cronTestContext(t, gapp)
+	msgServer := cronkeeper.NewMsgServerImpl(gapp.CronKeeper)
+	authority := gapp.CronKeeper.GetAuthority()
+	denom := evmtypes.GetEVMCoinDenom()
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	counter := deployCronTestContract(t, gapp, ctx, counterCode)
+	counterAcc := sdk.AccAddress(counter.Bytes())
+
+	_, err := msgServer.AddJob(ctx, &crontypes.MsgAddJob{
+		Authority: authority, Contract: counter.Hex(), Interval: 2, GasLimit: crontypes.DefaultMaxJobGasLimit + 1, FeePayer: counterAcc.String(),
+	})
+	require.ErrorIs(t, err, crontypes.ErrGasLimitTooHigh)
+
+	res, err := msgServer.AddJob(ctx, &crontypes.MsgAddJob{
+		Authority: authority, Contract: counter.Hex(), Interval: 2, GasLimit: 100_000, FeePayer: counterAcc.String(),
+	})
+	require.NoError(t, err)
+
+	start := ctx.BlockHeight()
+
+	// not due yet
+	require.NoError(t, gapp.CronKeeper.EndBlocker(ctx.WithBlockHeight(start+1)))
+	require.Equal(t, common.Hash{}, gapp.EVMKeeper.GetState(ctx, counter, common.Hash{}))
+
+	feesBefore := gapp.BankKeeper.GetBalance(ctx, feeCollector, denom)
+	require.NoError(t, gapp.CronKeeper.EndBlocker(ctx.WithBlockHeight(start+2)))
+	require.Equal(t, common.BigToHash(big.NewInt(1)), gapp.EVMKeeper.GetState(ctx, counter, common.Hash{}))
+	require.True(t, gapp.BankKeeper.GetBalance(ctx, feeCollector, denom).Amount.GT(feesBefore.Amount))
+
+	job, err := gapp.CronKeeper.GetJob(ctx, res.Id)
+	require.NoError(t, err)
+	require.Equal(t, uint64(start+4), job.NextHeight)
+
+	// a third party fee payer must grant a fee allowance to the module account
+	payer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	initAccountWithCoins(gapp, ctx, payer, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntWithDecimal(1, 18))))
+	res, err = msgServer.AddJob(ctx.WithBlockHeight(start+2), &crontypes.MsgAddJob{
+		Authority: authority, Contract: counter.Hex(), Interval: 1, GasLimit: 100_000, FeePayer: payer.String(),
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, gapp.CronKeeper.EndBlocker(ctx.WithBlockHeight(start+3)))
+	require.Equal(t, common.BigToHash(big.NewInt(1)), gapp.EVMKeeper.GetState(ctx, counter, common.Hash{}))
+	job, err = gapp.CronKeeper.GetJob(ctx, res.Id)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), job.ConsecutiveFailures)
+
+	moduleAddr := gapp.AccountKeeper.GetModuleAddress(crontypes.ModuleName)
+	require.NoError(t, gapp.FeeGrantKeeper.GrantAllowance(ctx, payer, moduleAddr, &feegrant.BasicAllowance{}))
+
+	payerBefore := gapp.BankKeeper.GetBalance(ctx, payer, denom)
+	require.NoError(t, gapp.CronKeeper.EndBlocker(ctx.WithBlockHeight(start+4)))
+	require.Equal(t, common.BigToHash(big.NewInt(3)), gapp.EVMKeeper.GetState(ctx, counter, common.Hash{}))
+	require.True(t, gapp.BankKeeper.GetBalance(ctx, payer, denom).Amount.LT(payerBefore.Amount))
+	job, err = gapp.CronKeeper.GetJob(ctx, res.Id)
+	require.NoError(t, err)
+	require.Zero(t, job.ConsecutiveFailures)
+}
+
+func TestCronBlockGasBudget(t *testing.T) {
+	gapp := Setup(t)
+	ctx := cronTestContext(t, gapp)
+	msgServer := cronkeeper.NewMsgServerImpl(gapp.CronKeeper)
+	authority := gapp.CronKeeper.GetAuthority()
+
+	params := crontypes.DefaultParams()
+	params.MaxJobGasLimit = 100_000
+	params.MaxBlockGas = 150_000
+	require.NoError(t, gapp.CronKeeper.Params.Set(ctx, params))
+
+	counter := deployCronTestContract(t, gapp, ctx, counterCode)
+	var ids []uint64
+	for _, gasLimit := range []uint64{100_000, 100_000, 40_000} {
+		res, err := msgServer.AddJob(ctx, &crontypes.MsgAddJob{
+			Authority: authority, Contract: counter.Hex(), Interval: 1, GasLimit: gasLimit, FeePayer: sdk.AccAddress(counter.Bytes()).String(),
+		})
+		require.NoError(t, err)
+		ids = append(ids, res.Id)
+	}
+
+	// the third job still fits after the second one is deferred
+	height := ctx.BlockHeight() + 1
+	require.NoError(t, gapp.CronKeeper.EndBlocker(ctx.WithBlockHeight(height)))
+	require.Equal(t, common.BigToHash(big.NewInt(2)), gapp.EVMKeeper.GetState(ctx, counter, common.Hash{}))
+
+	// the second job is deferred and runs first in the next block
+	deferred, err := gapp.CronKeeper.GetJob(ctx, ids[1])
+	require.NoError(t, err)
+	require.Equal(t, uint64(height), deferred.NextHeight)
+
+	require.NoError(t, gapp.CronKeeper.EndBlocker(ctx.WithBlockHeight(height+1)))
+	deferred, err = gapp.CronKeeper.GetJob(ctx, ids[1])
+	require.NoError(t, err)
+	require.Equal(t, uint64(height+2), deferred.NextHeight)
+}
+
+func TestCronContractJobBond(t *testing.T) {
+	gapp := Setup(t)
+	ctx := cronTestContext(t, gapp)
+	denom := evmtypes.GetEVMCoinDenom()
+	bond := sdkmath.NewIntWithDecimal(1, 17)
+
+	params := crontypes.DefaultParams()
+	params.MinBond = bond
+	params.MaxConsecutiveFailures = 2
+	require.NoError(t, gapp.CronKeeper.Params.Set(ctx, params))
+
+	// cancelled jobs refund the bond
+	counter := deployCronTestContract(t, gapp, ctx, counterCode)
+	balance := gapp.BankKeeper.GetBalance(ctx, counter.Bytes(), denom)
+	job, err := gapp.CronKeeper.RegisterContractJob(ctx, counter, nil, 1, 50_000)
+	require.NoError(t, err)
+	require.Equal(t, bond, job.Bond)
+	require.Equal(t, balance.Amount.Sub(bond), gapp.BankKeeper.GetBalance(ctx, counter.Bytes(), denom).Amount)
+
+	_, err = gapp.CronKeeper.CancelJob(ctx, sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()), job.Id)
+	require.ErrorIs(t, err, crontypes.ErrNotJobOwner)
+	_, err = gapp.CronKeeper.CancelJob(ctx, counter.Bytes(), job.Id)
+	require.NoError(t, err)
+	require.Equal(t, balance, gapp.BankKeeper.GetBalance(ctx, counter.Bytes(), denom))
+
+	// failing jobs are removed and their bond funds the community pool
+	reverter := deployCronTestContract(t, gapp, ctx, revertCode)
+	job, err = gapp.CronKeeper.RegisterContractJob(ctx, reverter, nil, 1, 50_000)
+	require.NoError(t, err)
+
+	poolBefore, err := gapp.DistrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+
+	// the gas used by the failed calls is charged to the contract
+	height := ctx.BlockHeight()
+	balance = gapp.BankKeeper.GetBalance(ctx, reverter.Bytes(), denom)
+	require.NoError(t, gapp.CronKeeper.EndBlocker(ctx.WithBlockHeight(height+1)))
+	job, err = gapp.CronKeeper.GetJob(ctx, job.Id)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), job.ConsecutiveFailures)
+	require.True(t, gapp.BankKeeper.GetBalance(ctx, reverter.Bytes(), denom).Amount.LT(balance.Amount))
+
+	require.NoError(t, gapp.CronKeeper.EndBlocker(ctx.WithBlockHeight(height+2)))
+	_, err = gapp.CronKeeper.GetJob(ctx, job.Id)
+	require.ErrorIs(t, err, crontypes.ErrJobNotFound)
+
+	poolAfter, err := gapp.DistrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, bond, poolAfter.CommunityPool.AmountOf(denom).Sub(poolBefore.CommunityPool.AmountOf(denom)).TruncateInt())
+}
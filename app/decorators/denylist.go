@@ -0,0 +1,107 @@
+package decorators
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	denylisttypes "github.com/rollchains/flora/x/denylist/types"
+)
+
+// DenylistKeeper checks the addresses against the sanctions denylist.
+type DenylistKeeper interface {
+	CheckAddress(ctx context.Context, path string, addr sdk.AccAddress) error
+}
+
+// DenylistDecorator rejects the txs signed by denied addresses, the EVM
+// transactions from or to denied addresses and the ERC-20 conversions to
+// denied receivers. The messages nested in an authz.MsgExec are checked too.
+//
+// The bank sends are checked by the bank send restriction, the EVM calls and
+// value transfers from inside contracts by the EVM call hook and the call
+// access control of the EVM params, and the ICS20 transfers by the IBC
+// middleware.
+type DenylistDecorator struct {
+	keeper DenylistKeeper
+}
+
+// NewDenylistDecorator returns a new DenylistDecorator.
+func NewDenylistDecorator(keeper DenylistKeeper) DenylistDecorator {
+	return DenylistDecorator{keeper: keeper}
+}
+
+func (d DenylistDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if sigTx, ok := tx.(authsigning.SigVerifiableTx); ok {
+		signers, err := sigTx.GetSigners()
+		if err != nil {
+			return ctx, err
+		}
+		for _, signer := range signers {
+			if err := d.keeper.CheckAddress(ctx, denylisttypes.PathAnte, signer); err != nil {
+				return ctx, err
+			}
+		}
+	}
+
+	if err := d.checkMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (d DenylistDecorator) checkMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		switch m := msg.(type) {
+		case *authz.MsgExec:
+			nested, err := m.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := d.checkMsgs(ctx, nested); err != nil {
+				return err
+			}
+
+		case *evmtypes.MsgEthereumTx:
+			if err := d.keeper.CheckAddress(ctx, denylisttypes.PathEVM, m.GetFrom()); err != nil {
+				return err
+			}
+			if to := m.AsTransaction().To(); to != nil {
+				if err := d.keeper.CheckAddress(ctx, denylisttypes.PathEVM, to.Bytes()); err != nil {
+					return err
+				}
+			}
+
+		case *erc20types.MsgConvertERC20:
+			if err := d.checkConversion(ctx, m.Sender, m.Receiver); err != nil {
+				return err
+			}
+
+		case *erc20types.MsgConvertCoin:
+			if err := d.checkConversion(ctx, m.Sender, m.Receiver); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkConversion checks the sender and the receiver of an ERC-20 conversion,
+// given as either hex or bech32 addresses.
+func (d DenylistDecorator) checkConversion(ctx sdk.Context, sender, receiver string) error {
+	for _, address := range []string{sender, receiver} {
+		addr, err := denylisttypes.ParseAddress(address)
+		if err != nil {
+			return err
+		}
+		if err := d.keeper.CheckAddress(ctx, denylisttypes.PathERC20, addr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package decorators_test
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/rollchains/flora/app/decorators"
+	denylisttypes "github.com/rollchains/flora/x/denylist/types"
+)
+
+// mockDenylistKeeper denies a single address.
+type mockDenylistKeeper struct {
+	denied sdk.AccAddress
+}
+
+func (k mockDenylistKeeper) CheckAddress(_ context.Context, _ string, addr sdk.AccAddress) error {
+	if addr.Equals(k.denied) {
+		return denylisttypes.ErrAddressDenied
+	}
+	return nil
+}
+
+func (s *AnteTestSuite) TestAnteDenylist() {
+	denied := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	other := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	deniedBech32 := sdk.AccAddress(denied.Bytes()).String()
+	otherBech32 := sdk.AccAddress(other.Bytes()).String()
+	ante := decorators.NewDenylistDecorator(mockDenylistKeeper{denied: denied.Bytes()})
+
+	check := func(msgs ...sdk.Msg) error {
+		_, err := ante.AnteHandle(s.ctx, decorators.NewMockTx(msgs...), false, decorators.EmptyAnte)
+		return err
+	}
+	ethTx := func(from common.Address, to *common.Address) *evmtypes.MsgEthereumTx {
+		msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{To: to, GasLimit: 100_000})
+		msg.From = from.Hex()
+		return msg
+	}
+
+	// EVM transactions
+	s.Require().ErrorIs(check(ethTx(denied, &other)), denylisttypes.ErrAddressDenied)
+	s.Require().ErrorIs(check(ethTx(other, &denied)), denylisttypes.ErrAddressDenied)
+	s.Require().NoError(check(ethTx(other, &other)))
+	s.Require().NoError(check(ethTx(other, nil)))
+
+	// ERC-20 conversions, hex and bech32 addresses
+	convertERC20 := &erc20types.MsgConvertERC20{ContractAddress: other.Hex(), Amount: sdkmath.NewInt(1), Sender: other.Hex(), Receiver: deniedBech32}
+	s.Require().ErrorIs(check(convertERC20), denylisttypes.ErrAddressDenied)
+	convertCoin := &erc20types.MsgConvertCoin{Coin: sdk.NewInt64Coin("stake", 1), Sender: deniedBech32, Receiver: other.Hex()}
+	s.Require().ErrorIs(check(convertCoin), denylisttypes.ErrAddressDenied)
+	convertCoin = &erc20types.MsgConvertCoin{Coin: sdk.NewInt64Coin("stake", 1), Sender: otherBech32, Receiver: other.Hex()}
+	s.Require().NoError(check(convertCoin))
+
+	// nested in authz
+	exec := authz.NewMsgExec(sdk.AccAddress(other.Bytes()), []sdk.Msg{convertERC20})
+	s.Require().ErrorIs(check(&exec), denylisttypes.ErrAddressDenied)
+}
@@ -0,0 +1,46 @@
+package decorators
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// DeployPolicyKeeper checks who may deploy contracts.
+type DeployPolicyKeeper interface {
+	CheckDeploy(ctx context.Context, deployer common.Address, initCode []byte) error
+}
+
+// DeployPolicyDecorator rejects the contract creation txs of deployers not
+// allowed by the deploy policy. The deployments from inside contracts are
+// checked by the EVM create access control.
+type DeployPolicyDecorator struct {
+	keeper DeployPolicyKeeper
+}
+
+// NewDeployPolicyDecorator returns a new DeployPolicyDecorator.
+func NewDeployPolicyDecorator(keeper DeployPolicyKeeper) DeployPolicyDecorator {
+	return DeployPolicyDecorator{keeper: keeper}
+}
+
+func (d DeployPolicyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	for _, msg := range tx.GetMsgs() {
+		ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			continue
+		}
+
+		ethTx := ethMsg.AsTransaction()
+		if ethTx.To() != nil {
+			continue
+		}
+		if err := d.keeper.CheckDeploy(ctx, common.BytesToAddress(ethMsg.GetFrom()), ethTx.Data()); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,171 @@
+package decorators
+
+import (
+	"bytes"
+	"fmt"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	evmostypes "github.com/cosmos/evm/types"
+
+	"github.com/rollchains/flora/client/eip712"
+)
+
+// EIP712SigVerificationDecorator verifies the signature of the txs signed in
+// the legacy EIP-712 Web3Tx flow, where the signature of the typed data is in
+// the ExtensionOptionsWeb3Tx of the tx and the Cosmos signature is empty. The
+// typed data is built with the codec of the app, so that every registered
+// message can be signed.
+//
+// The txs have a single signer, which is the fee payer, with an ethsecp256k1
+// public key.
+type EIP712SigVerificationDecorator struct {
+	ak  ante.AccountKeeper
+	cdc codectypes.AnyUnpacker
+}
+
+// NewEIP712SigVerificationDecorator returns a new EIP712SigVerificationDecorator.
+func NewEIP712SigVerificationDecorator(ak ante.AccountKeeper, cdc codectypes.AnyUnpacker) EIP712SigVerificationDecorator {
+	return EIP712SigVerificationDecorator{
+		ak:  ak,
+		cdc: cdc,
+	}
+}
+
+func (svd EIP712SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+	extTx, ok := tx.(ante.HasExtensionOptionsTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrUnknownExtensionOptions, "tx doesn't contain any extensions")
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+	if len(sigs) != 1 || len(signers) != 1 {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrTooManySignatures, "EIP-712 txs have a single signer, got %d signers and %d signatures", len(signers), len(sigs))
+	}
+	sig := sigs[0]
+
+	acc, err := ante.GetSignerAcc(ctx, svd.ak, signers[0])
+	if err != nil {
+		return ctx, err
+	}
+
+	pubKey := acc.GetPubKey()
+	if !simulate && pubKey == nil {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
+	}
+
+	if sig.Sequence != acc.GetSequence() {
+		return ctx, errorsmod.Wrapf(
+			sdkerrors.ErrWrongSequence,
+			"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
+		)
+	}
+
+	if simulate || ctx.IsReCheckTx() || !ctx.IsSigverifyTx() {
+		return next(ctx, tx, simulate)
+	}
+
+	var accNum uint64
+	if ctx.BlockHeight() != 0 {
+		accNum = acc.GetAccountNumber()
+	}
+	signerData := authsigning.SignerData{
+		Address:       acc.GetAddress().String(),
+		ChainID:       ctx.ChainID(),
+		AccountNumber: accNum,
+		Sequence:      acc.GetSequence(),
+		PubKey:        pubKey,
+	}
+
+	if err := svd.verifySignature(signerData, sig.Data, sigTx, extTx); err != nil {
+		errMsg := fmt.Sprintf("signature verification failed; please verify account number (%d) and chain-id (%s): (%s)", accNum, ctx.ChainID(), err.Error())
+		return ctx, errorsmod.Wrap(sdkerrors.ErrUnauthorized, errMsg)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// verifySignature verifies the signature of the typed data in the Web3Tx
+// extension against the public key of the signer.
+func (svd EIP712SigVerificationDecorator) verifySignature(
+	signerData authsigning.SignerData,
+	sigData signing.SignatureData,
+	tx authsigning.Tx,
+	extTx ante.HasExtensionOptionsTx,
+) error {
+	single, ok := sigData.(*signing.SingleSignatureData)
+	if !ok || single.SignMode != signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON {
+		return fmt.Errorf("EIP-712 txs must have a single %s signature", signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	}
+	// the signature is in the extension, the Cosmos one must not carry data
+	if len(single.Signature) != 0 {
+		return fmt.Errorf("the Cosmos signature of EIP-712 txs must be empty")
+	}
+
+	if _, ok := signerData.PubKey.(*ethsecp256k1.PubKey); !ok {
+		return fmt.Errorf("EIP-712 txs must be signed by an %s key, got %T", ethsecp256k1.KeyType, signerData.PubKey)
+	}
+
+	opts := extTx.GetExtensionOptions()
+	if len(opts) != 1 {
+		return fmt.Errorf("EIP-712 txs must have a single extension option, got %d", len(opts))
+	}
+	ext, ok := opts[0].GetCachedValue().(*evmostypes.ExtensionOptionsWeb3Tx)
+	if !ok {
+		return fmt.Errorf("unexpected extension option %s", opts[0].GetTypeUrl())
+	}
+
+	chainID, err := evmostypes.ParseChainID(signerData.ChainID)
+	if err != nil {
+		return err
+	}
+	if ext.TypedDataChainID != chainID.Uint64() {
+		return fmt.Errorf("typed data chain id %d doesn't match the chain id %d", ext.TypedDataChainID, chainID.Uint64())
+	}
+
+	feePayer, err := sdk.AccAddressFromBech32(ext.FeePayer)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid fee payer of the Web3Tx extension")
+	}
+	if !bytes.Equal(feePayer, tx.FeePayer()) || !bytes.Equal(feePayer, signerData.PubKey.Address()) {
+		return fmt.Errorf("fee payer %s of the Web3Tx extension is not the signer of the tx", ext.FeePayer)
+	}
+
+	typedData, err := eip712.LegacyTypedData(svd.cdc, signerData, tx, feePayer)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to create EIP-712 typed data from tx")
+	}
+	hash, err := eip712.Hash(typedData)
+	if err != nil {
+		return err
+	}
+
+	// wallets sign in the [R || S || V] format, with V possibly offset by 27
+	if len(ext.FeePayerSig) != ethcrypto.SignatureLength {
+		return fmt.Errorf("expected a %d bytes signature, got %d", ethcrypto.SignatureLength, len(ext.FeePayerSig))
+	}
+	if !ethcrypto.VerifySignature(signerData.PubKey.Bytes(), hash, ext.FeePayerSig[:ethcrypto.RecoveryIDOffset]) {
+		return fmt.Errorf("unable to verify the signature of the EIP-712 typed data")
+	}
+	return nil
+}
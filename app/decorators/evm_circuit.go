@@ -0,0 +1,47 @@
+package decorators
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// EVMCircuitKeeper checks the breakers of the EVM contracts and precompiles.
+type EVMCircuitKeeper interface {
+	CheckCall(ctx context.Context, address common.Address, input []byte) error
+}
+
+// EVMCircuitDecorator rejects the EVM transactions calling a contract or a
+// function whose breaker is tripped before they reach the mempool. The calls
+// from other contracts are checked by the EVM call hook of the evmcircuit
+// keeper.
+type EVMCircuitDecorator struct {
+	keeper EVMCircuitKeeper
+}
+
+// NewEVMCircuitDecorator returns a new EVMCircuitDecorator.
+func NewEVMCircuitDecorator(keeper EVMCircuitKeeper) EVMCircuitDecorator {
+	return EVMCircuitDecorator{keeper: keeper}
+}
+
+func (d EVMCircuitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	for _, msg := range tx.GetMsgs() {
+		ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			continue
+		}
+
+		ethTx := ethMsg.AsTransaction()
+		if ethTx.To() == nil {
+			continue
+		}
+		if err := d.keeper.CheckCall(ctx, *ethTx.To(), ethTx.Data()); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,59 @@
+package decorators_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/rollchains/flora/app/decorators"
+)
+
+var errMockBreakerTripped = errors.New("breaker tripped")
+
+// mockEVMCircuitKeeper trips the whole address or the selector.
+type mockEVMCircuitKeeper struct {
+	address  common.Address
+	selector []byte
+}
+
+func (k mockEVMCircuitKeeper) CheckCall(_ context.Context, address common.Address, input []byte) error {
+	if address != k.address {
+		return nil
+	}
+	if len(k.selector) == 0 || bytes.HasPrefix(input, k.selector) {
+		return errMockBreakerTripped
+	}
+	return nil
+}
+
+func (s *AnteTestSuite) TestAnteEVMCircuit() {
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	other := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	selector := []byte{0xde, 0xad, 0xbe, 0xef}
+
+	call := func(to *common.Address, input []byte) *evmtypes.MsgEthereumTx {
+		return evmtypes.NewTx(&evmtypes.EvmTxArgs{To: to, Input: input, GasLimit: 100_000})
+	}
+
+	// whole contract tripped
+	ante := decorators.NewEVMCircuitDecorator(mockEVMCircuitKeeper{address: contract})
+	_, err := ante.AnteHandle(s.ctx, decorators.NewMockTx(call(&contract, nil)), false, decorators.EmptyAnte)
+	s.Require().ErrorIs(err, errMockBreakerTripped)
+	_, err = ante.AnteHandle(s.ctx, decorators.NewMockTx(call(&other, nil)), false, decorators.EmptyAnte)
+	s.Require().NoError(err)
+
+	// single function tripped
+	ante = decorators.NewEVMCircuitDecorator(mockEVMCircuitKeeper{address: contract, selector: selector})
+	_, err = ante.AnteHandle(s.ctx, decorators.NewMockTx(call(&contract, append(selector, 0x01))), false, decorators.EmptyAnte)
+	s.Require().ErrorIs(err, errMockBreakerTripped)
+	_, err = ante.AnteHandle(s.ctx, decorators.NewMockTx(call(&contract, []byte{0x01, 0x02, 0x03, 0x04})), false, decorators.EmptyAnte)
+	s.Require().NoError(err)
+
+	// contract creations are not checked
+	_, err = ante.AnteHandle(s.ctx, decorators.NewMockTx(call(nil, selector)), false, decorators.EmptyAnte)
+	s.Require().NoError(err)
+}
@@ -0,0 +1,86 @@
+package decorators
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// PendingTxPool reports whether the app-side mempool holds a tx for a
+// sender and nonce, and whether it can queue a new one.
+type PendingTxPool interface {
+	Contains(sender sdk.AccAddress, nonce uint64) bool
+	CheckQueue(sender sdk.AccAddress, seq, nonce uint64) error
+}
+
+// NonceAccountKeeper reads and writes the account sequence.
+type NonceAccountKeeper interface {
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+	SetAccount(ctx context.Context, acc sdk.AccountI)
+}
+
+// NonceQueueDecorator lets CheckTx admit EVM txs whose nonce does not match
+// the account sequence, so the mempool can queue future nonces and replace
+// pending ones. Future nonces beyond the queue limits of the mempool are
+// rejected right away. Such txs are checked by the wrapped decorator against a
+// branch of the state where the sequence equals the tx nonce, and the branch
+// is discarded. Txs matching the sequence and all txs outside of CheckTx are
+// passed to the wrapped decorator unchanged.
+type NonceQueueDecorator struct {
+	inner         sdk.AnteDecorator
+	accountKeeper NonceAccountKeeper
+	pool          PendingTxPool
+}
+
+// NewNonceQueueDecorator returns a new NonceQueueDecorator wrapping inner.
+func NewNonceQueueDecorator(inner sdk.AnteDecorator, ak NonceAccountKeeper, pool PendingTxPool) NonceQueueDecorator {
+	return NonceQueueDecorator{
+		inner:         inner,
+		accountKeeper: ak,
+		pool:          pool,
+	}
+}
+
+func (d NonceQueueDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !ctx.IsCheckTx() || simulate || len(tx.GetMsgs()) != 1 {
+		return d.inner.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	ethMsg, txData, err := evmtypes.UnpackEthMsg(tx.GetMsgs()[0])
+	if err != nil {
+		return d.inner.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	from := ethMsg.GetFrom()
+	acc := d.accountKeeper.GetAccount(ctx, from)
+	if acc == nil {
+		return d.inner.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	nonce := txData.GetNonce()
+	seq := acc.GetSequence()
+	queued := nonce > seq
+	replacement := nonce < seq && d.pool.Contains(from, nonce)
+	if !queued && !replacement {
+		return d.inner.AnteHandle(ctx, tx, simulate, next)
+	}
+	if err := d.pool.CheckQueue(from, seq, nonce); err != nil {
+		return ctx, err
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	if err := acc.SetSequence(nonce); err != nil {
+		return ctx, err
+	}
+	d.accountKeeper.SetAccount(cacheCtx, acc)
+
+	newCtx, err := d.inner.AnteHandle(cacheCtx, tx, simulate, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	})
+	if err != nil {
+		return ctx, err
+	}
+
+	return next(newCtx.WithMultiStore(ctx.MultiStore()).WithEventManager(ctx.EventManager()), tx, simulate)
+}
@@ -0,0 +1,119 @@
+package decorators_test
+
+import (
+	"context"
+	"encoding/binary"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/rollchains/flora/app/decorators"
+	"github.com/rollchains/flora/app/mempool"
+)
+
+// mockSequenceKeeper keeps the account sequences in the context store so
+// writes to a branched context are not visible from the parent.
+type mockSequenceKeeper struct {
+	key storetypes.StoreKey
+}
+
+func (k mockSequenceKeeper) GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI {
+	bz := sdk.UnwrapSDKContext(ctx).KVStore(k.key).Get(addr)
+	if bz == nil {
+		return nil
+	}
+	return authtypes.NewBaseAccount(addr, nil, 0, binary.BigEndian.Uint64(bz))
+}
+
+func (k mockSequenceKeeper) SetAccount(ctx context.Context, acc sdk.AccountI) {
+	sdk.UnwrapSDKContext(ctx).KVStore(k.key).Set(acc.GetAddress(), sdk.Uint64ToBigEndian(acc.GetSequence()))
+}
+
+type mockPendingTxPool map[uint64]bool
+
+func (p mockPendingTxPool) Contains(_ sdk.AccAddress, nonce uint64) bool {
+	return p[nonce]
+}
+
+// CheckQueue allows nonces at most 10 ahead of the sequence.
+func (p mockPendingTxPool) CheckQueue(_ sdk.AccAddress, seq, nonce uint64) error {
+	if nonce > seq+10 {
+		return mempool.ErrNonceGapTooLarge
+	}
+	return nil
+}
+
+// sequenceCheckDecorator mimics the EVM mono decorator nonce check.
+type sequenceCheckDecorator struct {
+	ak mockSequenceKeeper
+}
+
+func (d sequenceCheckDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	ethMsg := tx.GetMsgs()[0].(*evmtypes.MsgEthereumTx)
+	acc := d.ak.GetAccount(ctx, ethMsg.GetFrom())
+	if ethMsg.AsTransaction().Nonce() != acc.GetSequence() {
+		return ctx, sdkerrors.ErrWrongSequence
+	}
+	if err := acc.SetSequence(acc.GetSequence() + 1); err != nil {
+		return ctx, err
+	}
+	d.ak.SetAccount(ctx, acc)
+	return next(ctx, tx, simulate)
+}
+
+func (s *AnteTestSuite) TestAnteNonceQueue() {
+	key := storetypes.NewKVStoreKey("nonce_queue")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_nonce_queue")).WithIsCheckTx(true)
+	ak := mockSequenceKeeper{key: key}
+
+	from := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	ak.SetAccount(ctx, authtypes.NewBaseAccount(from, nil, 0, 5))
+
+	ethTx := func(nonce uint64) sdk.Tx {
+		to := common.HexToAddress("0x01")
+		msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			Nonce:    nonce,
+			GasLimit: 21000,
+			GasPrice: big.NewInt(1),
+			To:       &to,
+		})
+		msg.From = common.BytesToAddress(from).Hex()
+		return decorators.NewMockTx(msg)
+	}
+
+	// nonce 3 is pending in the mempool, nonce 2 is not
+	ante := decorators.NewNonceQueueDecorator(sequenceCheckDecorator{ak: ak}, ak, mockPendingTxPool{3: true})
+
+	// the current nonce goes through the wrapped decorator and bumps the sequence
+	_, err := ante.AnteHandle(ctx, ethTx(5), false, decorators.EmptyAnte)
+	s.Require().NoError(err)
+	s.Require().EqualValues(6, ak.GetAccount(ctx, from).GetSequence())
+
+	// a future nonce is queued without touching the sequence
+	_, err = ante.AnteHandle(ctx, ethTx(8), false, decorators.EmptyAnte)
+	s.Require().NoError(err)
+	s.Require().EqualValues(6, ak.GetAccount(ctx, from).GetSequence())
+
+	// a nonce beyond the queue limits of the mempool is rejected
+	_, err = ante.AnteHandle(ctx, ethTx(17), false, decorators.EmptyAnte)
+	s.Require().ErrorIs(err, mempool.ErrNonceGapTooLarge)
+
+	// a pending nonce can be replaced, an unknown past nonce is rejected
+	_, err = ante.AnteHandle(ctx, ethTx(3), false, decorators.EmptyAnte)
+	s.Require().NoError(err)
+	_, err = ante.AnteHandle(ctx, ethTx(2), false, decorators.EmptyAnte)
+	s.Require().ErrorIs(err, sdkerrors.ErrWrongSequence)
+
+	// outside of CheckTx the nonce must match
+	_, err = ante.AnteHandle(ctx.WithIsCheckTx(false), ethTx(8), false, decorators.EmptyAnte)
+	s.Require().ErrorIs(err, sdkerrors.ErrWrongSequence)
+}
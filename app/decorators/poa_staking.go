@@ -0,0 +1,161 @@
+package decorators
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	stakingprecompile "github.com/cosmos/evm/precompiles/staking"
+	"github.com/cosmos/evm/x/vm/core/vm"
+	"github.com/cosmos/evm/x/vm/statedb"
+)
+
+// POAKeeper reports whether the validator set is managed by the poa admins.
+type POAKeeper interface {
+	IsEnabled(ctx context.Context) bool
+}
+
+// POAStakingDecorator rejects x/staking validator set changes while the chain
+// runs in proof-of-authority mode. Validators are created and weighted through
+// x/poa instead. Delegators left over from a proof-of-stake genesis can still
+// undelegate, operators can't as their self delegation is the admin set power.
+// Genesis transactions are let through so the initial set can still be
+// bootstrapped with gentxs.
+type POAStakingDecorator struct {
+	poaKeeper POAKeeper
+	filter    MsgFilterDecorator
+}
+
+// NewPOAStakingDecorator returns a new POAStakingDecorator.
+func NewPOAStakingDecorator(poaKeeper POAKeeper) POAStakingDecorator {
+	return POAStakingDecorator{
+		poaKeeper: poaKeeper,
+		filter: FilterDecorator(
+			&stakingtypes.MsgCreateValidator{},
+			&stakingtypes.MsgDelegate{},
+			&stakingtypes.MsgBeginRedelegate{},
+		),
+	}
+}
+
+func (d POAStakingDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if ctx.BlockHeight() == 0 || !d.poaKeeper.IsEnabled(ctx) {
+		return next(ctx, tx, simulate)
+	}
+
+	if d.changesValidatorSet(ctx, tx.GetMsgs()) {
+		return ctx, errPOAStaking
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+var errPOAStaking = fmt.Errorf("staking validator set changes are disabled in proof-of-authority mode")
+
+func (d POAStakingDecorator) changesValidatorSet(ctx sdk.Context, msgs []sdk.Msg) bool {
+	return d.filter.HasDisallowedMessage(ctx, msgs) || hasOperatorUnbonding(msgs)
+}
+
+type poaStakingPrecompile struct {
+	vm.PrecompiledContract
+	decorator POAStakingDecorator
+}
+
+// GuardStakingPrecompile wraps the staking precompile to apply the
+// POAStakingDecorator rules to its calls, including the calls from other
+// contracts, as the ante handler only sees the EVM txs.
+func GuardStakingPrecompile(precompile vm.PrecompiledContract, poaKeeper POAKeeper) vm.PrecompiledContract {
+	return poaStakingPrecompile{PrecompiledContract: precompile, decorator: NewPOAStakingDecorator(poaKeeper)}
+}
+
+// Run implements vm.PrecompiledContract.
+func (p poaStakingPrecompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	stateDB, ok := evm.StateDB.(*statedb.StateDB)
+	if !ok {
+		return nil, fmt.Errorf("invalid state db type %T", evm.StateDB)
+	}
+
+	ctx := stateDB.GetContext()
+	if p.decorator.poaKeeper.IsEnabled(ctx) {
+		if msg := stakingPrecompileCall(contract.Input); msg != nil && p.decorator.changesValidatorSet(ctx, []sdk.Msg{msg}) {
+			return nil, errPOAStaking
+		}
+	}
+
+	return p.PrecompiledContract.Run(evm, contract, readOnly)
+}
+
+// stakingPrecompileCall returns the x/staking message built by the staking
+// precompile for a call changing the delegations, or nil for the other calls.
+// The calls the precompile fails to decode are left to it.
+func stakingPrecompileCall(input []byte) sdk.Msg {
+	if len(input) < 4 {
+		return nil
+	}
+	method, err := stakingABI.MethodById(input[:4])
+	if err != nil {
+		return nil
+	}
+	args, err := method.Inputs.Unpack(input[4:])
+	if err != nil {
+		return nil
+	}
+
+	var msg sdk.Msg
+	switch method.Name {
+	case stakingprecompile.CreateValidatorMethod:
+		msg, _, err = stakingprecompile.NewMsgCreateValidator(args, "")
+	case stakingprecompile.DelegateMethod:
+		msg, _, err = stakingprecompile.NewMsgDelegate(args, "")
+	case stakingprecompile.RedelegateMethod:
+		msg, _, err = stakingprecompile.NewMsgRedelegate(args, "")
+	case stakingprecompile.UndelegateMethod:
+		msg, _, err = stakingprecompile.NewMsgUndelegate(args, "")
+	case stakingprecompile.CancelUnbondingDelegationMethod:
+		msg, _, err = stakingprecompile.NewMsgCancelUnbondingDelegation(args, "")
+	default:
+		return nil
+	}
+	if err != nil {
+		return nil
+	}
+	return msg
+}
+
+// hasOperatorUnbonding returns true if a validator operator unbonds from, or
+// cancels an unbonding of, its own validator.
+func hasOperatorUnbonding(msgs []sdk.Msg) bool {
+	for _, msg := range msgs {
+		var delegator, validator string
+		switch m := msg.(type) {
+		case *authz.MsgExec:
+			nested, err := m.GetMessages()
+			if err != nil || hasOperatorUnbonding(nested) {
+				return true
+			}
+			continue
+		case *stakingtypes.MsgUndelegate:
+			delegator, validator = m.DelegatorAddress, m.ValidatorAddress
+		case *stakingtypes.MsgCancelUnbondingDelegation:
+			delegator, validator = m.DelegatorAddress, m.ValidatorAddress
+		default:
+			continue
+		}
+
+		delAddr, err := sdk.AccAddressFromBech32(delegator)
+		if err != nil {
+			return true
+		}
+		valAddr, err := sdk.ValAddressFromBech32(validator)
+		if err != nil {
+			return true
+		}
+		if delAddr.Equals(sdk.AccAddress(valAddr)) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,60 @@
+package decorators_test
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/rollchains/flora/app/decorators"
+)
+
+type mockPOAKeeper bool
+
+func (k mockPOAKeeper) IsEnabled(context.Context) bool { return bool(k) }
+
+func (s *AnteTestSuite) TestAntePOAStaking() {
+	ctx := s.ctx.WithBlockHeight(10)
+
+	operator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	valAddr := sdk.ValAddress(operator)
+	delegator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	coin := sdk.NewCoin("stake", sdkmath.NewInt(1))
+
+	delegate := stakingtypes.NewMsgDelegate(delegator.String(), valAddr.String(), coin)
+	undelegate := stakingtypes.NewMsgUndelegate(delegator.String(), valAddr.String(), coin)
+	selfUndelegate := stakingtypes.NewMsgUndelegate(operator.String(), valAddr.String(), coin)
+
+	// staking is untouched when poa is disabled
+	ante := decorators.NewPOAStakingDecorator(mockPOAKeeper(false))
+	_, err := ante.AnteHandle(ctx, decorators.NewMockTx(delegate), false, decorators.EmptyAnte)
+	s.Require().NoError(err)
+
+	ante = decorators.NewPOAStakingDecorator(mockPOAKeeper(true))
+
+	// gentxs are allowed
+	_, err = ante.AnteHandle(ctx.WithBlockHeight(0), decorators.NewMockTx(delegate), false, decorators.EmptyAnte)
+	s.Require().NoError(err)
+
+	_, err = ante.AnteHandle(ctx, decorators.NewMockTx(delegate), false, decorators.EmptyAnte)
+	s.Require().Error(err)
+
+	exec := authz.NewMsgExec(delegator, []sdk.Msg{delegate})
+	_, err = ante.AnteHandle(ctx, decorators.NewMockTx(&exec), false, decorators.EmptyAnte)
+	s.Require().Error(err)
+
+	// delegators can leave, operators can't lower their admin set power
+	_, err = ante.AnteHandle(ctx, decorators.NewMockTx(undelegate), false, decorators.EmptyAnte)
+	s.Require().NoError(err)
+
+	_, err = ante.AnteHandle(ctx, decorators.NewMockTx(selfUndelegate), false, decorators.EmptyAnte)
+	s.Require().Error(err)
+
+	exec = authz.NewMsgExec(delegator, []sdk.Msg{selfUndelegate})
+	_, err = ante.AnteHandle(ctx, decorators.NewMockTx(&exec), false, decorators.EmptyAnte)
+	s.Require().Error(err)
+}
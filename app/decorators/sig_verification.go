@@ -0,0 +1,264 @@
+package decorators
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	errorsmod "cosmossdk.io/errors"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/evm/x/vm/core/vm"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/rollchains/flora/crypto/keys/eip1271"
+	"github.com/rollchains/flora/crypto/keys/passkey"
+	contractaccountstypes "github.com/rollchains/flora/x/contractaccounts/types"
+)
+
+// EIP1271Keeper calls the contracts validating the signatures of contract
+// accounts.
+type EIP1271Keeper interface {
+	ApplyMessage(ctx sdk.Context, msg core.Message, tracer vm.EVMLogger, commit bool) (*evmtypes.MsgEthereumTxResponse, error)
+}
+
+// ContractAccountsKeeper returns the gas cap of the isValidSignature calls
+// and the relying party id of the passkeys.
+type ContractAccountsKeeper interface {
+	GetParams(ctx context.Context) (contractaccountstypes.Params, error)
+}
+
+// SigVerificationDecorator verifies the signatures of the tx like the
+// x/auth SigVerificationDecorator, except for the contract accounts, the
+// accounts with an EIP-1271 public key: their signature is valid when
+// isValidSignature of the contract returns the magic value for the keccak256
+// of the sign bytes. The call is a static call capped to the signature gas cap
+// of the contractaccounts params, its gas is consumed by the tx. The
+// assertions of the passkeys are verified against the relying party id of the
+// contractaccounts params.
+//
+// The contract accounts and the passkeys can't be members of a multisig.
+type SigVerificationDecorator struct {
+	ak               ante.AccountKeeper
+	evmKeeper        EIP1271Keeper
+	contractAccounts ContractAccountsKeeper
+	signModeHandler  *txsigning.HandlerMap
+}
+
+// NewSigVerificationDecorator returns a new SigVerificationDecorator.
+func NewSigVerificationDecorator(ak ante.AccountKeeper, evmKeeper EIP1271Keeper, contractAccounts ContractAccountsKeeper, signModeHandler *txsigning.HandlerMap) SigVerificationDecorator {
+	return SigVerificationDecorator{
+		ak:               ak,
+		evmKeeper:        evmKeeper,
+		contractAccounts: contractAccounts,
+		signModeHandler:  signModeHandler,
+	}
+}
+
+func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+
+	if len(sigs) != len(signers) {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signers), len(sigs))
+	}
+
+	for i, sig := range sigs {
+		acc, err := ante.GetSignerAcc(ctx, svd.ak, signers[i])
+		if err != nil {
+			return ctx, err
+		}
+
+		pubKey := acc.GetPubKey()
+		if !simulate && pubKey == nil {
+			return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
+		}
+
+		if sig.Sequence != acc.GetSequence() {
+			return ctx, errorsmod.Wrapf(
+				sdkerrors.ErrWrongSequence,
+				"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
+			)
+		}
+
+		// the signature is not known when simulating, the gas cap of the call
+		// is consumed for the gas estimate to cover it
+		_, isContract := pubKey.(*eip1271.PubKey)
+		if simulate && isContract {
+			params, err := svd.contractAccounts.GetParams(ctx)
+			if err != nil {
+				return ctx, err
+			}
+			ctx.GasMeter().ConsumeGas(params.SignatureGasCap, "ante verify: eip1271")
+		}
+
+		if simulate || ctx.IsReCheckTx() || !ctx.IsSigverifyTx() {
+			continue
+		}
+
+		var accNum uint64
+		if ctx.BlockHeight() != 0 {
+			accNum = acc.GetAccountNumber()
+		}
+
+		anyPk, _ := codectypes.NewAnyWithValue(pubKey)
+		signerData := txsigning.SignerData{
+			Address:       acc.GetAddress().String(),
+			ChainID:       ctx.ChainID(),
+			AccountNumber: accNum,
+			Sequence:      acc.GetSequence(),
+			PubKey: &anypb.Any{
+				TypeUrl: anyPk.TypeUrl,
+				Value:   anyPk.Value,
+			},
+		}
+		adaptableTx, ok := tx.(authsigning.V2AdaptableTx)
+		if !ok {
+			return ctx, fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", tx)
+		}
+		txData := adaptableTx.GetSigningTxData()
+
+		switch pubKey := pubKey.(type) {
+		case *eip1271.PubKey:
+			err = svd.verifyContractSignature(ctx, pubKey, signerData, sig.Data, txData)
+		case *passkey.PubKey:
+			err = svd.verifyPasskeySignature(ctx, pubKey, signerData, sig.Data, txData)
+		default:
+			err = authsigning.VerifySignature(ctx, pubKey, signerData, sig.Data, svd.signModeHandler, txData)
+		}
+		if err != nil {
+			errMsg := fmt.Sprintf("signature verification failed; please verify account number (%d) and chain-id (%s): (%s)", accNum, ctx.ChainID(), err.Error())
+			return ctx, errorsmod.Wrap(sdkerrors.ErrUnauthorized, errMsg)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// verifyContractSignature calls isValidSignature on the contract of the
+// account with the sign bytes of the tx.
+func (svd SigVerificationDecorator) verifyContractSignature(
+	ctx sdk.Context,
+	pubKey cryptotypes.PubKey,
+	signerData txsigning.SignerData,
+	sigData signing.SignatureData,
+	txData txsigning.TxData,
+) error {
+	signBytes, signature, err := svd.getSignBytes(ctx, signerData, sigData, txData)
+	if err != nil {
+		return err
+	}
+
+	input, err := eip1271.IsValidSignatureInput(signBytes, signature)
+	if err != nil {
+		return err
+	}
+
+	params, err := svd.contractAccounts.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	contract := pubKey.(*eip1271.PubKey).Contract()
+	msg := ethtypes.NewMessage(
+		common.Address{},
+		&contract,
+		0,
+		big.NewInt(0), // amount
+		params.SignatureGasCap,
+		big.NewInt(0), // gasPrice
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		input,
+		ethtypes.AccessList{},
+		true, // isFake
+	)
+
+	res, err := svd.evmKeeper.ApplyMessage(ctx, msg, evmtypes.NewNoOpTracer(), false)
+	if err != nil {
+		return err
+	}
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "ante verify: eip1271")
+
+	if res.Failed() {
+		return fmt.Errorf("isValidSignature failed: %s", res.VmError)
+	}
+	if !eip1271.IsMagicValue(res.Ret) {
+		return fmt.Errorf("isValidSignature of %s rejected the signature", contract)
+	}
+	return nil
+}
+
+// verifyPasskeySignature verifies the WebAuthn assertion of the passkey over
+// the sign bytes of the tx, for the relying party id of the params.
+func (svd SigVerificationDecorator) verifyPasskeySignature(
+	ctx sdk.Context,
+	pubKey *passkey.PubKey,
+	signerData txsigning.SignerData,
+	sigData signing.SignatureData,
+	txData txsigning.TxData,
+) error {
+	signBytes, signature, err := svd.getSignBytes(ctx, signerData, sigData, txData)
+	if err != nil {
+		return err
+	}
+
+	var assertion passkey.Assertion
+	if err := assertion.Unmarshal(signature); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid passkey assertion: %s", err)
+	}
+
+	params, err := svd.contractAccounts.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	return assertion.Verify(*pubKey, signBytes, params.PasskeyRpId)
+}
+
+// getSignBytes returns the sign bytes of the tx for the sign mode of the
+// single signature, and the signature.
+func (svd SigVerificationDecorator) getSignBytes(
+	ctx sdk.Context,
+	signerData txsigning.SignerData,
+	sigData signing.SignatureData,
+	txData txsigning.TxData,
+) (signBytes, signature []byte, err error) {
+	single, ok := sigData.(*signing.SingleSignatureData)
+	if !ok {
+		return nil, nil, fmt.Errorf("expected %T, got %T", &signing.SingleSignatureData{}, sigData)
+	}
+
+	// the sign modes of the API mirror the internal ones
+	signMode := signingv1beta1.SignMode(single.SignMode)
+	signBytes, err = svd.signModeHandler.GetSignBytes(ctx, signMode, signerData, txData)
+	if err != nil {
+		return nil, nil, err
+	}
+	return signBytes, single.Signature, nil
+}
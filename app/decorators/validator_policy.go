@@ -0,0 +1,161 @@
+package decorators
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	stakingprecompile "github.com/cosmos/evm/precompiles/staking"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	validatorpolicytypes "github.com/rollchains/flora/x/validatorpolicy/types"
+)
+
+// stakingABI is parsed once, the ante handlers are rebuilt for every tx.
+var stakingABI = func() abi.ABI {
+	stakingABI, err := stakingprecompile.LoadABI()
+	if err != nil {
+		panic(err)
+	}
+	return stakingABI
+}()
+
+// ValidatorPolicyKeeper returns the governance set validator policy.
+type ValidatorPolicyKeeper interface {
+	GetParams(ctx context.Context) (validatorpolicytypes.Params, error)
+}
+
+// ValidatorKeeper returns the current state of a validator.
+type ValidatorKeeper interface {
+	GetValidator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error)
+}
+
+// ValidatorPolicyDecorator enforces the commission floor, the max commission
+// change and the min self delegation on validator creation and edits. The
+// messages are checked when sent directly, nested in an authz.MsgExec or as
+// an EVM call to the staking precompile.
+type ValidatorPolicyDecorator struct {
+	policyKeeper  ValidatorPolicyKeeper
+	stakingKeeper ValidatorKeeper
+}
+
+// NewValidatorPolicyDecorator returns a new ValidatorPolicyDecorator.
+func NewValidatorPolicyDecorator(policyKeeper ValidatorPolicyKeeper, stakingKeeper ValidatorKeeper) ValidatorPolicyDecorator {
+	return ValidatorPolicyDecorator{
+		policyKeeper:  policyKeeper,
+		stakingKeeper: stakingKeeper,
+	}
+}
+
+func (d ValidatorPolicyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	// gentxs are only bound by the x/staking rules
+	if ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	params, err := d.policyKeeper.GetParams(ctx)
+	if err != nil {
+		return ctx, err
+	}
+
+	if err := d.checkMsgs(ctx, params, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (d ValidatorPolicyDecorator) checkMsgs(ctx sdk.Context, params validatorpolicytypes.Params, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		switch m := msg.(type) {
+		case *authz.MsgExec:
+			nested, err := m.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := d.checkMsgs(ctx, params, nested); err != nil {
+				return err
+			}
+
+		case *evmtypes.MsgEthereumTx:
+			staking, err := d.stakingPrecompileMsg(m)
+			if err != nil {
+				return err
+			}
+			if staking != nil {
+				if err := d.checkMsgs(ctx, params, []sdk.Msg{staking}); err != nil {
+					return err
+				}
+			}
+
+		case *stakingtypes.MsgCreateValidator:
+			if err := params.CheckCreateValidator(m); err != nil {
+				return err
+			}
+
+		case *stakingtypes.MsgEditValidator:
+			valAddr, err := sdk.ValAddressFromBech32(m.ValidatorAddress)
+			if err != nil {
+				return err
+			}
+			validator, err := d.stakingKeeper.GetValidator(ctx, valAddr)
+			if err != nil {
+				return err
+			}
+			if err := params.CheckEditValidator(m, validator); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// stakingPrecompileMsg returns the x/staking message built by the staking
+// precompile for the given EVM transaction, or nil if the transaction is not a
+// createValidator or editValidator call to the precompile.
+func (d ValidatorPolicyDecorator) stakingPrecompileMsg(msg *evmtypes.MsgEthereumTx) (sdk.Msg, error) {
+	ethTx := msg.AsTransaction()
+	if ethTx.To() == nil || *ethTx.To() != common.HexToAddress(evmtypes.StakingPrecompileAddress) {
+		return nil, nil
+	}
+
+	data := ethTx.Data()
+	if len(data) < 4 {
+		return nil, nil
+	}
+
+	method, err := stakingABI.MethodById(data[:4])
+	if err != nil {
+		return nil, nil
+	}
+
+	switch method.Name {
+	case stakingprecompile.CreateValidatorMethod, stakingprecompile.EditValidatorMethod:
+	default:
+		return nil, nil
+	}
+
+	args, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil, validatorpolicytypes.ErrInvalidStakingPrecompileTx.Wrap(err.Error())
+	}
+
+	if method.Name == stakingprecompile.CreateValidatorMethod {
+		staking, _, err := stakingprecompile.NewMsgCreateValidator(args, "")
+		if err != nil {
+			return nil, validatorpolicytypes.ErrInvalidStakingPrecompileTx.Wrap(err.Error())
+		}
+		return staking, nil
+	}
+
+	staking, _, err := stakingprecompile.NewMsgEditValidator(args)
+	if err != nil {
+		return nil, validatorpolicytypes.ErrInvalidStakingPrecompileTx.Wrap(err.Error())
+	}
+	return staking, nil
+}
@@ -0,0 +1,112 @@
+package decorators_test
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	stakingprecompile "github.com/cosmos/evm/precompiles/staking"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/rollchains/flora/app/decorators"
+	validatorpolicytypes "github.com/rollchains/flora/x/validatorpolicy/types"
+)
+
+type mockValidatorPolicyKeeper struct {
+	params validatorpolicytypes.Params
+}
+
+func (k mockValidatorPolicyKeeper) GetParams(context.Context) (validatorpolicytypes.Params, error) {
+	return k.params, nil
+}
+
+type mockValidatorKeeper struct {
+	validator stakingtypes.Validator
+}
+
+func (k mockValidatorKeeper) GetValidator(context.Context, sdk.ValAddress) (stakingtypes.Validator, error) {
+	return k.validator, nil
+}
+
+func (s *AnteTestSuite) TestAnteValidatorPolicy() {
+	ctx := s.ctx.WithBlockHeight(10)
+
+	valAddr := sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+	validator := stakingtypes.Validator{
+		OperatorAddress: valAddr.String(),
+		Commission: stakingtypes.NewCommission(
+			sdkmath.LegacyNewDecWithPrec(5, 2), sdkmath.LegacyNewDecWithPrec(5, 1), sdkmath.LegacyNewDecWithPrec(1, 1),
+		),
+	}
+
+	// 5% floor, 1% max change per edit
+	ante := decorators.NewValidatorPolicyDecorator(
+		mockValidatorPolicyKeeper{params: validatorpolicytypes.DefaultParams()},
+		mockValidatorKeeper{validator: validator},
+	)
+
+	createValidator := func(rate sdkmath.LegacyDec) *stakingtypes.MsgCreateValidator {
+		return &stakingtypes.MsgCreateValidator{
+			ValidatorAddress:  valAddr.String(),
+			Commission:        stakingtypes.NewCommissionRates(rate, sdkmath.LegacyOneDec(), sdkmath.LegacyNewDecWithPrec(1, 1)),
+			MinSelfDelegation: sdkmath.OneInt(),
+		}
+	}
+	editValidator := func(rate sdkmath.LegacyDec) *stakingtypes.MsgEditValidator {
+		return stakingtypes.NewMsgEditValidator(valAddr.String(), stakingtypes.Description{}, &rate, nil)
+	}
+
+	_, err := ante.AnteHandle(ctx, decorators.NewMockTx(createValidator(sdkmath.LegacyZeroDec())), false, decorators.EmptyAnte)
+	s.Require().ErrorIs(err, validatorpolicytypes.ErrCommissionBelowFloor)
+
+	// gentxs are not bound by the policy
+	_, err = ante.AnteHandle(ctx.WithBlockHeight(0), decorators.NewMockTx(createValidator(sdkmath.LegacyZeroDec())), false, decorators.EmptyAnte)
+	s.Require().NoError(err)
+
+	_, err = ante.AnteHandle(ctx, decorators.NewMockTx(createValidator(sdkmath.LegacyNewDecWithPrec(5, 2))), false, decorators.EmptyAnte)
+	s.Require().NoError(err)
+
+	_, err = ante.AnteHandle(ctx, decorators.NewMockTx(editValidator(sdkmath.LegacyNewDecWithPrec(6, 2))), false, decorators.EmptyAnte)
+	s.Require().NoError(err)
+
+	_, err = ante.AnteHandle(ctx, decorators.NewMockTx(editValidator(sdkmath.LegacyNewDecWithPrec(4, 2))), false, decorators.EmptyAnte)
+	s.Require().ErrorIs(err, validatorpolicytypes.ErrCommissionBelowFloor)
+
+	_, err = ante.AnteHandle(ctx, decorators.NewMockTx(editValidator(sdkmath.LegacyNewDecWithPrec(7, 2))), false, decorators.EmptyAnte)
+	s.Require().ErrorIs(err, validatorpolicytypes.ErrCommissionChangeTooLarge)
+
+	// nested in authz
+	exec := authz.NewMsgExec(sdk.AccAddress(valAddr), []sdk.Msg{createValidator(sdkmath.LegacyZeroDec())})
+	_, err = ante.AnteHandle(ctx, decorators.NewMockTx(&exec), false, decorators.EmptyAnte)
+	s.Require().ErrorIs(err, validatorpolicytypes.ErrCommissionBelowFloor)
+
+	// through the staking precompile
+	stakingABI, err := stakingprecompile.LoadABI()
+	s.Require().NoError(err)
+
+	precompile := common.HexToAddress(evmtypes.StakingPrecompileAddress)
+	editValidatorCall := func(rate sdkmath.LegacyDec) *evmtypes.MsgEthereumTx {
+		input, err := stakingABI.Pack(
+			stakingprecompile.EditValidatorMethod,
+			stakingprecompile.Description{},
+			common.BytesToAddress(valAddr),
+			rate.BigInt(),
+			big.NewInt(stakingprecompile.DoNotModifyMinSelfDelegation),
+		)
+		s.Require().NoError(err)
+		return evmtypes.NewTx(&evmtypes.EvmTxArgs{To: &precompile, Input: input, GasLimit: 100_000})
+	}
+
+	_, err = ante.AnteHandle(ctx, decorators.NewMockTx(editValidatorCall(sdkmath.LegacyZeroDec())), false, decorators.EmptyAnte)
+	s.Require().ErrorIs(err, validatorpolicytypes.ErrCommissionBelowFloor)
+
+	_, err = ante.AnteHandle(ctx, decorators.NewMockTx(editValidatorCall(sdkmath.LegacyNewDecWithPrec(6, 2))), false, decorators.EmptyAnte)
+	s.Require().NoError(err)
+}
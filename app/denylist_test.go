@@ -0,0 +1,132 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/rollchains/flora/app/decorators"
+	"github.com/rollchains/flora/x/denylist"
+	denylistkeeper "github.com/rollchains/flora/x/denylist/keeper"
+	denylisttypes "github.com/rollchains/flora/x/denylist/types"
+)
+
+func TestDenylist(t *testing.T) {
+	gapp := Setup(t)
+	ctx := cronTestContext(t, gapp)
+	msgServer := denylistkeeper.NewMsgServerImpl(gapp.DenylistKeeper)
+	denom := evmtypes.GetEVMCoinDenom()
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(1000)))
+
+	newAccount := func() sdk.AccAddress {
+		addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		initAccountWithCoins(gapp, ctx, addr, coins)
+		return addr
+	}
+	sanctioned, other := newAccount(), newAccount()
+	sanctionedHex := common.BytesToAddress(sanctioned)
+
+	// a group policy of compliance officers manages the denylist
+	officers := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	_, err := msgServer.UpdateParams(ctx, &denylisttypes.MsgUpdateParams{
+		Authority: gapp.DenylistKeeper.GetAuthority(),
+		Params:    denylisttypes.NewParams([]string{officers}),
+	})
+	require.NoError(t, err)
+
+	_, err = msgServer.AddAddresses(ctx, &denylisttypes.MsgAddAddresses{Sender: other.String(), Addresses: []string{sanctioned.String()}})
+	require.ErrorIs(t, err, denylisttypes.ErrNotAnAdmin)
+	_, err = msgServer.AddAddresses(ctx, &denylisttypes.MsgAddAddresses{Sender: officers, Addresses: []string{sanctionedHex.Hex()}})
+	require.NoError(t, err)
+
+	res, err := denylistkeeper.NewQuerier(gapp.DenylistKeeper).Denied(ctx, &denylisttypes.QueryDeniedRequest{Address: sanctioned.String()})
+	require.NoError(t, err)
+	require.True(t, res.Denied)
+
+	// bank sends from and to denied addresses, module accounts still pay out
+	require.ErrorIs(t, gapp.BankKeeper.SendCoins(ctx, sanctioned, other, coins), denylisttypes.ErrAddressDenied)
+	require.ErrorIs(t, gapp.BankKeeper.SendCoins(ctx, other, sanctioned, coins), denylisttypes.ErrAddressDenied)
+	require.NoError(t, gapp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, gapp.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sanctioned, coins))
+
+	var blocked bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == denylisttypes.EventTypeBlocked {
+			blocked = true
+		}
+	}
+	require.True(t, blocked)
+
+	// EVM transactions, calls and value transfers from inside contracts
+	ante := decorators.NewDenylistDecorator(gapp.DenylistKeeper)
+	ethTx := func(from, to common.Address) error {
+		msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{To: &to, GasLimit: 100_000})
+		msg.From = from.Hex()
+		_, err := ante.AnteHandle(ctx, decorators.NewMockTx(msg), false, decorators.EmptyAnte)
+		return err
+	}
+	require.ErrorIs(t, ethTx(sanctionedHex, common.BytesToAddress(other)), denylisttypes.ErrAddressDenied)
+	require.ErrorIs(t, ethTx(common.BytesToAddress(other), sanctionedHex), denylisttypes.ErrAddressDenied)
+	require.NoError(t, ethTx(common.BytesToAddress(other), common.BytesToAddress(other)))
+
+	_, err = gapp.EVMKeeper.CallEVMWithData(ctx, common.BytesToAddress(other), &sanctionedHex, nil, false)
+	require.ErrorContains(t, err, denylisttypes.ErrAddressDenied.Error())
+
+	payerCode := append(append(common.FromHex("0x6000600060006000600173"), sanctionedHex.Bytes()...), common.FromHex("0x5af115602657005b60006000fd")...)
+	payer := deployCronTestContract(t, gapp, ctx, payerCode)
+	_, err = gapp.EVMKeeper.CallEVMWithData(ctx, common.BytesToAddress(other), &payer, nil, false)
+	require.Error(t, err)
+
+	// a contract created at a denied address can't forward the funds of the
+	// address from its constructor
+	forwarderCode := append(append(common.FromHex("0x60006000600060004773"), other.Bytes()...), common.FromHex("0x5af115602557005b60006000fd")...)
+	factory := deployCronTestContract(t, gapp, ctx, common.FromHex("0x36600060003736600034f015601057005b60006000fd"))
+	forwarder := crypto.CreateAddress(factory, 0)
+	initAccountWithCoins(gapp, ctx, forwarder.Bytes(), coins)
+	_, err = msgServer.AddAddresses(ctx, &denylisttypes.MsgAddAddresses{Sender: officers, Addresses: []string{forwarder.Hex()}})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{sanctionedHex.Hex(), forwarder.Hex()}, gapp.EVMKeeper.GetParams(ctx).AccessControl.Call.AccessControlList)
+
+	_, err = gapp.EVMKeeper.CallEVMWithData(ctx, common.BytesToAddress(other), &factory, forwarderCode, false)
+	require.ErrorContains(t, err, "execution reverted")
+
+	// inbound and outbound ICS20 transfers
+	packetData := func(sender, receiver string) []byte {
+		return transfertypes.NewFungibleTokenPacketData(denom, "1000", sender, receiver, "").GetBytes()
+	}
+	ics4Wrapper := denylist.NewICS4Wrapper(nil, gapp.DenylistKeeper)
+	_, err = ics4Wrapper.SendPacket(ctx, nil, "transfer", "channel-0", clienttypes.NewHeight(1, 100), 0, packetData(sanctioned.String(), "cosmos1receiver"))
+	require.ErrorIs(t, err, denylisttypes.ErrAddressDenied)
+
+	middleware := denylist.NewIBCMiddleware(nil, gapp.DenylistKeeper)
+	packet := channeltypes.NewPacket(packetData("cosmos1sender", sanctioned.String()), 1, "transfer", "channel-0", "transfer", "channel-1", clienttypes.NewHeight(1, 100), 0)
+	require.False(t, middleware.OnRecvPacket(ctx, packet, other).Success())
+
+	// removed addresses transfer again
+	_, err = msgServer.RemoveAddresses(ctx, &denylisttypes.MsgRemoveAddresses{Sender: officers, Addresses: []string{sanctioned.String()}})
+	require.NoError(t, err)
+	require.NoError(t, gapp.BankKeeper.SendCoins(ctx, sanctioned, other, coins))
+	_, err = gapp.EVMKeeper.CallEVMWithData(ctx, common.BytesToAddress(other), &payer, nil, false)
+	require.NoError(t, err)
+
+	_, err = msgServer.RemoveAddresses(ctx, &denylisttypes.MsgRemoveAddresses{Sender: officers, Addresses: []string{forwarder.Hex()}})
+	require.NoError(t, err)
+	require.Empty(t, gapp.EVMKeeper.GetParams(ctx).AccessControl.Call.AccessControlList)
+	balance := gapp.BankKeeper.GetBalance(ctx, other, denom)
+	_, err = gapp.EVMKeeper.CallEVMWithData(ctx, common.BytesToAddress(other), &factory, forwarderCode, true)
+	require.NoError(t, err)
+	require.True(t, gapp.BankKeeper.GetAllBalances(ctx, forwarder.Bytes()).IsZero())
+	require.Equal(t, balance.Add(coins[0]), gapp.BankKeeper.GetBalance(ctx, other, denom))
+}
@@ -0,0 +1,127 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	deploypolicykeeper "github.com/rollchains/flora/x/deploypolicy/keeper"
+	deploypolicytypes "github.com/rollchains/flora/x/deploypolicy/types"
+)
+
+var (
+	// emptyInitCode deploys a contract without code.
+	emptyInitCode = common.FromHex("0x00")
+	// factoryCode deploys a contract without code on every call and reverts
+	// when the deployment fails.
+	factoryCode = common.FromHex("0x600060006000f015600c57005b60006000fd")
+)
+
+func TestDeployPolicyAllowlist(t *testing.T) {
+	gapp := Setup(t)
+	ctx := cronTestContext(t, gapp)
+	msgServer := deploypolicykeeper.NewMsgServerImpl(gapp.DeployPolicyKeeper)
+
+	newAccount := func() common.Address {
+		addr := common.BytesToAddress(secp256k1.GenPrivKey().PubKey().Address())
+		gapp.AccountKeeper.SetAccount(ctx, gapp.AccountKeeper.NewAccountWithAddress(ctx, addr.Bytes()))
+		return addr
+	}
+	deployer, other := newAccount(), newAccount()
+	factory := deployCronTestContract(t, gapp, ctx, factoryCode)
+
+	// a group policy manages the allowlist
+	groupPolicy := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	_, err := msgServer.UpdateParams(ctx, &deploypolicytypes.MsgUpdateParams{
+		Authority: gapp.DeployPolicyKeeper.GetAuthority(),
+		Params:    deploypolicytypes.NewParams(deploypolicytypes.ModeAllowlist, []string{groupPolicy}),
+	})
+	require.NoError(t, err)
+
+	_, err = msgServer.AddDeployers(ctx, &deploypolicytypes.MsgAddDeployers{
+		Sender: sdk.AccAddress(other.Bytes()).String(), Deployers: []string{other.Hex()},
+	})
+	require.ErrorIs(t, err, deploypolicytypes.ErrNotAnAdmin)
+	_, err = msgServer.AddDeployers(ctx, &deploypolicytypes.MsgAddDeployers{Sender: groupPolicy, Deployers: []string{deployer.Hex()}})
+	require.NoError(t, err)
+
+	create := gapp.EVMKeeper.GetParams(ctx).AccessControl.Create
+	require.Equal(t, evmtypes.AccessTypePermissioned, create.AccessType)
+	require.Equal(t, []string{deployer.Hex()}, create.AccessControlList)
+
+	// contract creation txs
+	_, err = gapp.EVMKeeper.CallEVMWithData(ctx, other, nil, emptyInitCode, false)
+	require.ErrorContains(t, err, "does not have permission to deploy contracts")
+	_, err = gapp.EVMKeeper.CallEVMWithData(ctx, deployer, nil, emptyInitCode, false)
+	require.NoError(t, err)
+
+	// CREATE from inside contracts, allowed for allowlisted signers and
+	// factories
+	_, err = gapp.EVMKeeper.CallEVMWithData(ctx, other, &factory, nil, false)
+	require.Error(t, err)
+	_, err = gapp.EVMKeeper.CallEVMWithData(ctx, deployer, &factory, nil, false)
+	require.NoError(t, err)
+
+	_, err = msgServer.AddDeployers(ctx, &deploypolicytypes.MsgAddDeployers{Sender: groupPolicy, Deployers: []string{factory.Hex()}})
+	require.NoError(t, err)
+	_, err = gapp.EVMKeeper.CallEVMWithData(ctx, other, &factory, nil, false)
+	require.NoError(t, err)
+
+	// opening the deployments resets the EVM access control
+	_, err = msgServer.UpdateParams(ctx, &deploypolicytypes.MsgUpdateParams{
+		Authority: gapp.DeployPolicyKeeper.GetAuthority(),
+		Params:    deploypolicytypes.DefaultParams(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, evmtypes.AccessTypePermissionless, gapp.EVMKeeper.GetParams(ctx).AccessControl.Create.AccessType)
+	_, err = gapp.EVMKeeper.CallEVMWithData(ctx, other, nil, emptyInitCode, false)
+	require.NoError(t, err)
+}
+
+func TestDeployPolicyCodeHash(t *testing.T) {
+	gapp := Setup(t)
+	ctx := cronTestContext(t, gapp)
+	msgServer := deploypolicykeeper.NewMsgServerImpl(gapp.DeployPolicyKeeper)
+	authority := gapp.DeployPolicyKeeper.GetAuthority()
+
+	deployer := common.BytesToAddress(secp256k1.GenPrivKey().PubKey().Address())
+	gapp.AccountKeeper.SetAccount(ctx, gapp.AccountKeeper.NewAccountWithAddress(ctx, deployer.Bytes()))
+	_, err := msgServer.UpdateParams(ctx, &deploypolicytypes.MsgUpdateParams{
+		Authority: authority,
+		Params:    deploypolicytypes.NewParams(deploypolicytypes.ModeAllowlist, nil),
+	})
+	require.NoError(t, err)
+	_, err = msgServer.AddDeployers(ctx, &deploypolicytypes.MsgAddDeployers{Sender: authority, Deployers: []string{deployer.Hex()}})
+	require.NoError(t, err)
+
+	// the creation code can be approved ahead of the mode
+	codeHash := deploypolicytypes.CodeHash(factoryCode).Hex()
+	_, err = msgServer.ApproveCode(ctx, &deploypolicytypes.MsgApproveCode{Sender: authority, CodeHashes: []string{codeHash}})
+	require.NoError(t, err)
+	res, err := deploypolicykeeper.NewQuerier(gapp.DeployPolicyKeeper).CodeHashes(ctx, &deploypolicytypes.QueryCodeHashesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{codeHash}, res.CodeHashes)
+
+	// a factory deploys unapproved code for an allowlisted signer, the EVM
+	// create hooks don't see the creation code, so the code hash mode is
+	// rejected
+	factory := deployCronTestContract(t, gapp, ctx, factoryCode)
+	_, err = gapp.EVMKeeper.CallEVMWithData(ctx, deployer, &factory, nil, false)
+	require.NoError(t, err)
+
+	codeHashParams := deploypolicytypes.NewParams(deploypolicytypes.ModeCodeHash, nil)
+	_, err = msgServer.UpdateParams(ctx, &deploypolicytypes.MsgUpdateParams{Authority: authority, Params: codeHashParams})
+	require.ErrorContains(t, err, "can't be enforced on the deployments from inside contracts")
+	params, err := gapp.DeployPolicyKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, deploypolicytypes.ModeAllowlist, params.Mode)
+	require.Error(t, deploypolicytypes.NewGenesisState(codeHashParams, nil, []string{codeHash}).Validate())
+
+	_, err = msgServer.RevokeCode(ctx, &deploypolicytypes.MsgRevokeCode{Sender: authority, CodeHashes: []string{codeHash}})
+	require.NoError(t, err)
+}
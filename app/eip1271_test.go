@@ -0,0 +1,123 @@
+package app
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/rollchains/flora/crypto/keys/eip1271"
+	contractaccountstypes "github.com/rollchains/flora/x/contractaccounts/types"
+)
+
+// approvedHashWalletCode returns the magic value from isValidSignature when
+// the hash is the one approved in the storage slot 0, like the pre-approved
+// messages of a Safe.
+var approvedHashWalletCode = common.FromHex("0x60043560005414600f5760206000f35b631626ba7e60e01b60005260206000f3")
+
+// eip1271TestContext returns a signed test context proposed by the genesis
+// validator, as the EVM resolves the coinbase from the block proposer.
+func eip1271TestContext(t *testing.T, gapp *ChainApp) sdk.Context {
+	t.Helper()
+
+	ctx := signedTestContext(gapp.BaseApp.NewContext(false))
+	validators, err := gapp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	consAddr, err := validators[0].GetConsAddr()
+	require.NoError(t, err)
+
+	header := ctx.BlockHeader()
+	header.ProposerAddress = consAddr
+	return ctx.WithBlockHeader(header)
+}
+
+// newContractAccount deploys the code at a new address and funds it to pay
+// the fees of its txs.
+func newContractAccount(t *testing.T, gapp *ChainApp, ctx sdk.Context, code []byte) common.Address {
+	t.Helper()
+
+	addr := common.BytesToAddress(secp256k1.GenPrivKey().PubKey().Address())
+	codeHash := crypto.Keccak256(code)
+	gapp.EVMKeeper.SetCode(ctx, codeHash, code)
+	require.NoError(t, gapp.EVMKeeper.SetAccount(ctx, addr, statedb.Account{Balance: big.NewInt(0), CodeHash: codeHash}))
+
+	initAccountWithCoins(gapp, ctx, addr.Bytes(), sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewIntWithDecimal(1, 18))))
+	return addr
+}
+
+func TestContractAccountSignedTx(t *testing.T) {
+	gapp := Setup(t)
+	ctx := eip1271TestContext(t, gapp)
+
+	wallet := newContractAccount(t, gapp, ctx, approvedHashWalletCode)
+	pubKey := eip1271.NewPubKey(wallet)
+
+	signTx := func(sequence uint64, approve bool) sdk.Tx {
+		return signTestTx(t, gapp, ctx, pubKey, sequence, func(signBytes []byte) []byte {
+			if approve {
+				gapp.EVMKeeper.SetState(ctx, wallet, common.Hash{}, eip1271.SignBytesHash(signBytes).Bytes())
+			}
+			return []byte{0x01}
+		})
+	}
+
+	_, err := gapp.AnteHandler()(ctx, signTx(0, false), false)
+	require.ErrorContains(t, err, "rejected the signature")
+
+	gasBefore := ctx.GasMeter().GasConsumed()
+	newCtx, err := gapp.AnteHandler()(ctx, signTx(0, true), false)
+	require.NoError(t, err)
+	require.True(t, pubKey.Equals(gapp.AccountKeeper.GetAccount(newCtx, wallet.Bytes()).GetPubKey()))
+	require.Greater(t, newCtx.GasMeter().GasConsumed(), gasBefore)
+
+	// the approval is bound to the sign bytes, including the sequence
+	_, err = gapp.AnteHandler()(newCtx, signTx(1, false), false)
+	require.ErrorContains(t, err, "rejected the signature")
+
+	// accounts without code can't use the contract account keys
+	eoa := eip1271.NewPubKey(common.BytesToAddress(secp256k1.GenPrivKey().PubKey().Address()))
+	initAccountWithCoins(gapp, ctx, eoa.Address().Bytes(), sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewIntWithDecimal(1, 18))))
+	_, err = gapp.AnteHandler()(ctx, signTestTx(t, gapp, ctx, eoa, 0, func([]byte) []byte { return []byte{0x01} }), false)
+	require.ErrorContains(t, err, "signature verification failed")
+}
+
+func TestContractAccountSignatureGasCap(t *testing.T) {
+	gapp := Setup(t)
+	ctx := eip1271TestContext(t, gapp)
+
+	wallet := newContractAccount(t, gapp, ctx, approvedHashWalletCode)
+	pubKey := eip1271.NewPubKey(wallet)
+	signTx := func() sdk.Tx {
+		return signTestTx(t, gapp, ctx, pubKey, 0, func(signBytes []byte) []byte {
+			gapp.EVMKeeper.SetState(ctx, wallet, common.Hash{}, eip1271.SignBytesHash(signBytes).Bytes())
+			return []byte{0x01}
+		})
+	}
+	simulate := func() uint64 {
+		simCtx, _ := ctx.CacheContext()
+		simCtx = simCtx.WithGasMeter(storetypes.NewGasMeter(1_000_000))
+		newCtx, err := gapp.AnteHandler()(simCtx, signTx(), true)
+		require.NoError(t, err)
+		return newCtx.GasMeter().GasConsumed()
+	}
+
+	// the simulations consume the whole gas cap
+	simGas := simulate()
+	require.NoError(t, gapp.ContractAccountsKeeper.Params.Set(ctx, contractaccountstypes.NewParams(contractaccountstypes.DefaultSignatureGasCap*2, "")))
+	require.Equal(t, simGas+contractaccountstypes.DefaultSignatureGasCap, simulate())
+
+	// the isValidSignature call runs out of gas above the intrinsic gas
+	require.NoError(t, gapp.ContractAccountsKeeper.Params.Set(ctx, contractaccountstypes.NewParams(23_000, "")))
+	_, err := gapp.AnteHandler()(ctx, signTx(), false)
+	require.ErrorContains(t, err, "isValidSignature failed")
+}
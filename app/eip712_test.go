@@ -0,0 +1,124 @@
+package app
+
+import (
+	"testing"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/rollchains/flora/client/eip712"
+	deploypolicytypes "github.com/rollchains/flora/x/deploypolicy/types"
+)
+
+// eip712TestTx returns the builder of an unsigned tx with the messages, paying
+// its fee in the EVM denom.
+func eip712TestTx(t *testing.T, gapp *ChainApp, msgs ...sdk.Msg) client.TxBuilder {
+	t.Helper()
+
+	denom := evmtypes.GetEVMCoinDenom()
+	builder := gapp.TxConfig().NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msgs...))
+	builder.SetGasLimit(400_000)
+	builder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntWithDecimal(1, 15))))
+	return builder
+}
+
+func TestEIP712SignedTx(t *testing.T) {
+	gapp := Setup(t)
+	ctx := signedTestContext(gapp.BaseApp.NewContext(false)).WithChainID(ChainID)
+
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	key, err := priv.ToECDSA()
+	require.NoError(t, err)
+	pubKey := priv.PubKey()
+	addr := sdk.AccAddress(pubKey.Address())
+	initAccountWithCoins(gapp, ctx, addr, sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewIntWithDecimal(1, 18))))
+	acc := gapp.AccountKeeper.GetAccount(ctx, addr)
+
+	to := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	send := banktypes.NewMsgSend(addr, to, sdk.NewCoins(sdk.NewInt64Coin(evmtypes.GetEVMCoinDenom(), 1)))
+
+	signerData := func(sequence uint64) authsigning.SignerData {
+		return authsigning.SignerData{
+			Address:       addr.String(),
+			ChainID:       ChainID,
+			AccountNumber: acc.GetAccountNumber(),
+			Sequence:      sequence,
+			PubKey:        pubKey,
+		}
+	}
+	sign := func(hash []byte) []byte {
+		sig, err := ethcrypto.Sign(hash, key)
+		require.NoError(t, err)
+		sig[ethcrypto.RecoveryIDOffset] += 27 // as signed by the wallets
+		return sig
+	}
+
+	t.Run("typed", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		builder := eip712TestTx(t, gapp, send)
+		require.NoError(t, eip712.SetSignature(builder, pubKey, 0, nil))
+
+		typedData, err := eip712.TypedData(ctx, gapp.TxConfig().SignModeHandler(), signerData(0), builder.GetTx())
+		require.NoError(t, err)
+		hash, err := eip712.Hash(typedData)
+		require.NoError(t, err)
+
+		// the signature of another sequence is rejected
+		otherData, err := eip712.TypedData(ctx, gapp.TxConfig().SignModeHandler(), signerData(1), builder.GetTx())
+		require.NoError(t, err)
+		otherHash, err := eip712.Hash(otherData)
+		require.NoError(t, err)
+		require.NoError(t, eip712.SetSignature(builder, pubKey, 0, sign(otherHash)))
+		_, err = gapp.AnteHandler()(ctx, builder.GetTx(), false)
+		require.ErrorContains(t, err, "signature verification failed")
+
+		require.NoError(t, eip712.SetSignature(builder, pubKey, 0, sign(hash)))
+		_, err = gapp.AnteHandler()(ctx, builder.GetTx(), false)
+		require.NoError(t, err)
+	})
+
+	t.Run("legacy", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		// any registered message can be signed
+		msg := &deploypolicytypes.MsgAddDeployers{Sender: addr.String(), Deployers: []string{"0x1111111111111111111111111111111111111111"}}
+		builder := eip712TestTx(t, gapp, msg)
+		require.NoError(t, eip712.SetLegacySignature(builder, ChainID, pubKey, 0, nil))
+
+		typedData, err := eip712.LegacyTypedData(gapp.AppCodec(), signerData(0), builder.GetTx(), addr)
+		require.NoError(t, err)
+		hash, err := eip712.Hash(typedData)
+		require.NoError(t, err)
+
+		// a signature of another key is rejected
+		otherPriv, err := ethsecp256k1.GenerateKey()
+		require.NoError(t, err)
+		otherKey, err := otherPriv.ToECDSA()
+		require.NoError(t, err)
+		otherSig, err := ethcrypto.Sign(hash, otherKey)
+		require.NoError(t, err)
+		require.NoError(t, eip712.SetLegacySignature(builder, ChainID, pubKey, 0, otherSig))
+		_, err = gapp.AnteHandler()(ctx, builder.GetTx(), false)
+		require.ErrorContains(t, err, "unable to verify the signature")
+
+		require.NoError(t, eip712.SetLegacySignature(builder, ChainID, pubKey, 0, sign(hash)))
+		newCtx, err := gapp.AnteHandler()(ctx, builder.GetTx(), false)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), gapp.AccountKeeper.GetAccount(newCtx, addr).GetSequence())
+
+		// the messages of legacy txs are of a single type
+		_, err = eip712.LegacyTypedData(gapp.AppCodec(), signerData(1), eip712TestTx(t, gapp, msg, send).GetTx(), addr)
+		require.ErrorContains(t, err, "single type")
+	})
+}
@@ -0,0 +1,179 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	circuittypes "cosmossdk.io/x/circuit/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	oracleprecompile "github.com/rollchains/flora/precompiles/oracle"
+	evmcircuitkeeper "github.com/rollchains/flora/x/evmcircuit/keeper"
+	evmcircuittypes "github.com/rollchains/flora/x/evmcircuit/types"
+	oracletypes "github.com/rollchains/flora/x/oracle/types"
+)
+
+func TestEVMCircuitAuthorization(t *testing.T) {
+	gapp := Setup(t)
+	ctx := gapp.BaseApp.NewContext(false)
+	msgServer := evmcircuitkeeper.NewMsgServerImpl(gapp.EVMCircuitKeeper)
+	querier := evmcircuitkeeper.NewQuerier(gapp.EVMCircuitKeeper)
+
+	admin := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	bankAdmin := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	require.NoError(t, gapp.CircuitKeeper.Permissions.Set(ctx, admin, circuittypes.Permissions{
+		Level:         circuittypes.Permissions_LEVEL_SOME_MSGS,
+		LimitTypeUrls: []string{evmcircuitkeeper.EthereumTxTypeURL},
+	}))
+	require.NoError(t, gapp.CircuitKeeper.Permissions.Set(ctx, bankAdmin, circuittypes.Permissions{
+		Level:         circuittypes.Permissions_LEVEL_SOME_MSGS,
+		LimitTypeUrls: []string{"/cosmos.bank.v1beta1.MsgSend"},
+	}))
+
+	contract := "0x1000000000000000000000000000000000000001"
+	trip := &evmcircuittypes.MsgTripBreaker{Authority: bankAdmin.String(), Address: contract, Selector: "0xdeadbeef"}
+	_, err := msgServer.TripBreaker(ctx, trip)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	trip.Authority = admin.String()
+	_, err = msgServer.TripBreaker(ctx, trip)
+	require.NoError(t, err)
+	require.Equal(t, evmcircuittypes.EventTypeTripBreaker, ctx.EventManager().Events()[0].Type)
+	_, err = msgServer.TripBreaker(ctx, trip)
+	require.ErrorIs(t, err, evmcircuittypes.ErrInvalidBreaker)
+
+	res, err := querier.Breakers(ctx, &evmcircuittypes.QueryBreakersRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []evmcircuittypes.Breaker{{Address: common.HexToAddress(contract).Hex(), Selector: "0xdeadbeef", TrippedBy: admin.String()}}, res.Breakers)
+
+	tripped, err := querier.Tripped(ctx, &evmcircuittypes.QueryTrippedRequest{Address: contract, Selector: "0xdeadbeef"})
+	require.NoError(t, err)
+	require.True(t, tripped.Tripped)
+	tripped, err = querier.Tripped(ctx, &evmcircuittypes.QueryTrippedRequest{Address: contract})
+	require.NoError(t, err)
+	require.False(t, tripped.Tripped)
+
+	// the circuit authority can reset the breakers of the admins
+	reset := &evmcircuittypes.MsgResetBreaker{Authority: sdk.AccAddress(gapp.CircuitKeeper.GetAuthority()).String(), Address: contract, Selector: "0xdeadbeef"}
+	_, err = msgServer.ResetBreaker(ctx, reset)
+	require.NoError(t, err)
+	_, err = msgServer.ResetBreaker(ctx, reset)
+	require.ErrorIs(t, err, evmcircuittypes.ErrBreakerNotTripped)
+}
+
+func TestEVMCircuitCalls(t *testing.T) {
+	gapp := Setup(t)
+	ctx := oracleTestContext(t, gapp, oracletypes.DefaultParams())
+	caller := common.BytesToAddress(secp256k1.GenPrivKey().PubKey().Address())
+	gapp.AccountKeeper.SetAccount(ctx, gapp.AccountKeeper.NewAccountWithAddress(ctx, caller.Bytes()))
+
+	// the price feed is a dynamic precompile
+	feedABI, err := oracleprecompile.LoadABI()
+	require.NoError(t, err)
+	feed := oracletypes.FeedAddress("ETH/USD")
+	decimals := feedABI.Methods["decimals"].ID
+
+	require.NoError(t, gapp.EVMCircuitKeeper.Trip(ctx, feed, decimals, "admin"))
+	_, err = gapp.EVMKeeper.CallEVM(ctx, feedABI, caller, feed, false, "decimals")
+	require.ErrorContains(t, err, evmcircuittypes.ErrBreakerTripped.Error())
+	_, err = gapp.EVMKeeper.CallEVM(ctx, feedABI, caller, feed, false, "description")
+	require.NoError(t, err)
+
+	require.NoError(t, gapp.EVMCircuitKeeper.Reset(ctx, feed, decimals, "admin"))
+	_, err = gapp.EVMKeeper.CallEVM(ctx, feedABI, caller, feed, false, "decimals")
+	require.NoError(t, err)
+
+	// a tripped contract can't be called directly nor by other contracts
+	counter := deployCronTestContract(t, gapp, ctx, counterCode)
+	proxyCode := append(append(common.FromHex("0x6000600060006000600073"), counter.Bytes()...), common.FromHex("0x5af115602657005b60006000fd")...)
+	proxy := deployCronTestContract(t, gapp, ctx, proxyCode)
+
+	_, err = gapp.EVMKeeper.CallEVMWithData(ctx, caller, &proxy, nil, false)
+	require.NoError(t, err)
+
+	require.NoError(t, gapp.EVMCircuitKeeper.Trip(ctx, counter, nil, "admin"))
+	_, err = gapp.EVMKeeper.CallEVMWithData(ctx, caller, &counter, nil, false)
+	require.ErrorContains(t, err, evmcircuittypes.ErrBreakerTripped.Error())
+	_, err = gapp.EVMKeeper.CallEVMWithData(ctx, caller, &proxy, nil, false)
+	require.Error(t, err)
+}
+
+func TestEVMCircuitSelectorCalls(t *testing.T) {
+	gapp := Setup(t)
+	ctx := cronTestContext(t, gapp)
+	caller := common.BytesToAddress(secp256k1.GenPrivKey().PubKey().Address())
+	gapp.AccountKeeper.SetAccount(ctx, gapp.AccountKeeper.NewAccountWithAddress(ctx, caller.Bytes()))
+
+	// the proxy forwards its input to the counter and reverts if the call fails
+	counter := deployCronTestContract(t, gapp, ctx, counterCode)
+	proxyCode := append(append(common.FromHex("0x366000600037600060003660006000"), append([]byte{0x73}, counter.Bytes()...)...), common.FromHex("0x5af115602b57005b60006000fd")...)
+	proxy := deployCronTestContract(t, gapp, ctx, proxyCode)
+
+	selector := common.FromHex("0xdeadbeef")
+	require.NoError(t, gapp.EVMCircuitKeeper.Trip(ctx, counter, selector, "admin"))
+
+	_, err := gapp.EVMKeeper.CallEVMWithData(ctx, caller, &counter, selector, false)
+	require.ErrorContains(t, err, evmcircuittypes.ErrBreakerTripped.Error())
+	_, err = gapp.EVMKeeper.CallEVMWithData(ctx, caller, &proxy, selector, false)
+	require.ErrorContains(t, err, "execution reverted")
+
+	// the other functions of the contract still run, directly and from the proxy
+	_, err = gapp.EVMKeeper.CallEVMWithData(ctx, caller, &counter, common.FromHex("0xcafebabe"), true)
+	require.NoError(t, err)
+	_, err = gapp.EVMKeeper.CallEVMWithData(ctx, caller, &proxy, common.FromHex("0xcafebabe"), true)
+	require.NoError(t, err)
+	require.Equal(t, common.BigToHash(common.Big2), gapp.EVMKeeper.GetState(ctx, counter, common.Hash{}))
+
+	require.NoError(t, gapp.EVMCircuitKeeper.Reset(ctx, counter, selector, "admin"))
+	_, err = gapp.EVMKeeper.CallEVMWithData(ctx, caller, &proxy, selector, false)
+	require.NoError(t, err)
+}
+
+func TestEVMCircuitCallerContextCalls(t *testing.T) {
+	gapp := Setup(t)
+	ctx := cronTestContext(t, gapp)
+	caller := common.BytesToAddress(secp256k1.GenPrivKey().PubKey().Address())
+	gapp.AccountKeeper.SetAccount(ctx, gapp.AccountKeeper.NewAccountWithAddress(ctx, caller.Bytes()))
+
+	// the implementation has a tripped function unrelated to the calls
+	implementation := deployCronTestContract(t, gapp, ctx, counterCode)
+	require.NoError(t, gapp.EVMCircuitKeeper.Trip(ctx, implementation, common.FromHex("0xdeadbeef"), "admin"))
+
+	// the proxy forwards its input to the implementation by DELEGATECALL and
+	// reverts if the call fails
+	delegateProxyCode := append(append(common.FromHex("0x3660006000376000600036600073"), implementation.Bytes()...), common.FromHex("0x5af415602957005b60006000fd")...)
+	delegateProxy := deployCronTestContract(t, gapp, ctx, delegateProxyCode)
+	// the library forwards its input to the implementation by CALLCODE
+	callCodeProxyCode := append(append(common.FromHex("0x366000600037600060003660006000"), append([]byte{0x73}, implementation.Bytes()...)...), common.FromHex("0x5af215602b57005b60006000fd")...)
+	callCodeProxy := deployCronTestContract(t, gapp, ctx, callCodeProxyCode)
+
+	// the implementation runs against the storage of the callers, which the
+	// breakers of the implementation don't guard
+	for _, proxy := range []common.Address{delegateProxy, callCodeProxy} {
+		_, err := gapp.EVMKeeper.CallEVMWithData(ctx, caller, &proxy, common.FromHex("0xcafebabe"), true)
+		require.NoError(t, err)
+		_, err = gapp.EVMKeeper.CallEVMWithData(ctx, caller, &proxy, common.FromHex("0xdeadbeef"), true)
+		require.NoError(t, err)
+		require.Equal(t, common.BigToHash(common.Big2), gapp.EVMKeeper.GetState(ctx, proxy, common.Hash{}))
+	}
+	require.Equal(t, common.Hash{}, gapp.EVMKeeper.GetState(ctx, implementation, common.Hash{}))
+
+	// a DELEGATECALL following a guarded CALL in the same transaction still
+	// runs in the context of the caller, the contract reverts if a call fails
+	callThenDelegateCode := append(append(common.FromHex("0x6000600060006000600073"), implementation.Bytes()...), common.FromHex("0x5af115604957600060006000600073")...)
+	callThenDelegateCode = append(append(callThenDelegateCode, implementation.Bytes()...), common.FromHex("0x5af415604957005b60006000fd")...)
+	callThenDelegate := deployCronTestContract(t, gapp, ctx, callThenDelegateCode)
+	_, err := gapp.EVMKeeper.CallEVMWithData(ctx, caller, &callThenDelegate, nil, true)
+	require.NoError(t, err)
+	require.Equal(t, common.BigToHash(common.Big1), gapp.EVMKeeper.GetState(ctx, implementation, common.Hash{}))
+	require.Equal(t, common.BigToHash(common.Big1), gapp.EVMKeeper.GetState(ctx, callThenDelegate, common.Hash{}))
+
+	// the calls to the implementation itself are still guarded
+	_, err = gapp.EVMKeeper.CallEVMWithData(ctx, caller, &implementation, common.FromHex("0xdeadbeef"), true)
+	require.ErrorContains(t, err, evmcircuittypes.ErrBreakerTripped.Error())
+}
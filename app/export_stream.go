@@ -0,0 +1,547 @@
+package app
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/cast"
+
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/genesis"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+const (
+	// StreamedGenesisKey is the app state key of a genesis whose module state
+	// was written to a directory by ExportAppStateToDir.
+	StreamedGenesisKey = "streamed_genesis"
+
+	// StreamFormatNDJSON writes the EVM records one JSON object per line.
+	StreamFormatNDJSON = "ndjson"
+	// StreamFormatChunked writes the EVM records as JSON arrays of at most
+	// the chunk size records, one file per chunk.
+	StreamFormatChunked = "chunked"
+
+	// DefaultStreamChunkSize is the default number of records of a chunk.
+	DefaultStreamChunkSize = 10000
+)
+
+// StreamedGenesis references the module state of a streamed export. The state
+// of each module is in modules/<module>.json, the EVM contracts are in the evm
+// directory: the contract codes deduplicated by code hash, the code hashes of
+// the contract accounts and their storage, as separate streams of records.
+//
+// Only the EVM contracts are streamed: the state of the other modules, the
+// auth accounts and bank balances included, is marshalled in memory one
+// module at a time, as their genesis is a single JSON document.
+type StreamedGenesis struct {
+	// Dir is the export directory, relative to the directory of the genesis
+	// file so that both can be moved together.
+	Dir     string   `json:"dir"`
+	Format  string   `json:"format"`
+	Modules []string `json:"modules"`
+}
+
+type evmCodeRecord struct {
+	Hash string `json:"hash"`
+	Code string `json:"code"`
+}
+
+type evmAccountRecord struct {
+	Address  string `json:"address"`
+	CodeHash string `json:"code_hash"`
+}
+
+type evmStorageRecord struct {
+	Address string `json:"address"`
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+}
+
+const (
+	evmCodesStream    = "codes"
+	evmAccountsStream = "accounts"
+	evmStorageStream  = "storage"
+)
+
+// ExportAppStateToDir exports the state of the application like
+// ExportAppStateAndValidators, but writes the module state to the directory one
+// module at a time, and the EVM contracts as streams of records. The app state
+// of the returned genesis references the directory relative to genesisDir, the
+// directory the genesis file is written to, see StreamedGenesis.
+func (app *ChainApp) ExportAppStateToDir(dir, genesisDir, format string, chunkSize int, forZeroHeight bool, jailAllowedAddrs, modulesToExport []string) (servertypes.ExportedApp, error) {
+	if err := validateStreamFormat(format); err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+	if format == StreamFormatChunked && chunkSize <= 0 {
+		return servertypes.ExportedApp{}, fmt.Errorf("invalid chunk size %d", chunkSize)
+	}
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+	genesisDir, err = filepath.Abs(genesisDir)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+	relDir, err := filepath.Rel(genesisDir, dir)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+	// stale files of a previous export would be imported with the new ones
+	if entries, err := os.ReadDir(dir); err == nil && len(entries) > 0 {
+		return servertypes.ExportedApp{}, fmt.Errorf("export directory %s is not empty", dir)
+	}
+	for _, sub := range []string{"modules", "evm"} {
+		if err := os.MkdirAll(filepath.Join(dir, sub), 0o755); err != nil {
+			return servertypes.ExportedApp{}, err
+		}
+	}
+
+	ctx, height := app.exportContext(forZeroHeight, jailAllowedAddrs)
+
+	if len(modulesToExport) == 0 {
+		modulesToExport = app.ModuleManager.OrderExportGenesis
+	}
+	stream := StreamedGenesis{Dir: dir, Format: format}
+	for _, name := range modulesToExport {
+		var state json.RawMessage
+		if name == evmtypes.ModuleName {
+			// the contracts are streamed next to the params
+			state, err = app.appCodec.MarshalJSON(&evmtypes.GenesisState{
+				Params:   app.EVMKeeper.GetParams(ctx),
+				Accounts: []evmtypes.GenesisAccount{},
+			})
+			if err == nil {
+				err = app.exportEVMState(ctx, stream, chunkSize)
+			}
+		} else {
+			var genState map[string]json.RawMessage
+			genState, err = app.ModuleManager.ExportGenesisForModules(ctx, app.appCodec, []string{name})
+			state = genState[name]
+		}
+		if err != nil {
+			return servertypes.ExportedApp{}, fmt.Errorf("export %s: %w", name, err)
+		}
+		if state == nil {
+			continue
+		}
+
+		if err := os.WriteFile(stream.modulePath(name), state, 0o644); err != nil {
+			return servertypes.ExportedApp{}, err
+		}
+		stream.Modules = append(stream.Modules, name)
+	}
+
+	stream.Dir = filepath.ToSlash(relDir)
+	appState, err := json.MarshalIndent(map[string]StreamedGenesis{StreamedGenesisKey: stream}, "", "  ")
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+	return app.exportedApp(ctx, height, appState)
+}
+
+// exportEVMState writes the contract codes, accounts and storage. A code shared
+// by several contracts is written once.
+func (app *ChainApp) exportEVMState(ctx sdk.Context, stream StreamedGenesis, chunkSize int) (err error) {
+	codes, err := stream.writer(evmCodesStream, chunkSize)
+	if err != nil {
+		return err
+	}
+	defer closeRecordWriter(codes, &err)
+	accounts, err := stream.writer(evmAccountsStream, chunkSize)
+	if err != nil {
+		return err
+	}
+	defer closeRecordWriter(accounts, &err)
+	storage, err := stream.writer(evmStorageStream, chunkSize)
+	if err != nil {
+		return err
+	}
+	defer closeRecordWriter(storage, &err)
+
+	written := make(map[common.Hash]bool)
+	app.EVMKeeper.IterateContracts(ctx, func(address common.Address, codeHash common.Hash) bool {
+		if !written[codeHash] {
+			err = codes.Write(evmCodeRecord{
+				Hash: codeHash.Hex(),
+				Code: common.Bytes2Hex(app.EVMKeeper.GetCode(ctx, codeHash)),
+			})
+			if err != nil {
+				return true
+			}
+			written[codeHash] = true
+		}
+
+		if err = accounts.Write(evmAccountRecord{Address: address.Hex(), CodeHash: codeHash.Hex()}); err != nil {
+			return true
+		}
+
+		app.EVMKeeper.ForEachStorage(ctx, address, func(key, value common.Hash) bool {
+			err = storage.Write(evmStorageRecord{Address: address.Hex(), Key: key.Hex(), Value: value.Hex()})
+			return err == nil
+		})
+		return err != nil
+	})
+	return err
+}
+
+// initStreamedGenesis initializes the modules in genesis order from the state
+// written by ExportAppStateToDir, loading the state of one module at a time.
+func (app *ChainApp) initStreamedGenesis(ctx sdk.Context, bz json.RawMessage) (*abci.ResponseInitChain, error) {
+	stream, err := parseStreamedGenesis(app.genesisDir, bz)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range stream.Modules {
+		if _, ok := app.ModuleManager.Modules[name]; !ok {
+			return nil, fmt.Errorf("unknown module %s in %s", name, StreamedGenesisKey)
+		}
+	}
+
+	ctx.Logger().Info("initializing blockchain state from a streamed genesis", "dir", stream.Dir)
+
+	var validatorUpdates []abci.ValidatorUpdate
+	for _, name := range app.ModuleManager.OrderInitGenesis {
+		if !slices.Contains(stream.Modules, name) {
+			continue
+		}
+
+		state, err := os.ReadFile(stream.modulePath(name))
+		if err != nil {
+			return nil, err
+		}
+		updates, err := app.initModuleGenesis(ctx, name, state)
+		if err != nil {
+			return nil, fmt.Errorf("init %s: %w", name, err)
+		}
+		if len(updates) > 0 {
+			if len(validatorUpdates) > 0 {
+				return nil, errors.New("validator InitGenesis updates already set by a previous module")
+			}
+			validatorUpdates = updates
+		}
+
+		if name == evmtypes.ModuleName {
+			if err := app.importEVMState(ctx, stream); err != nil {
+				return nil, fmt.Errorf("init %s: %w", name, err)
+			}
+		}
+	}
+
+	if len(validatorUpdates) == 0 {
+		return nil, errors.New("validator set is empty after InitGenesis")
+	}
+	return &abci.ResponseInitChain{Validators: validatorUpdates}, nil
+}
+
+// initModuleGenesis runs the InitGenesis of a module, as the module manager.
+func (app *ChainApp) initModuleGenesis(ctx sdk.Context, name string, state json.RawMessage) ([]abci.ValidatorUpdate, error) {
+	switch mod := app.ModuleManager.Modules[name].(type) {
+	case appmodule.HasGenesis:
+		source, err := genesis.SourceFromRawJSON(state)
+		if err != nil {
+			return nil, err
+		}
+		return nil, mod.InitGenesis(ctx, source)
+	case module.HasGenesis:
+		mod.InitGenesis(ctx, app.appCodec, state)
+		return nil, nil
+	case module.HasABCIGenesis:
+		return mod.InitGenesis(ctx, app.appCodec, state), nil
+	}
+	return nil, nil
+}
+
+// importEVMState sets the contract codes, then the code hashes of the contract
+// accounts and their storage. The accounts must be in the auth state.
+func (app *ChainApp) importEVMState(ctx sdk.Context, stream StreamedGenesis) error {
+	err := readRecords(stream, evmCodesStream, func(record evmCodeRecord) error {
+		code := common.Hex2Bytes(record.Code)
+		codeHash := crypto.Keccak256Hash(code)
+		if codeHash != common.HexToHash(record.Hash) {
+			return fmt.Errorf("code hash %s doesn't match the code, expected %s", record.Hash, codeHash)
+		}
+		app.EVMKeeper.SetCode(ctx, codeHash.Bytes(), code)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = readRecords(stream, evmAccountsStream, func(record evmAccountRecord) error {
+		address := common.HexToAddress(record.Address)
+		if app.AccountKeeper.GetAccount(ctx, sdk.AccAddress(address.Bytes())) == nil {
+			return fmt.Errorf("account not found for address %s", record.Address)
+		}
+		codeHash := common.HexToHash(record.CodeHash)
+		if len(app.EVMKeeper.GetCode(ctx, codeHash)) == 0 {
+			return fmt.Errorf("code %s of %s is missing", record.CodeHash, record.Address)
+		}
+		app.EVMKeeper.SetCodeHash(ctx, address.Bytes(), codeHash.Bytes())
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return readRecords(stream, evmStorageStream, func(record evmStorageRecord) error {
+		app.EVMKeeper.SetState(ctx, common.HexToAddress(record.Address), common.HexToHash(record.Key), common.HexToHash(record.Value).Bytes())
+		return nil
+	})
+}
+
+// LoadStreamedGenesis returns the state of the modules of a streamed genesis,
+// the app state of a genesis file of genesisDir, and checks that the EVM
+// records decode and that the contract codes match their hashes. The records
+// are read one at a time, unlike the module state.
+func LoadStreamedGenesis(genesisDir string, bz json.RawMessage) (map[string]json.RawMessage, error) {
+	stream, err := parseStreamedGenesis(genesisDir, bz)
+	if err != nil {
+		return nil, err
+	}
+
+	genState := make(map[string]json.RawMessage, len(stream.Modules))
+	for _, name := range stream.Modules {
+		if genState[name], err = os.ReadFile(stream.modulePath(name)); err != nil {
+			return nil, err
+		}
+	}
+	if !slices.Contains(stream.Modules, evmtypes.ModuleName) {
+		return genState, nil
+	}
+
+	codeHashes := make(map[common.Hash]bool)
+	err = readRecords(stream, evmCodesStream, func(record evmCodeRecord) error {
+		codeHash := crypto.Keccak256Hash(common.Hex2Bytes(record.Code))
+		if codeHash != common.HexToHash(record.Hash) {
+			return fmt.Errorf("code hash %s doesn't match the code, expected %s", record.Hash, codeHash)
+		}
+		codeHashes[codeHash] = true
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = readRecords(stream, evmAccountsStream, func(record evmAccountRecord) error {
+		if !codeHashes[common.HexToHash(record.CodeHash)] {
+			return fmt.Errorf("code %s of %s is missing", record.CodeHash, record.Address)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return genState, readRecords(stream, evmStorageStream, func(evmStorageRecord) error { return nil })
+}
+
+// parseStreamedGenesis parses the streamed genesis of the app state of a
+// genesis file of genesisDir, resolving its directory.
+func parseStreamedGenesis(genesisDir string, bz json.RawMessage) (StreamedGenesis, error) {
+	var stream StreamedGenesis
+	if err := json.Unmarshal(bz, &stream); err != nil {
+		return StreamedGenesis{}, fmt.Errorf("invalid %s: %w", StreamedGenesisKey, err)
+	}
+	if err := validateStreamFormat(stream.Format); err != nil {
+		return StreamedGenesis{}, err
+	}
+	if !filepath.IsAbs(stream.Dir) {
+		stream.Dir = filepath.Join(genesisDir, filepath.FromSlash(stream.Dir))
+	}
+	return stream, nil
+}
+
+// genesisFileDir returns the directory of the genesis file of the node.
+func genesisFileDir(appOpts servertypes.AppOptions) string {
+	cfg := cmtcfg.DefaultBaseConfig()
+	cfg.RootDir = cast.ToString(appOpts.Get(flags.FlagHome))
+	if genesisFile := cast.ToString(appOpts.Get("genesis_file")); genesisFile != "" {
+		cfg.Genesis = genesisFile
+	}
+	return filepath.Dir(cfg.GenesisFile())
+}
+
+func validateStreamFormat(format string) error {
+	if format != StreamFormatNDJSON && format != StreamFormatChunked {
+		return fmt.Errorf("unknown stream format %q, expected %s or %s", format, StreamFormatNDJSON, StreamFormatChunked)
+	}
+	return nil
+}
+
+func (s StreamedGenesis) modulePath(name string) string {
+	return filepath.Join(s.Dir, "modules", name+".json")
+}
+
+func (s StreamedGenesis) streamPath(name string) string {
+	return filepath.Join(s.Dir, "evm", name)
+}
+
+// recordWriter writes a stream of JSON records.
+type recordWriter interface {
+	Write(record any) error
+	Close() error
+}
+
+func (s StreamedGenesis) writer(name string, chunkSize int) (recordWriter, error) {
+	if s.Format == StreamFormatChunked {
+		return &chunkedWriter{path: s.streamPath(name), size: chunkSize}, nil
+	}
+
+	file, err := os.Create(s.streamPath(name) + ".ndjson")
+	if err != nil {
+		return nil, err
+	}
+	buf := bufio.NewWriter(file)
+	return &ndjsonWriter{file: file, buf: buf, enc: json.NewEncoder(buf)}, nil
+}
+
+func closeRecordWriter(w recordWriter, err *error) {
+	if closeErr := w.Close(); *err == nil {
+		*err = closeErr
+	}
+}
+
+type ndjsonWriter struct {
+	file *os.File
+	buf  *bufio.Writer
+	enc  *json.Encoder
+}
+
+func (w *ndjsonWriter) Write(record any) error {
+	return w.enc.Encode(record)
+}
+
+func (w *ndjsonWriter) Close() error {
+	if err := w.buf.Flush(); err != nil {
+		w.file.Close()
+		return err
+	}
+	return w.file.Close()
+}
+
+// chunkedWriter writes the records as JSON arrays, in files <path>-NNNNNN.json
+// of at most size records.
+type chunkedWriter struct {
+	path  string
+	size  int
+	chunk int
+	count int
+
+	file *os.File
+	buf  *bufio.Writer
+}
+
+func (w *chunkedWriter) Write(record any) error {
+	bz, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	if w.file == nil {
+		w.file, err = os.Create(fmt.Sprintf("%s-%06d.json", w.path, w.chunk))
+		if err != nil {
+			return err
+		}
+		w.buf = bufio.NewWriter(w.file)
+		_, err = w.buf.WriteString("[\n")
+	} else {
+		_, err = w.buf.WriteString(",\n")
+	}
+	if err != nil {
+		return err
+	}
+	if _, err := w.buf.Write(bz); err != nil {
+		return err
+	}
+
+	w.count++
+	if w.count == w.size {
+		return w.Close()
+	}
+	return nil
+}
+
+// Close ends the current chunk.
+func (w *chunkedWriter) Close() error {
+	if w.file == nil {
+		return nil
+	}
+
+	file := w.file
+	w.file, w.chunk, w.count = nil, w.chunk+1, 0
+	if _, err := w.buf.WriteString("\n]\n"); err != nil {
+		file.Close()
+		return err
+	}
+	if err := w.buf.Flush(); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// readRecords decodes the records of a stream one at a time.
+func readRecords[T any](s StreamedGenesis, name string, fn func(T) error) error {
+	if s.Format == StreamFormatNDJSON {
+		return readRecordFile(s.streamPath(name)+".ndjson", false, fn)
+	}
+
+	chunks, err := filepath.Glob(s.streamPath(name) + "-*.json")
+	if err != nil {
+		return err
+	}
+	slices.Sort(chunks)
+	for _, chunk := range chunks {
+		if err := readRecordFile(chunk, true, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readRecordFile[T any](path string, array bool, fn func(T) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	dec := json.NewDecoder(bufio.NewReader(file))
+	if array {
+		if tok, err := dec.Token(); err != nil || tok != json.Delim('[') {
+			return fmt.Errorf("%s: expected a JSON array", path)
+		}
+	}
+
+	for i := 0; ; i++ {
+		if array && !dec.More() {
+			break
+		}
+		var record T
+		if err := dec.Decode(&record); err != nil {
+			if !array && errors.Is(err, io.EOF) {
+				break
+			}
+			return fmt.Errorf("%s: record %d: %w", path, i, err)
+		}
+		if err := fn(record); err != nil {
+			return fmt.Errorf("%s: record %d: %w", path, i, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package app
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestStreamedExport(t *testing.T) {
+	code := common.FromHex("0x6001600155")
+	codeHash := crypto.Keccak256Hash(code)
+	contracts := []common.Address{
+		common.HexToAddress("0x1000000000000000000000000000000000000001"),
+		common.HexToAddress("0x1000000000000000000000000000000000000002"),
+	}
+
+	for _, format := range []string{StreamFormatNDJSON, StreamFormatChunked} {
+		t.Run(format, func(t *testing.T) {
+			chainApp := Setup(t)
+			ctx := chainApp.NewUncachedContext(false, cmtproto.Header{Height: chainApp.LastBlockHeight()})
+
+			// two contracts sharing their code
+			chainApp.EVMKeeper.SetCode(ctx, codeHash.Bytes(), code)
+			for i, contract := range contracts {
+				chainApp.AccountKeeper.SetAccount(ctx, chainApp.AccountKeeper.NewAccountWithAddress(ctx, sdk.AccAddress(contract.Bytes())))
+				chainApp.EVMKeeper.SetCodeHash(ctx, contract.Bytes(), codeHash.Bytes())
+				chainApp.EVMKeeper.SetState(ctx, contract, common.BigToHash(common.Big1), common.BigToHash(common.Big2).Bytes())
+				chainApp.EVMKeeper.SetState(ctx, contract, common.BigToHash(common.Big2), common.BigToHash(common.Big3).Bytes())
+				if i == 1 {
+					chainApp.EVMKeeper.SetState(ctx, contract, common.BigToHash(common.Big3), common.BigToHash(common.Big256).Bytes())
+				}
+			}
+			require.NoError(t, chainApp.simulateBlock(time.Now().UTC()))
+
+			// the genesis is written to the config directory of the new node
+			home := t.TempDir()
+			genesisDir := filepath.Join(home, "config")
+			dir := filepath.Join(genesisDir, "state")
+			exported, err := chainApp.ExportAppStateToDir(dir, genesisDir, format, 2, false, nil, nil)
+			require.NoError(t, err)
+
+			var appState map[string]json.RawMessage
+			require.NoError(t, json.Unmarshal(exported.AppState, &appState))
+			stream, err := parseStreamedGenesis(genesisDir, appState[StreamedGenesisKey])
+			require.NoError(t, err)
+			require.Equal(t, dir, stream.Dir)
+			require.Equal(t, format, stream.Format)
+			require.Contains(t, stream.Modules, "evm")
+			require.Contains(t, string(appState[StreamedGenesisKey]), `"dir": "state"`, "the directory is relative to the genesis")
+
+			codes, codeHashes := 0, map[string]int{}
+			require.NoError(t, readRecords(stream, evmCodesStream, func(evmCodeRecord) error { codes++; return nil }))
+			require.NoError(t, readRecords(stream, evmAccountsStream, func(record evmAccountRecord) error { codeHashes[record.CodeHash]++; return nil }))
+			require.Equal(t, len(codeHashes), codes, "each code is written once")
+			require.Equal(t, len(contracts), codeHashes[codeHash.Hex()])
+
+			storage := 0
+			require.NoError(t, readRecords(stream, evmStorageStream, func(record evmStorageRecord) error {
+				if common.HexToAddress(record.Address) == contracts[0] || common.HexToAddress(record.Address) == contracts[1] {
+					storage++
+				}
+				return nil
+			}))
+			require.Equal(t, 5, storage)
+
+			_, err = chainApp.ExportAppStateToDir(dir, genesisDir, format, 2, false, nil, nil)
+			require.ErrorContains(t, err, "is not empty")
+
+			// the streamed genesis validates as the genesis it was exported from
+			genState, err := LoadStreamedGenesis(genesisDir, appState[StreamedGenesisKey])
+			require.NoError(t, err)
+			require.NoError(t, chainApp.BasicModuleManager.ValidateGenesis(chainApp.appCodec, chainApp.txConfig, genState))
+
+			newApp := NewChainApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(home), EVMAppOptions)
+			newCtx := newApp.NewContextLegacy(true, cmtproto.Header{Height: chainApp.LastBlockHeight()})
+			res, err := newApp.InitChainer(newCtx, &abci.RequestInitChain{AppStateBytes: exported.AppState})
+			require.NoError(t, err)
+			require.Len(t, res.Validators, len(exported.Validators))
+
+			for i, contract := range contracts {
+				require.Equal(t, codeHash, newApp.EVMKeeper.GetCodeHash(newCtx, contract))
+				require.Equal(t, code, newApp.EVMKeeper.GetCode(newCtx, codeHash))
+				require.Equal(t, common.BigToHash(common.Big2), newApp.EVMKeeper.GetState(newCtx, contract, common.BigToHash(common.Big1)))
+				require.Equal(t, common.BigToHash(common.Big3), newApp.EVMKeeper.GetState(newCtx, contract, common.BigToHash(common.Big2)))
+				if i == 1 {
+					require.Equal(t, common.BigToHash(common.Big256), newApp.EVMKeeper.GetState(newCtx, contract, common.BigToHash(common.Big3)))
+				}
+			}
+
+			ctx = chainApp.NewContextLegacy(true, cmtproto.Header{Height: chainApp.LastBlockHeight()})
+			require.Equal(t, chainApp.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom), newApp.BankKeeper.GetSupply(newCtx, sdk.DefaultBondDenom))
+			require.Equal(t, chainApp.EVMKeeper.GetParams(ctx), newApp.EVMKeeper.GetParams(newCtx))
+		})
+	}
+}
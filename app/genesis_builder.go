@@ -0,0 +1,196 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common"
+	tokenfactorytypes "github.com/strangelove-ventures/tokenfactory/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// validatedGenesis is the genesis state of a module.
+type validatedGenesis interface {
+	proto.Message
+	Validate() error
+}
+
+// SetActiveStaticPrecompiles sets the static precompiles enabled by the EVM
+// params. The precompiles must be available in the app.
+func (g GenesisState) SetActiveStaticPrecompiles(cdc codec.Codec, precompiles []string) error {
+	active := make([]string, 0, len(precompiles))
+	for _, precompile := range precompiles {
+		idx := slices.IndexFunc(AvailableStaticPrecompiles, func(available string) bool { return strings.EqualFold(available, precompile) })
+		if idx < 0 {
+			return fmt.Errorf("static precompile %s is not available", precompile)
+		}
+		active = append(active, AvailableStaticPrecompiles[idx])
+	}
+	slices.Sort(active)
+
+	var genState evmtypes.GenesisState
+	return g.updateModule(cdc, evmtypes.ModuleName, &genState, func() error {
+		genState.Params.ActiveStaticPrecompiles = active
+		return nil
+	})
+}
+
+// AddTokenPair adds a token pair to the erc20 genesis. The ERC20 contract of
+// a pair owned by the erc20 module is a precompile, a dynamic one when dynamic
+// is set and a native one otherwise.
+func (g GenesisState) AddTokenPair(cdc codec.Codec, pair erc20types.TokenPair, dynamic bool) error {
+	if !pair.IsNativeCoin() && dynamic {
+		return fmt.Errorf("the ERC20 contract of %s is not owned by the erc20 module", pair.Denom)
+	}
+
+	var genState erc20types.GenesisState
+	return g.updateModule(cdc, erc20types.ModuleName, &genState, func() error {
+		if pair.IsNativeCoin() {
+			precompiles := &genState.Params.NativePrecompiles
+			if dynamic {
+				precompiles = &genState.Params.DynamicPrecompiles
+			}
+			*precompiles = append(*precompiles, pair.Erc20Address)
+			slices.Sort(*precompiles)
+		}
+
+		genState.TokenPairs = append(genState.TokenPairs, pair)
+		return nil
+	})
+}
+
+// AddPredeploy deploys a contract with its code and storage at genesis. The
+// account of the contract is added to the auth genesis if it is missing, as
+// the EVM genesis requires it.
+func (g GenesisState) AddPredeploy(cdc codec.Codec, address common.Address, code []byte, storage evmtypes.Storage) error {
+	if len(code) == 0 {
+		return fmt.Errorf("no code for the contract %s", address)
+	}
+	if slices.ContainsFunc(AvailableStaticPrecompiles, func(precompile string) bool { return common.HexToAddress(precompile) == address }) {
+		return fmt.Errorf("%s is the address of a static precompile", address)
+	}
+
+	var genState evmtypes.GenesisState
+	if err := g.updateModule(cdc, evmtypes.ModuleName, &genState, func() error {
+		if slices.ContainsFunc(genState.Accounts, func(account evmtypes.GenesisAccount) bool { return common.HexToAddress(account.Address) == address }) {
+			return fmt.Errorf("the contract %s is already deployed", address)
+		}
+
+		genState.Accounts = append(genState.Accounts, evmtypes.GenesisAccount{
+			Address: address.Hex(),
+			Code:    common.Bytes2Hex(code),
+			Storage: storage,
+		})
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return g.addAccount(cdc, address.Bytes())
+}
+
+// AddFactoryDenom creates a tokenfactory denom with its bank metadata, the
+// base of the metadata is the denom.
+func (g GenesisState) AddFactoryDenom(cdc codec.Codec, admin string, metadata banktypes.Metadata) error {
+	var tokenfactoryGenState tokenfactorytypes.GenesisState
+	if err := g.updateModule(cdc, tokenfactorytypes.ModuleName, &tokenfactoryGenState, func() error {
+		tokenfactoryGenState.FactoryDenoms = append(tokenfactoryGenState.FactoryDenoms, tokenfactorytypes.GenesisDenom{
+			Denom:             metadata.Base,
+			AuthorityMetadata: tokenfactorytypes.DenomAuthorityMetadata{Admin: admin},
+		})
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return g.AddDenomMetadata(cdc, metadata)
+}
+
+// AddDenomMetadata adds the bank metadata of a denom, the base of the
+// metadata. The metadata is kept in the order of the bases, the order of the
+// bank export.
+func (g GenesisState) AddDenomMetadata(cdc codec.Codec, metadata banktypes.Metadata) error {
+	if err := metadata.Validate(); err != nil {
+		return err
+	}
+
+	var bankGenState banktypes.GenesisState
+	return g.updateModule(cdc, banktypes.ModuleName, &bankGenState, func() error {
+		if slices.ContainsFunc(bankGenState.DenomMetadata, func(existing banktypes.Metadata) bool { return existing.Base == metadata.Base }) {
+			return fmt.Errorf("denom %s already has metadata", metadata.Base)
+		}
+		i, _ := slices.BinarySearchFunc(bankGenState.DenomMetadata, metadata.Base, func(existing banktypes.Metadata, base string) int {
+			return strings.Compare(existing.Base, base)
+		})
+		bankGenState.DenomMetadata = slices.Insert(bankGenState.DenomMetadata, i, metadata)
+		return nil
+	})
+}
+
+// UpdateFeeMarketParams applies update to the params of the feemarket genesis.
+func (g GenesisState) UpdateFeeMarketParams(cdc codec.Codec, update func(*feemarkettypes.Params) error) error {
+	var genState feemarkettypes.GenesisState
+	return g.updateModule(cdc, feemarkettypes.ModuleName, &genState, func() error {
+		return update(&genState.Params)
+	})
+}
+
+// updateModule unmarshals the genesis state of a module into genState, applies
+// fn to it and marshals it back once it is validated.
+func (g GenesisState) updateModule(cdc codec.Codec, module string, genState validatedGenesis, fn func() error) error {
+	bz, ok := g[module]
+	if !ok {
+		return fmt.Errorf("no %s genesis state", module)
+	}
+	if err := cdc.UnmarshalJSON(bz, genState); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", module, err)
+	}
+
+	if err := fn(); err != nil {
+		return err
+	}
+	if err := genState.Validate(); err != nil {
+		return fmt.Errorf("invalid %s genesis state: %w", module, err)
+	}
+
+	bz, err := cdc.MarshalJSON(genState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s genesis state: %w", module, err)
+	}
+	g[module] = bz
+	return nil
+}
+
+// addAccount adds a base account to the auth genesis if it is missing.
+func (g GenesisState) addAccount(cdc codec.Codec, address sdk.AccAddress) error {
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, g)
+	accounts, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return fmt.Errorf("failed to unpack %s genesis accounts: %w", authtypes.ModuleName, err)
+	}
+	if accounts.Contains(address) {
+		return nil
+	}
+
+	accounts = append(accounts, authtypes.NewBaseAccount(address, nil, 0, 0))
+	authGenState.Accounts, err = authtypes.PackAccounts(authtypes.SanitizeGenesisAccounts(accounts))
+	if err != nil {
+		return fmt.Errorf("failed to pack %s genesis accounts: %w", authtypes.ModuleName, err)
+	}
+
+	bz, err := cdc.MarshalJSON(&authGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s genesis state: %w", authtypes.ModuleName, err)
+	}
+	g[authtypes.ModuleName] = bz
+	return nil
+}
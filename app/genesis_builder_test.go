@@ -0,0 +1,120 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	tokenfactorytypes "github.com/strangelove-ventures/tokenfactory/x/tokenfactory/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestGenesisBuilder(t *testing.T) {
+	chainApp := Setup(t)
+	exported, err := chainApp.ExportAppStateAndValidators(false, nil, nil)
+	require.NoError(t, err)
+
+	var genesis GenesisState
+	require.NoError(t, json.Unmarshal(exported.AppState, &genesis))
+	cdc := chainApp.AppCodec()
+
+	precompiles := []string{evmtypes.P256PrecompileAddress, evmtypes.Bech32PrecompileAddress}
+	require.NoError(t, genesis.SetActiveStaticPrecompiles(cdc, precompiles))
+	require.ErrorContains(t, genesis.SetActiveStaticPrecompiles(cdc, []string{"0x0000000000000000000000000000000000000999"}), "is not available")
+
+	creator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	denom := "factory/" + creator + "/ufoo"
+	metadata := banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom}, {Denom: "foo", Exponent: 6}},
+		Base:       denom,
+		Display:    "foo",
+		Name:       "Foo",
+		Symbol:     "FOO",
+	}
+	require.NoError(t, genesis.AddFactoryDenom(cdc, creator, metadata))
+	require.ErrorContains(t, genesis.AddFactoryDenom(cdc, creator, metadata), "duplicate denom")
+
+	erc20Address := common.HexToAddress("0x2000000000000000000000000000000000000001")
+	require.NoError(t, genesis.AddTokenPair(cdc, erc20types.NewTokenPair(erc20Address, denom, erc20types.OWNER_MODULE), true))
+	require.ErrorContains(t, genesis.AddTokenPair(cdc, erc20types.NewTokenPair(erc20Address, "ufoo", erc20types.OWNER_EXTERNAL), false), "duplicated")
+
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	code := common.FromHex("0x6001600155")
+	storage := evmtypes.Storage{evmtypes.NewState(common.BigToHash(common.Big1), common.BigToHash(common.Big2))}
+	require.NoError(t, genesis.AddPredeploy(cdc, contract, code, storage))
+	require.ErrorContains(t, genesis.AddPredeploy(cdc, contract, code, nil), "already deployed")
+	require.ErrorContains(t, genesis.AddPredeploy(cdc, common.HexToAddress(evmtypes.Bech32PrecompileAddress), code, nil), "static precompile")
+
+	require.NoError(t, genesis.UpdateFeeMarketParams(cdc, func(params *feemarkettypes.Params) error {
+		params.NoBaseFee = true
+		params.MinGasPrice = sdkmath.LegacyNewDec(2)
+		return nil
+	}))
+	require.ErrorContains(t, genesis.UpdateFeeMarketParams(cdc, func(params *feemarkettypes.Params) error {
+		params.BaseFeeChangeDenominator = 0
+		return nil
+	}), "base fee change denominator")
+
+	appState, err := json.Marshal(genesis)
+	require.NoError(t, err)
+
+	newApp := NewChainApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()), EVMAppOptions)
+	ctx := newApp.NewContextLegacy(true, cmtproto.Header{Height: chainApp.LastBlockHeight()})
+	_, err = newApp.InitChainer(ctx, &abci.RequestInitChain{AppStateBytes: appState})
+	require.NoError(t, err)
+
+	require.Equal(t, precompiles, newApp.EVMKeeper.GetParams(ctx).ActiveStaticPrecompiles)
+
+	// the metadata of the denom isn't replaced by the default tokenfactory one
+	storedMetadata, found := newApp.BankKeeper.GetDenomMetaData(ctx, denom)
+	require.True(t, found)
+	require.Equal(t, metadata.Display, storedMetadata.Display)
+	require.Equal(t, metadata.Symbol, storedMetadata.Symbol)
+	authority, err := newApp.TokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	require.NoError(t, err)
+	require.Equal(t, creator, authority.Admin)
+
+	pair, found := newApp.Erc20Keeper.GetTokenPair(ctx, newApp.Erc20Keeper.GetTokenPairID(ctx, denom))
+	require.True(t, found)
+	require.Equal(t, erc20Address, pair.GetERC20Contract())
+	require.True(t, newApp.Erc20Keeper.GetParams(ctx).IsDynamicPrecompile(erc20Address))
+
+	require.Equal(t, crypto.Keccak256Hash(code), newApp.EVMKeeper.GetCodeHash(ctx, contract))
+	require.Equal(t, common.BigToHash(common.Big2), newApp.EVMKeeper.GetState(ctx, contract, common.BigToHash(common.Big1)))
+
+	feemarketParams := newApp.FeeMarketKeeper.GetParams(ctx)
+	require.True(t, feemarketParams.NoBaseFee)
+	require.Equal(t, sdkmath.LegacyNewDec(2), feemarketParams.MinGasPrice)
+
+	// tokenfactory is initialized before bank but exported in the same state:
+	// the export imports back to the same genesis
+	reexported, err := newApp.ExportAppStateAndValidators(false, nil, nil)
+	require.NoError(t, err)
+	var regenesis GenesisState
+	require.NoError(t, json.Unmarshal(reexported.AppState, &regenesis))
+	for _, module := range []string{banktypes.ModuleName, tokenfactorytypes.ModuleName, feemarkettypes.ModuleName} {
+		require.JSONEq(t, string(genesis[module]), string(regenesis[module]), module)
+	}
+
+	// the token pairs are exported in the order of their ids
+	var erc20Genesis, erc20Regenesis erc20types.GenesisState
+	cdc.MustUnmarshalJSON(genesis[erc20types.ModuleName], &erc20Genesis)
+	cdc.MustUnmarshalJSON(regenesis[erc20types.ModuleName], &erc20Regenesis)
+	require.ElementsMatch(t, erc20Genesis.TokenPairs, erc20Regenesis.TokenPairs)
+	require.Equal(t, erc20Genesis.Params, erc20Regenesis.Params)
+}
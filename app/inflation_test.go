@@ -0,0 +1,124 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+
+	inflationkeeper "github.com/rollchains/flora/x/inflation/keeper"
+	inflationtypes "github.com/rollchains/flora/x/inflation/types"
+)
+
+// TestInflationScheduleSimulation mints thirty years of blocks, with years
+// shortened to 100 blocks, and checks the halving, the supply cap, the shares
+// and the supply projections.
+func TestInflationScheduleSimulation(t *testing.T) {
+	const (
+		epochBlocks = 100
+		years       = 30
+	)
+
+	gapp := Setup(t)
+	ctx := gapp.BaseApp.NewContext(false)
+	querier := inflationkeeper.NewQuerier(gapp.InflationKeeper)
+
+	bondDenom, err := gapp.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+	initialSupply := gapp.BankKeeper.GetSupply(ctx, bondDenom).Amount
+
+	mintParams, err := gapp.MintKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	mintParams.MintDenom = bondDenom
+	mintParams.BlocksPerYear = epochBlocks
+	require.NoError(t, gapp.MintKeeper.Params.Set(ctx, mintParams))
+
+	// the geometric emission totals twice the first epoch provisions, the cap
+	// is reached during the fifth epoch
+	initialProvisions := sdkmath.NewInt(1_000_000_000_000)
+	maxSupply := initialSupply.Add(initialProvisions.MulRaw(19).QuoRaw(10))
+	ecosystem := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	params := inflationtypes.DefaultParams()
+	params.EpochBlocks = epochBlocks
+	params.InitialEpochProvisions = initialProvisions
+	params.MaxSupply = maxSupply
+	params.EcosystemShare = sdkmath.LegacyNewDecWithPrec(5, 2)
+	params.EcosystemAddress = ecosystem.String()
+	require.NoError(t, params.Validate())
+	require.NoError(t, gapp.InflationKeeper.Params.Set(ctx, params))
+
+	start := ctx.BlockHeight() + 1
+	require.NoError(t, gapp.InflationKeeper.StartHeight.Set(ctx, uint64(start)))
+
+	projectionHeight := uint64(start + 2*epochBlocks + epochBlocks/2)
+	projection, err := querier.ProjectedSupply(ctx, &inflationtypes.QueryProjectedSupplyRequest{Height: projectionHeight})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), projection.Epoch)
+
+	poolBefore, err := gapp.DistrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+
+	supply := initialSupply
+	var epochMinted []sdkmath.Int
+	for i := int64(0); i < years*epochBlocks; i++ {
+		ctx = ctx.WithBlockHeight(start + i)
+		require.NoError(t, mint.BeginBlocker(ctx, gapp.MintKeeper, gapp.InflationKeeper.InflationCalculationFn))
+		require.NoError(t, gapp.InflationKeeper.BeginBlocker(ctx))
+
+		newSupply := gapp.BankKeeper.GetSupply(ctx, bondDenom).Amount
+		require.True(t, newSupply.LTE(maxSupply), "supply %s above the cap %s", newSupply, maxSupply)
+
+		if i%epochBlocks == 0 {
+			epochMinted = append(epochMinted, sdkmath.ZeroInt())
+		}
+		epochMinted[len(epochMinted)-1] = epochMinted[len(epochMinted)-1].Add(newSupply.Sub(supply))
+		supply = newSupply
+
+		if uint64(ctx.BlockHeight()) == projectionHeight {
+			require.InDelta(t, projection.Supply.Int64(), supply.Int64(), 3*epochBlocks)
+
+			current, err := querier.CurrentEpoch(ctx, &inflationtypes.QueryCurrentEpochRequest{})
+			require.NoError(t, err)
+			require.Equal(t, uint64(2), current.Epoch)
+			require.Equal(t, uint64(start+2*epochBlocks), current.StartHeight)
+		}
+	}
+
+	// the provisions halve every epoch until the cap is reached
+	expected := initialProvisions
+	for epoch := 0; epoch < 4; epoch++ {
+		require.InDelta(t, expected.Int64(), epochMinted[epoch].Int64(), epochBlocks, "epoch %d", epoch)
+		expected = expected.QuoRaw(2)
+	}
+	require.InDelta(t, maxSupply.Int64(), supply.Int64(), epochBlocks)
+	for _, minted := range epochMinted[5:] {
+		require.True(t, minted.LT(sdkmath.NewInt(epochBlocks)))
+	}
+
+	totalMinted := supply.Sub(initialSupply)
+	poolAfter, err := gapp.DistrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	toPool := poolAfter.CommunityPool.AmountOf(bondDenom).Sub(poolBefore.CommunityPool.AmountOf(bondDenom)).TruncateInt()
+	require.InDelta(t, totalMinted.QuoRaw(10).Int64(), toPool.Int64(), years*epochBlocks)
+	require.InDelta(t, totalMinted.QuoRaw(20).Int64(), gapp.BankKeeper.GetBalance(ctx, ecosystem, bondDenom).Amount.Int64(), years*epochBlocks)
+}
+
+func TestInflationDefaultParams(t *testing.T) {
+	powerReduction := sdk.DefaultPowerReduction
+	t.Cleanup(func() { sdk.DefaultPowerReduction = powerReduction })
+
+	// the default amounts are tokens of the chain denom, whatever its decimals
+	for _, decimals := range []int{6, 18} {
+		sdk.DefaultPowerReduction = sdkmath.NewIntWithDecimal(1, decimals)
+		params := inflationtypes.DefaultParams()
+		require.NoError(t, params.Validate())
+		require.Equal(t, sdkmath.NewIntWithDecimal(inflationtypes.DefaultInitialEpochTokens, decimals), params.InitialEpochProvisions)
+		require.Equal(t, sdkmath.NewIntWithDecimal(inflationtypes.DefaultMaxSupplyTokens, decimals), params.MaxSupply)
+	}
+}
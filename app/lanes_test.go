@@ -0,0 +1,119 @@
+package app
+
+import (
+	"errors"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/rollchains/flora/x/lanes"
+)
+
+// laneTestTx is a tx identified by its single byte encoding.
+type laneTestTx struct {
+	id  byte
+	msg sdk.Msg
+	gas uint64
+}
+
+func (tx laneTestTx) GetMsgs() []sdk.Msg                    { return []sdk.Msg{tx.msg} }
+func (tx laneTestTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+func (tx laneTestTx) GetGas() uint64                        { return tx.gas }
+
+// laneTestVerifier accepts every known tx.
+type laneTestVerifier map[byte]laneTestTx
+
+func (v laneTestVerifier) PrepareProposalVerifyTx(tx sdk.Tx) ([]byte, error) {
+	return v.TxEncode(tx)
+}
+
+func (v laneTestVerifier) ProcessProposalVerifyTx(bz []byte) (sdk.Tx, error) {
+	return v.TxDecode(bz)
+}
+
+func (v laneTestVerifier) TxDecode(bz []byte) (sdk.Tx, error) {
+	tx, ok := v[bz[0]]
+	if !ok {
+		return nil, errors.New("unknown tx")
+	}
+	return tx, nil
+}
+
+func (v laneTestVerifier) TxEncode(tx sdk.Tx) ([]byte, error) {
+	return []byte{tx.(laneTestTx).id}, nil
+}
+
+// TestLaneProposals checks the lane layout and gas reservations with a 1000
+// gas block: 200 reserved for IBC, 100 for system txs, 500 for EVM txs and 200
+// shared by all the lanes.
+func TestLaneProposals(t *testing.T) {
+	gapp := Setup(t)
+	ctx := gapp.BaseApp.NewContext(false).WithConsensusParams(cmtproto.ConsensusParams{
+		Block: &cmtproto.BlockParams{MaxBytes: 1 << 20, MaxGas: 1000},
+	})
+
+	ibc := func(id byte, gas uint64) laneTestTx {
+		return laneTestTx{id: id, msg: &channeltypes.MsgRecvPacket{}, gas: gas}
+	}
+	system := func(id byte, gas uint64) laneTestTx {
+		return laneTestTx{id: id, msg: &govv1.MsgVote{}, gas: gas}
+	}
+	evm := func(id byte, gas uint64) laneTestTx {
+		return laneTestTx{id: id, msg: &evmtypes.MsgEthereumTx{}, gas: gas}
+	}
+	other := func(id byte, gas uint64) laneTestTx {
+		return laneTestTx{id: id, msg: &banktypes.MsgSend{}, gas: gas}
+	}
+
+	txs := []laneTestTx{
+		evm(1, 400), evm(2, 400), other(3, 200), ibc(4, 150), ibc(5, 150), system(6, 100), other(7, 100),
+	}
+	verifier := laneTestVerifier{}
+	var reqTxs [][]byte
+	for _, tx := range txs {
+		verifier[tx.id] = tx
+		reqTxs = append(reqTxs, []byte{tx.id})
+	}
+
+	handler := lanes.NewProposalHandler(gapp.LanesKeeper, sdkmempool.NoOpMempool{}, verifier)
+
+	// the second IBC tx uses 100 shared gas, the second EVM tx and the first
+	// other tx don't fit in the 100 shared gas left
+	prepared, err := handler.PrepareProposalHandler()(ctx, &abci.RequestPrepareProposal{Txs: reqTxs, MaxTxBytes: 1 << 20})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{{4}, {5}, {6}, {1}, {7}}, prepared.Txs)
+
+	process := func(txs ...byte) abci.ResponseProcessProposal_ProposalStatus {
+		req := &abci.RequestProcessProposal{}
+		for _, id := range txs {
+			req.Txs = append(req.Txs, []byte{id})
+		}
+		res, err := handler.ProcessProposalHandler()(ctx, req)
+		require.NoError(t, err)
+		return res.Status
+	}
+
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, process(4, 5, 6, 1, 7))
+	// the default lane uses the shared gas
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, process(1, 3))
+
+	// lanes out of order
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, process(1, 4))
+	// EVM lane above its reservation and the shared gas, the IBC and system
+	// reservations are kept even when unused
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, process(1, 2))
+	// default lane above the shared gas
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, process(1, 3, 7))
+	// shared gas used by the IBC lane first
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, process(4, 5, 3))
+}
@@ -0,0 +1,116 @@
+package mempool
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cast"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const (
+	// DefaultMaxTxs is the default capacity of the mempool.
+	DefaultMaxTxs = 5000
+	// DefaultPriceBump is the default minimum fee increase, in percent, for a
+	// tx to replace another one with the same sender and nonce.
+	DefaultPriceBump = 10
+	// DefaultTxLifetime is the default time a tx is kept in the mempool.
+	DefaultTxLifetime = 3 * time.Hour
+	// DefaultMaxQueuedPerSender is the default number of txs a sender can
+	// queue ahead of a nonce gap.
+	DefaultMaxQueuedPerSender = 64
+	// DefaultMaxNonceGap is the default distance between the nonce of a
+	// queued tx and the account sequence.
+	DefaultMaxNonceGap = 256
+
+	// FlagPriceBump is the app.toml key of the replace-by-fee price bump.
+	FlagPriceBump = "mempool.price-bump"
+	// FlagTxLifetime is the app.toml key of the tx lifetime.
+	FlagTxLifetime = "mempool.tx-lifetime"
+	// FlagMaxQueuedPerSender is the app.toml key of the max queued txs per
+	// sender.
+	FlagMaxQueuedPerSender = "mempool.max-queued-per-sender"
+	// FlagMaxNonceGap is the app.toml key of the max nonce gap.
+	FlagMaxNonceGap = "mempool.max-nonce-gap"
+)
+
+// DefaultConfigTemplate extends the [mempool] section of the SDK app.toml
+// template, so it must be appended right after it.
+const DefaultConfigTemplate = `
+# Minimum fee cap and tip increase, in percent, for a tx to replace a pending
+# tx from the same sender with the same nonce.
+price-bump = {{ .PriorityMempool.PriceBump }}
+
+# Maximum time a tx is kept in the mempool before being evicted, along with
+# the later nonces of its sender.
+tx-lifetime = "{{ .PriorityMempool.TxLifetime }}"
+
+# Maximum number of txs of a sender queued behind a nonce gap, 0 disables the
+# limit.
+max-queued-per-sender = {{ .PriorityMempool.MaxQueuedPerSender }}
+
+# Maximum distance between the nonce of a tx and the sequence of its sender, 0
+# disables the limit.
+max-nonce-gap = {{ .PriorityMempool.MaxNonceGap }}
+`
+
+// Config defines the app-side mempool configuration. MaxTxs is read from the
+// SDK max-txs setting: a negative value disables the mempool and zero leaves
+// it unbounded.
+type Config struct {
+	MaxTxs             int           `mapstructure:"max-txs"`
+	PriceBump          uint64        `mapstructure:"price-bump"`
+	TxLifetime         time.Duration `mapstructure:"tx-lifetime"`
+	MaxQueuedPerSender uint64        `mapstructure:"max-queued-per-sender"`
+	MaxNonceGap        uint64        `mapstructure:"max-nonce-gap"`
+}
+
+// DefaultConfig returns the default mempool configuration.
+func DefaultConfig() Config {
+	return Config{
+		MaxTxs:             DefaultMaxTxs,
+		PriceBump:          DefaultPriceBump,
+		TxLifetime:         DefaultTxLifetime,
+		MaxQueuedPerSender: DefaultMaxQueuedPerSender,
+		MaxNonceGap:        DefaultMaxNonceGap,
+	}
+}
+
+// Enabled returns true if the app-side mempool should replace the no-op one.
+func (c Config) Enabled() bool {
+	return c.MaxTxs >= 0
+}
+
+// Validate checks the mempool configuration.
+func (c Config) Validate() error {
+	if c.TxLifetime < 0 {
+		return fmt.Errorf("tx lifetime cannot be negative: %s", c.TxLifetime)
+	}
+	return nil
+}
+
+// ConfigFromAppOptions reads the mempool configuration from app.toml, falling
+// back to the defaults for unset values.
+func ConfigFromAppOptions(appOpts servertypes.AppOptions) Config {
+	cfg := DefaultConfig()
+
+	if v := appOpts.Get(server.FlagMempoolMaxTxs); v != nil {
+		cfg.MaxTxs = cast.ToInt(v)
+	}
+	if v := appOpts.Get(FlagPriceBump); v != nil {
+		cfg.PriceBump = cast.ToUint64(v)
+	}
+	if v := appOpts.Get(FlagTxLifetime); v != nil {
+		cfg.TxLifetime = cast.ToDuration(v)
+	}
+	if v := appOpts.Get(FlagMaxQueuedPerSender); v != nil {
+		cfg.MaxQueuedPerSender = cast.ToUint64(v)
+	}
+	if v := appOpts.Get(FlagMaxNonceGap); v != nil {
+		cfg.MaxNonceGap = cast.ToUint64(v)
+	}
+
+	return cfg
+}
@@ -0,0 +1,399 @@
+package mempool
+
+import (
+	"container/heap"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"sync"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+var (
+	// ErrReplacementUnderpriced is returned when a tx reuses the nonce of a
+	// pending tx without bumping its fee enough.
+	ErrReplacementUnderpriced = errors.New("replacement tx underpriced")
+	// ErrNonceGapTooLarge is returned when the nonce of a tx is too far ahead
+	// of the account sequence.
+	ErrNonceGapTooLarge = errors.New("nonce gap too large")
+	// ErrSenderQueueFull is returned when a sender already has the maximum
+	// number of queued txs.
+	ErrSenderQueueFull = errors.New("sender queue full")
+)
+
+// AccountKeeper returns the current sequence of the tx senders.
+type AccountKeeper interface {
+	GetSequence(ctx context.Context, addr sdk.AccAddress) (uint64, error)
+}
+
+// entry is a tx held in the mempool.
+type entry struct {
+	tx       sdk.Tx
+	hash     [sha256.Size]byte
+	info     txInfo
+	priority int64
+	added    time.Time
+	// order breaks priority ties in favour of the first inserted tx.
+	order uint64
+}
+
+var _ sdkmempool.Mempool = (*PriorityNonceMempool)(nil)
+
+// PriorityNonceMempool is an app-side mempool that orders txs by priority
+// across senders and by nonce within a sender. EVM and Cosmos txs of an
+// account share one nonce space.
+//
+// Txs with a nonce ahead of the account sequence are queued and only become
+// selectable once the gap is filled, a sender can queue up to
+// Config.MaxQueuedPerSender txs at most Config.MaxNonceGap nonces ahead of its
+// sequence. A tx reusing a pending nonce replaces it
+// if its fee cap and tip are bumped by at least Config.PriceBump percent.
+// When full, the lowest priority tx that is last in its sender's queue is
+// evicted, and txs older than Config.TxLifetime are dropped along with the
+// later nonces of their sender.
+type PriorityNonceMempool struct {
+	mtx sync.RWMutex
+
+	cfg           Config
+	accountKeeper AccountKeeper
+	txEncoder     sdk.TxEncoder
+	now           func() time.Time
+
+	senders map[string]map[uint64]*entry
+	count   int
+	order   uint64
+}
+
+// NewPriorityNonceMempool returns a new PriorityNonceMempool.
+func NewPriorityNonceMempool(cfg Config, ak AccountKeeper, txEncoder sdk.TxEncoder) *PriorityNonceMempool {
+	return &PriorityNonceMempool{
+		cfg:           cfg,
+		accountKeeper: ak,
+		txEncoder:     txEncoder,
+		now:           time.Now,
+		senders:       make(map[string]map[uint64]*entry),
+	}
+}
+
+// SetClock overrides the clock used for the tx lifetime, used in tests.
+func (mp *PriorityNonceMempool) SetClock(now func() time.Time) {
+	mp.now = now
+}
+
+// Insert adds a tx to the mempool. The priority is the one set on the
+// context by the ante handler.
+func (mp *PriorityNonceMempool) Insert(goCtx context.Context, tx sdk.Tx) error {
+	info, err := extractTxInfo(tx)
+	if err != nil {
+		return err
+	}
+	hash, err := txHash(mp.txEncoder, tx)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	mp.evictExpired()
+
+	e := &entry{
+		tx:       tx,
+		hash:     hash,
+		info:     info,
+		priority: sdk.UnwrapSDKContext(goCtx).Priority(),
+		added:    mp.now(),
+		order:    mp.order,
+	}
+	mp.order++
+
+	txs := mp.senders[info.sender]
+	if prev, ok := txs[info.nonce]; ok {
+		if prev.hash == hash {
+			return nil
+		}
+		if !isBumped(prev.info.feeCap, info.feeCap, mp.cfg.PriceBump) ||
+			!isBumped(prev.info.tipCap, info.tipCap, mp.cfg.PriceBump) {
+			return errorsmod.Wrapf(
+				ErrReplacementUnderpriced,
+				"nonce %d requires a %d%% fee cap and tip increase", info.nonce, mp.cfg.PriceBump,
+			)
+		}
+		txs[info.nonce] = e
+		return nil
+	}
+
+	seq, err := mp.accountKeeper.GetSequence(goCtx, sdk.AccAddress(info.sender))
+	if err != nil {
+		// the first tx of an account may create it
+		seq = 0
+	}
+	if err := mp.checkQueue(info.sender, seq, info.nonce); err != nil {
+		return err
+	}
+
+	if mp.cfg.MaxTxs > 0 && mp.count >= mp.cfg.MaxTxs {
+		victim := mp.lowestTail()
+		if victim == nil || victim.priority >= e.priority ||
+			(victim.info.sender == info.sender && victim.info.nonce < info.nonce) {
+			return sdkmempool.ErrMempoolTxMaxCapacity
+		}
+		mp.remove(victim)
+	}
+
+	if txs == nil {
+		txs = make(map[uint64]*entry)
+		mp.senders[info.sender] = txs
+	}
+	txs[info.nonce] = e
+	mp.count++
+
+	return nil
+}
+
+// Select returns an iterator over the executable txs, those whose nonces
+// follow the account sequence without gaps. Txs with a nonce below the
+// sequence are dropped.
+func (mp *PriorityNonceMempool) Select(goCtx context.Context, _ [][]byte) sdkmempool.Iterator {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	mp.evictExpired()
+
+	queues := make(senderQueues, 0, len(mp.senders))
+	for sender, txs := range mp.senders {
+		seq, err := mp.accountKeeper.GetSequence(goCtx, sdk.AccAddress(sender))
+		if err != nil {
+			continue
+		}
+
+		for nonce, e := range txs {
+			if nonce < seq {
+				mp.remove(e)
+			}
+		}
+
+		var queue []*entry
+		for nonce := seq; ; nonce++ {
+			e, ok := txs[nonce]
+			if !ok {
+				break
+			}
+			queue = append(queue, e)
+		}
+		if len(queue) > 0 {
+			queues = append(queues, queue)
+		}
+	}
+
+	heap.Init(&queues)
+	selected := make([]sdk.Tx, 0, mp.count)
+	for queues.Len() > 0 {
+		queue := queues[0]
+		selected = append(selected, queue[0].tx)
+		if len(queue) == 1 {
+			heap.Pop(&queues)
+			continue
+		}
+		queues[0] = queue[1:]
+		heap.Fix(&queues, 0)
+	}
+
+	if len(selected) == 0 {
+		return nil
+	}
+	return &iterator{txs: selected}
+}
+
+// CountTx returns the number of txs in the mempool, queued ones included.
+func (mp *PriorityNonceMempool) CountTx() int {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	return mp.count
+}
+
+// Remove removes a tx from the mempool. A tx that was replaced is reported
+// as not found.
+func (mp *PriorityNonceMempool) Remove(tx sdk.Tx) error {
+	info, err := extractTxInfo(tx)
+	if err != nil {
+		return err
+	}
+	hash, err := txHash(mp.txEncoder, tx)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	e, ok := mp.senders[info.sender][info.nonce]
+	if !ok || e.hash != hash {
+		return sdkmempool.ErrTxNotFound
+	}
+	mp.remove(e)
+
+	return nil
+}
+
+// Contains returns true if a tx of the sender with the given nonce is pending.
+func (mp *PriorityNonceMempool) Contains(sender sdk.AccAddress, nonce uint64) bool {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	_, ok := mp.senders[string(sender)][nonce]
+	return ok
+}
+
+// CheckQueue returns an error if a new tx of the sender with the given nonce
+// would exceed the queue limits, given the account sequence.
+func (mp *PriorityNonceMempool) CheckQueue(sender sdk.AccAddress, seq, nonce uint64) error {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	return mp.checkQueue(string(sender), seq, nonce)
+}
+
+// checkQueue enforces the max nonce gap and the max number of queued txs of a
+// sender, those which don't follow the sequence without gaps once the new tx
+// is added. Replacements are not limited.
+func (mp *PriorityNonceMempool) checkQueue(sender string, seq, nonce uint64) error {
+	txs := mp.senders[sender]
+	if _, ok := txs[nonce]; ok || nonce <= seq {
+		return nil
+	}
+	if mp.cfg.MaxNonceGap > 0 && nonce-seq > mp.cfg.MaxNonceGap {
+		return errorsmod.Wrapf(ErrNonceGapTooLarge, "nonce %d is more than %d ahead of the sequence %d", nonce, mp.cfg.MaxNonceGap, seq)
+	}
+	if mp.cfg.MaxQueuedPerSender == 0 {
+		return nil
+	}
+
+	// the first missing nonce, once the new tx is added
+	gap := seq
+	for {
+		if _, ok := txs[gap]; !ok && gap != nonce {
+			break
+		}
+		gap++
+	}
+
+	var queued uint64
+	if nonce > gap {
+		queued++
+	}
+	for n := range txs {
+		if n > gap {
+			queued++
+		}
+	}
+	if queued > mp.cfg.MaxQueuedPerSender {
+		return errorsmod.Wrapf(ErrSenderQueueFull, "at most %d txs can be queued ahead of the sequence %d", mp.cfg.MaxQueuedPerSender, seq)
+	}
+	return nil
+}
+
+func (mp *PriorityNonceMempool) remove(e *entry) {
+	txs := mp.senders[e.info.sender]
+	delete(txs, e.info.nonce)
+	if len(txs) == 0 {
+		delete(mp.senders, e.info.sender)
+	}
+	mp.count--
+}
+
+// lowestTail returns the lowest priority tx among the highest nonce tx of
+// every sender. Evicting it never leaves a nonce gap.
+func (mp *PriorityNonceMempool) lowestTail() *entry {
+	var lowest *entry
+	for _, txs := range mp.senders {
+		var tail *entry
+		for _, e := range txs {
+			if tail == nil || e.info.nonce > tail.info.nonce {
+				tail = e
+			}
+		}
+		if lowest == nil || tail.priority < lowest.priority ||
+			(tail.priority == lowest.priority && tail.order > lowest.order) {
+			lowest = tail
+		}
+	}
+	return lowest
+}
+
+// evictExpired drops the txs older than the lifetime together with the later
+// nonces of their sender, which can no longer be executed.
+func (mp *PriorityNonceMempool) evictExpired() {
+	if mp.cfg.TxLifetime == 0 {
+		return
+	}
+
+	deadline := mp.now().Add(-mp.cfg.TxLifetime)
+	for _, txs := range mp.senders {
+		var (
+			expired  bool
+			minNonce uint64
+		)
+		for nonce, e := range txs {
+			if e.added.Before(deadline) && (!expired || nonce < minNonce) {
+				expired, minNonce = true, nonce
+			}
+		}
+		if !expired {
+			continue
+		}
+		for nonce, e := range txs {
+			if nonce >= minNonce {
+				mp.remove(e)
+			}
+		}
+	}
+}
+
+// senderQueues is a max heap of executable sender queues, ordered by the
+// priority of their first tx.
+type senderQueues [][]*entry
+
+func (q senderQueues) Len() int { return len(q) }
+
+func (q senderQueues) Less(i, j int) bool {
+	if q[i][0].priority != q[j][0].priority {
+		return q[i][0].priority > q[j][0].priority
+	}
+	return q[i][0].order < q[j][0].order
+}
+
+func (q senderQueues) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *senderQueues) Push(x any) { *q = append(*q, x.([]*entry)) }
+
+func (q *senderQueues) Pop() any {
+	old := *q
+	n := len(old)
+	x := old[n-1]
+	*q = old[:n-1]
+	return x
+}
+
+// iterator walks a snapshot of the selected txs.
+type iterator struct {
+	txs []sdk.Tx
+	idx int
+}
+
+func (it *iterator) Next() sdkmempool.Iterator {
+	if it.idx+1 >= len(it.txs) {
+		return nil
+	}
+	return &iterator{txs: it.txs, idx: it.idx + 1}
+}
+
+func (it *iterator) Tx() sdk.Tx {
+	return it.txs[it.idx]
+}
@@ -0,0 +1,199 @@
+package mempool_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	evmencoding "github.com/cosmos/evm/encoding"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/rollchains/flora/app/mempool"
+)
+
+type mockAccountKeeper map[string]uint64
+
+func (k mockAccountKeeper) GetSequence(_ context.Context, addr sdk.AccAddress) (uint64, error) {
+	return k[string(addr)], nil
+}
+
+type testTx struct {
+	tx       sdk.Tx
+	priority int64
+}
+
+type mempoolFixture struct {
+	t        *testing.T
+	txConfig client.TxConfig
+	accounts mockAccountKeeper
+	mp       *mempool.PriorityNonceMempool
+}
+
+func newMempoolFixture(t *testing.T, cfg mempool.Config) *mempoolFixture {
+	txConfig := evmencoding.MakeConfig().TxConfig
+	accounts := mockAccountKeeper{}
+	return &mempoolFixture{
+		t:        t,
+		txConfig: txConfig,
+		accounts: accounts,
+		mp:       mempool.NewPriorityNonceMempool(cfg, accounts, txConfig.TxEncoder()),
+	}
+}
+
+// ethTx builds a dynamic fee EVM tx, the priority is its tip.
+func (f *mempoolFixture) ethTx(from common.Address, nonce uint64, feeCap, tip int64) testTx {
+	to := common.HexToAddress("0x01")
+	msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		Nonce:     nonce,
+		GasLimit:  21000,
+		GasFeeCap: big.NewInt(feeCap),
+		GasTipCap: big.NewInt(tip),
+		ChainID:   big.NewInt(9000),
+		To:        &to,
+	})
+	msg.From = from.Hex()
+
+	builder := f.txConfig.NewTxBuilder()
+	require.NoError(f.t, builder.SetMsgs(msg))
+	return testTx{tx: builder.GetTx(), priority: tip}
+}
+
+func (f *mempoolFixture) insert(tx testTx) error {
+	return f.mp.Insert(sdk.Context{}.WithPriority(tx.priority), tx.tx)
+}
+
+func (f *mempoolFixture) selected() []sdk.Tx {
+	var txs []sdk.Tx
+	for it := f.mp.Select(sdk.Context{}, nil); it != nil; it = it.Next() {
+		txs = append(txs, it.Tx())
+	}
+	return txs
+}
+
+func TestMempoolNonceOrdering(t *testing.T) {
+	f := newMempoolFixture(t, mempool.DefaultConfig())
+	alice := common.HexToAddress("0xa1")
+	bob := common.HexToAddress("0xb0b")
+
+	alice0, alice1, alice2 := f.ethTx(alice, 0, 100, 1), f.ethTx(alice, 1, 100, 90), f.ethTx(alice, 2, 100, 1)
+	bob0 := f.ethTx(bob, 0, 100, 50)
+
+	// nonce 2 is queued behind a gap
+	require.NoError(t, f.insert(alice2))
+	require.NoError(t, f.insert(alice0))
+	require.NoError(t, f.insert(bob0))
+	require.Equal(t, 3, f.mp.CountTx())
+	require.Equal(t, []sdk.Tx{bob0.tx, alice0.tx}, f.selected())
+
+	// filling the gap makes nonce 2 executable, the heads are ordered by priority
+	require.NoError(t, f.insert(alice1))
+	require.Equal(t, []sdk.Tx{bob0.tx, alice0.tx, alice1.tx, alice2.tx}, f.selected())
+
+	// once the sequence moves past a nonce the tx is dropped
+	f.accounts[string(alice.Bytes())] = 1
+	require.Equal(t, []sdk.Tx{alice1.tx, bob0.tx, alice2.tx}, f.selected())
+	require.Equal(t, 3, f.mp.CountTx())
+}
+
+func TestMempoolReplaceByFee(t *testing.T) {
+	f := newMempoolFixture(t, mempool.DefaultConfig())
+	alice := common.HexToAddress("0xa1")
+
+	original := f.ethTx(alice, 0, 100, 10)
+	require.NoError(t, f.insert(original))
+
+	// both the fee cap and the tip must be bumped by 10%
+	require.ErrorIs(t, f.insert(f.ethTx(alice, 0, 105, 11)), mempool.ErrReplacementUnderpriced)
+	require.ErrorIs(t, f.insert(f.ethTx(alice, 0, 110, 10)), mempool.ErrReplacementUnderpriced)
+
+	replacement := f.ethTx(alice, 0, 110, 11)
+	require.NoError(t, f.insert(replacement))
+	require.Equal(t, 1, f.mp.CountTx())
+	require.Equal(t, []sdk.Tx{replacement.tx}, f.selected())
+
+	// the replaced tx is gone, removing it must not drop the replacement
+	require.ErrorIs(t, f.mp.Remove(original.tx), sdkmempool.ErrTxNotFound)
+	require.NoError(t, f.mp.Remove(replacement.tx))
+	require.Zero(t, f.mp.CountTx())
+}
+
+func TestMempoolCapacityEviction(t *testing.T) {
+	cfg := mempool.DefaultConfig()
+	cfg.MaxTxs = 3
+	f := newMempoolFixture(t, cfg)
+	alice := common.HexToAddress("0xa1")
+	bob := common.HexToAddress("0xb0b")
+	carol := common.HexToAddress("0xca201")
+
+	alice0, alice1 := f.ethTx(alice, 0, 100, 1), f.ethTx(alice, 1, 100, 30)
+	bob0 := f.ethTx(bob, 0, 100, 5)
+	require.NoError(t, f.insert(alice0))
+	require.NoError(t, f.insert(alice1))
+	require.NoError(t, f.insert(bob0))
+
+	// a tx cheaper than every queue tail is rejected
+	require.ErrorIs(t, f.insert(f.ethTx(carol, 0, 100, 5)), sdkmempool.ErrMempoolTxMaxCapacity)
+
+	// otherwise the lowest priority tail is evicted, alice0 is kept as it
+	// would leave a nonce gap
+	carol0 := f.ethTx(carol, 0, 100, 10)
+	require.NoError(t, f.insert(carol0))
+	require.Equal(t, 3, f.mp.CountTx())
+	require.Equal(t, []sdk.Tx{carol0.tx, alice0.tx, alice1.tx}, f.selected())
+}
+
+func TestMempoolQueueLimits(t *testing.T) {
+	cfg := mempool.DefaultConfig()
+	cfg.MaxQueuedPerSender = 2
+	cfg.MaxNonceGap = 5
+	f := newMempoolFixture(t, cfg)
+	alice := common.HexToAddress("0xa1")
+	f.accounts[string(alice.Bytes())] = 10
+
+	// the nonce can be at most 5 ahead of the sequence
+	require.ErrorIs(t, f.insert(f.ethTx(alice, 16, 100, 1)), mempool.ErrNonceGapTooLarge)
+	require.NoError(t, f.insert(f.ethTx(alice, 15, 100, 1)))
+
+	// 2 txs can be queued behind the gap
+	require.NoError(t, f.insert(f.ethTx(alice, 12, 100, 1)))
+	require.ErrorIs(t, f.insert(f.ethTx(alice, 13, 100, 1)), mempool.ErrSenderQueueFull)
+	require.ErrorIs(t, f.mp.CheckQueue(alice.Bytes(), 10, 13), mempool.ErrSenderQueueFull)
+
+	// the executable txs and the replacements are not limited
+	require.NoError(t, f.insert(f.ethTx(alice, 10, 100, 1)))
+	require.NoError(t, f.insert(f.ethTx(alice, 12, 110, 2)))
+
+	// filling the gap frees the queue
+	require.NoError(t, f.insert(f.ethTx(alice, 11, 100, 1)))
+	require.NoError(t, f.insert(f.ethTx(alice, 14, 100, 1)))
+	require.Equal(t, 5, f.mp.CountTx())
+}
+
+func TestMempoolTxLifetime(t *testing.T) {
+	cfg := mempool.DefaultConfig()
+	cfg.TxLifetime = time.Hour
+	f := newMempoolFixture(t, cfg)
+	alice := common.HexToAddress("0xa1")
+	bob := common.HexToAddress("0xb0b")
+
+	now := time.Unix(0, 0)
+	f.mp.SetClock(func() time.Time { return now })
+
+	require.NoError(t, f.insert(f.ethTx(alice, 0, 100, 1)))
+	now = now.Add(30 * time.Minute)
+	require.NoError(t, f.insert(f.ethTx(alice, 1, 100, 1)))
+	bob0 := f.ethTx(bob, 0, 100, 1)
+	require.NoError(t, f.insert(bob0))
+
+	// alice0 expires and takes alice1 with it
+	now = now.Add(45 * time.Minute)
+	require.Equal(t, []sdk.Tx{bob0.tx}, f.selected())
+	require.Equal(t, 1, f.mp.CountTx())
+}
@@ -0,0 +1,83 @@
+package mempool
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// txInfo holds the ordering data extracted from a tx.
+type txInfo struct {
+	// sender holds the raw address bytes.
+	sender string
+	nonce  uint64
+	// feeCap and tipCap are compared on replacement. Both are the gas price for
+	// legacy EVM txs and Cosmos txs.
+	feeCap *big.Int
+	tipCap *big.Int
+}
+
+// extractTxInfo returns the sender, nonce and gas prices of a tx. EVM txs
+// are keyed by the sender and nonce of their MsgEthereumTx, Cosmos txs by
+// their first signer, so both share the account sequence.
+func extractTxInfo(tx sdk.Tx) (txInfo, error) {
+	msgs := tx.GetMsgs()
+	if len(msgs) > 0 {
+		if ethMsg, ok := msgs[0].(*evmtypes.MsgEthereumTx); ok {
+			txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+			if err != nil {
+				return txInfo{}, err
+			}
+			from := ethMsg.GetFrom()
+			if from.Empty() {
+				return txInfo{}, fmt.Errorf("ethereum tx %s has no sender", ethMsg.Hash)
+			}
+			return txInfo{
+				sender: string(from),
+				nonce:  txData.GetNonce(),
+				feeCap: txData.GetGasFeeCap(),
+				tipCap: txData.GetGasTipCap(),
+			}, nil
+		}
+	}
+
+	signers, err := sdkmempool.NewDefaultSignerExtractionAdapter().GetSigners(tx)
+	if err != nil {
+		return txInfo{}, err
+	}
+	if len(signers) == 0 {
+		return txInfo{}, fmt.Errorf("tx requires at least one signer")
+	}
+
+	price := new(big.Int)
+	if feeTx, ok := tx.(sdk.FeeTx); ok && feeTx.GetGas() > 0 {
+		fee := feeTx.GetFee().AmountOf(evmtypes.GetEVMCoinDenom())
+		price.Quo(fee.BigInt(), new(big.Int).SetUint64(feeTx.GetGas()))
+	}
+
+	return txInfo{
+		sender: string(signers[0].Signer),
+		nonce:  signers[0].Sequence,
+		feeCap: price,
+		tipCap: price,
+	}, nil
+}
+
+// isBumped returns true if next exceeds prev by at least bump percent.
+func isBumped(prev, next *big.Int, bump uint64) bool {
+	threshold := new(big.Int).Mul(prev, new(big.Int).SetUint64(100+bump))
+	return new(big.Int).Mul(next, big.NewInt(100)).Cmp(threshold) >= 0
+}
+
+// txHash identifies a tx by the hash of its encoding.
+func txHash(encode sdk.TxEncoder, tx sdk.Tx) ([sha256.Size]byte, error) {
+	bz, err := encode(tx)
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	return sha256.Sum256(bz), nil
+}
@@ -0,0 +1,365 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	oracleprecompile "github.com/rollchains/flora/precompiles/oracle"
+	"github.com/rollchains/flora/x/oracle"
+	oracletypes "github.com/rollchains/flora/x/oracle/types"
+)
+
+type failingProvider struct{}
+
+func (failingProvider) Values(sdk.Context) (map[string]math.LegacyDec, error) {
+	return nil, errors.New("exchange unreachable")
+}
+
+func TestOracleVoteExtensions(t *testing.T) {
+	gapp := Setup(t)
+	ctx := gapp.BaseApp.NewContext(false)
+	handler := gapp.VoteExtensionHandler
+
+	extend := func() []byte {
+		res, err := handler.ExtendVoteHandler()(ctx, &abci.RequestExtendVote{Height: 2})
+		require.NoError(t, err)
+		return res.VoteExtension
+	}
+	verify := func(ve []byte) abci.ResponseVerifyVoteExtension_VerifyStatus {
+		res, err := handler.VerifyVoteExtensionHandler()(ctx, &abci.RequestVerifyVoteExtension{Height: 2, VoteExtension: ve})
+		require.NoError(t, err)
+		return res.Status
+	}
+
+	// no provider, nothing reported
+	require.Empty(t, extend())
+	require.Equal(t, abci.ResponseVerifyVoteExtension_ACCEPT, verify(nil))
+
+	handler.SetProvider(oracle.MockProvider{
+		"BTC/USD": math.LegacyMustNewDecFromStr("65000.5"),
+		"ETH/USD": math.LegacyMustNewDecFromStr("3200"),
+	})
+	bz := extend()
+	ve, err := oracletypes.DecodeVoteExtension(bz)
+	require.NoError(t, err)
+	require.Equal(t, []oracletypes.ReportedValue{
+		{Key: "BTC/USD", Value: math.LegacyMustNewDecFromStr("65000.5")},
+		{Key: "ETH/USD", Value: math.LegacyMustNewDecFromStr("3200")},
+	}, ve.Values)
+	require.Equal(t, abci.ResponseVerifyVoteExtension_ACCEPT, verify(bz))
+
+	// a failing or invalid provider must not prevent voting
+	handler.SetProvider(failingProvider{})
+	require.Empty(t, extend())
+	handler.SetProvider(oracle.MockProvider{"ETH/USD": math.LegacyNewDec(-1)})
+	require.Empty(t, extend())
+
+	// malformed vote extensions are rejected
+	require.Equal(t, abci.ResponseVerifyVoteExtension_REJECT, verify([]byte("not a vote extension")))
+	negative, err := (&oracletypes.VoteExtension{Values: []oracletypes.ReportedValue{
+		{Key: "ETH/USD", Value: math.LegacyNewDec(-1)},
+	}}).Marshal()
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseVerifyVoteExtension_REJECT, verify(negative))
+	unsorted, err := (&oracletypes.VoteExtension{Values: []oracletypes.ReportedValue{
+		{Key: "ETH/USD", Value: math.LegacyOneDec()},
+		{Key: "BTC/USD", Value: math.LegacyOneDec()},
+	}}).Marshal()
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseVerifyVoteExtension_REJECT, verify(unsorted))
+}
+
+// oracleVote returns a commit vote of a validator reporting the values.
+func oracleVote(t *testing.T, power int64, values map[string]string) abci.ExtendedVoteInfo {
+	decs := make(map[string]math.LegacyDec, len(values))
+	for key, value := range values {
+		decs[key] = math.LegacyMustNewDecFromStr(value)
+	}
+	ve := oracletypes.NewVoteExtension(decs)
+	bz, err := ve.Marshal()
+	require.NoError(t, err)
+
+	return abci.ExtendedVoteInfo{
+		Validator:     abci.Validator{Power: power},
+		VoteExtension: bz,
+		BlockIdFlag:   cmtproto.BlockIDFlagCommit,
+	}
+}
+
+func TestOracleComputeMedians(t *testing.T) {
+	extCommit := abci.ExtendedCommitInfo{Votes: []abci.ExtendedVoteInfo{
+		oracleVote(t, 10, map[string]string{"ETH/USD": "3000", "BTC/USD": "60000"}),
+		oracleVote(t, 20, map[string]string{"ETH/USD": "3100"}),
+		oracleVote(t, 30, map[string]string{"ETH/USD": "3200", "BTC/USD": "61000"}),
+		oracleVote(t, 40, map[string]string{"ETH/USD": "9000", "BTC/USD": "62000"}),
+		// absent and malformed votes count in the total power only
+		{Validator: abci.Validator{Power: 5}, BlockIdFlag: cmtproto.BlockIDFlagAbsent},
+		{Validator: abci.Validator{Power: 5}, VoteExtension: []byte("garbage"), BlockIdFlag: cmtproto.BlockIDFlagCommit},
+	}}
+
+	// BTC/USD is reported by 80 of 110, ETH/USD by 100 of 110
+	medians := oracletypes.ComputeMedians(extCommit, 7)
+	require.Equal(t, []oracletypes.Median{
+		{Key: "BTC/USD", Value: math.LegacyMustNewDecFromStr("61000"), Height: 7},
+		{Key: "ETH/USD", Value: math.LegacyMustNewDecFromStr("3200"), Height: 7},
+	}, medians)
+
+	// without 2/3 of the power nothing is kept
+	extCommit.Votes = append(extCommit.Votes, abci.ExtendedVoteInfo{Validator: abci.Validator{Power: 50}, BlockIdFlag: cmtproto.BlockIDFlagNil})
+	require.Empty(t, oracletypes.ComputeMedians(extCommit, 7))
+}
+
+// oracleTestContext returns a context with vote extensions enabled and the
+// ETH/USD pair registered.
+func oracleTestContext(t *testing.T, gapp *ChainApp, params oracletypes.Params) sdk.Context {
+	t.Helper()
+
+	ctx := cronTestContext(t, gapp).WithBlockHeight(10).WithBlockTime(time.Unix(1_700_000_000, 0).UTC()).WithConsensusParams(cmtproto.ConsensusParams{
+		Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 2},
+	})
+	params.Pairs = []oracletypes.Pair{{Key: "ETH/USD", Decimals: 8, MaxStaleness: 60}}
+	require.NoError(t, gapp.OracleKeeper.Params.Set(ctx, params))
+	return ctx
+}
+
+// injectVotes returns the injected tx of a commit.
+func injectVotes(t *testing.T, votes ...abci.ExtendedVoteInfo) *abci.RequestFinalizeBlock {
+	injected, err := (&abci.ExtendedCommitInfo{Votes: votes}).Marshal()
+	require.NoError(t, err)
+	return &abci.RequestFinalizeBlock{Txs: [][]byte{injected}}
+}
+
+func TestOraclePreBlocker(t *testing.T) {
+	gapp := Setup(t)
+	ctx := oracleTestContext(t, gapp, oracletypes.DefaultParams())
+	blockTime := ctx.BlockTime()
+
+	// BTC/USD is not a registered pair
+	req := injectVotes(t,
+		oracleVote(t, 10, map[string]string{"ETH/USD": "3000", "BTC/USD": "60000"}),
+		oracleVote(t, 10, map[string]string{"ETH/USD": "3100", "BTC/USD": "60000"}),
+		oracleVote(t, 10, map[string]string{"ETH/USD": "3200", "BTC/USD": "60000"}),
+	)
+	require.NoError(t, gapp.OracleKeeper.PreBlocker(ctx, req))
+	median, err := gapp.OracleKeeper.GetMedian(ctx, "ETH/USD")
+	require.NoError(t, err)
+	require.Equal(t, oracletypes.Median{
+		Key: "ETH/USD", Value: math.LegacyMustNewDecFromStr("3100"), Height: 10, Timestamp: blockTime, Round: 1,
+	}, median)
+
+	_, err = gapp.OracleKeeper.GetMedian(ctx, "BTC/USD")
+	require.ErrorIs(t, err, oracletypes.ErrMedianNotFound)
+
+	// every update starts a new round
+	require.NoError(t, gapp.OracleKeeper.PreBlocker(ctx.WithBlockHeight(11), req))
+	median, err = gapp.OracleKeeper.GetMedian(ctx, "ETH/USD")
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), median.Round)
+
+	// prices older than the max staleness cannot be read
+	_, _, err = gapp.OracleKeeper.GetPrice(ctx.WithBlockTime(blockTime.Add(60*time.Second)), "ETH/USD")
+	require.NoError(t, err)
+	_, _, err = gapp.OracleKeeper.GetPrice(ctx.WithBlockTime(blockTime.Add(61*time.Second)), "ETH/USD")
+	require.ErrorIs(t, err, oracletypes.ErrStalePrice)
+
+	// a proposal without a valid injected tx is rejected
+	accept := func(sdk.Context, *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+	}
+	handler := oracle.NewProposalHandler(gapp.OracleKeeper, gapp.StakingKeeper, nil, accept)
+	for _, txs := range [][][]byte{nil, {[]byte("garbage")}} {
+		res, err := handler.ProcessProposalHandler()(ctx, &abci.RequestProcessProposal{Height: 10, Txs: txs})
+		require.NoError(t, err)
+		require.Equal(t, abci.ResponseProcessProposal_REJECT, res.Status)
+	}
+}
+
+func TestOraclePriceFeed(t *testing.T) {
+	gapp := Setup(t)
+	ctx := oracleTestContext(t, gapp, oracletypes.DefaultParams())
+	feedABI, err := oracleprecompile.LoadABI()
+	require.NoError(t, err)
+	feed := oracletypes.FeedAddress("ETH/USD")
+	caller := common.BytesToAddress(secp256k1.GenPrivKey().PubKey().Address())
+	gapp.AccountKeeper.SetAccount(ctx, gapp.AccountKeeper.NewAccountWithAddress(ctx, caller.Bytes()))
+
+	call := func(ctx sdk.Context, method string, args ...interface{}) ([]interface{}, error) {
+		res, err := gapp.EVMKeeper.CallEVM(ctx, feedABI, caller, feed, false, method, args...)
+		if err != nil {
+			return nil, err
+		}
+		return feedABI.Unpack(method, res.Ret)
+	}
+
+	out, err := call(ctx, "decimals")
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{uint8(8)}, out)
+	out, err = call(ctx, "description")
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"ETH/USD"}, out)
+
+	// no price yet
+	_, err = call(ctx, "latestRoundData")
+	require.Error(t, err)
+
+	require.NoError(t, gapp.OracleKeeper.PreBlocker(ctx, injectVotes(t,
+		oracleVote(t, 10, map[string]string{"ETH/USD": "3100.123456789"}),
+	)))
+
+	updatedAt := big.NewInt(ctx.BlockTime().Unix())
+	round := []interface{}{big.NewInt(1), big.NewInt(310012345678), updatedAt, updatedAt, big.NewInt(1)}
+	out, err = call(ctx, "latestRoundData")
+	require.NoError(t, err)
+	require.Equal(t, round, out)
+	out, err = call(ctx, "getRoundData", big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, round, out)
+	_, err = call(ctx, "getRoundData", big.NewInt(2))
+	require.Error(t, err)
+
+	// stale prices revert, the round data can still be read
+	stale := ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	_, err = call(stale, "latestRoundData")
+	require.ErrorContains(t, err, "stale price")
+	_, err = call(stale, "getRoundData", big.NewInt(1))
+	require.NoError(t, err)
+}
+
+func TestOracleMissSlashing(t *testing.T) {
+	gapp := Setup(t)
+	params := oracletypes.DefaultParams()
+	params.SlashWindow = 4
+	params.SlashFraction = math.LegacyNewDecWithPrec(1, 1)
+	ctx := oracleTestContext(t, gapp, params).WithBlockHeight(9)
+
+	validators, err := gapp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	validator := validators[0]
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	power := validator.GetConsensusPower(gapp.StakingKeeper.PowerReduction(ctx))
+
+	vote := func(values map[string]string) abci.ExtendedVoteInfo {
+		v := oracleVote(t, power, values)
+		v.Validator.Address = consAddr
+		return v
+	}
+	misses := func() uint64 {
+		n, err := gapp.OracleKeeper.MissCounters.Get(ctx, consAddr)
+		if errors.Is(err, collections.ErrNotFound) {
+			return 0
+		}
+		require.NoError(t, err)
+		return n
+	}
+
+	// the other validators hold enough power to update the price without it
+	others := oracleVote(t, 3*power, map[string]string{"ETH/USD": "3000"})
+	others.Validator.Address = sdk.ConsAddress("others")
+
+	// reporting the registered pairs only is valid, missing one is not
+	require.NoError(t, gapp.OracleKeeper.PreBlocker(ctx, injectVotes(t, vote(map[string]string{"ETH/USD": "3000"}), others)))
+	require.Zero(t, misses())
+	require.NoError(t, gapp.OracleKeeper.PreBlocker(ctx.WithBlockHeight(10), injectVotes(t, vote(map[string]string{"BTC/USD": "60000"}), others)))
+	require.Equal(t, uint64(1), misses())
+	absent := abci.ExtendedVoteInfo{Validator: abci.Validator{Address: consAddr, Power: power}, BlockIdFlag: cmtproto.BlockIDFlagAbsent}
+	require.NoError(t, gapp.OracleKeeper.PreBlocker(ctx.WithBlockHeight(11), injectVotes(t, absent, others)))
+	require.Equal(t, uint64(2), misses())
+
+	// 1 valid report out of 4 is below half of the window
+	tokens := validator.GetTokens()
+	require.NoError(t, gapp.OracleKeeper.PreBlocker(ctx.WithBlockHeight(12), injectVotes(t, absent, others)))
+	require.Zero(t, misses())
+
+	validator, err = gapp.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+	require.NoError(t, err)
+	require.True(t, validator.IsJailed())
+	require.Equal(t, tokens.Sub(tokens.QuoRaw(10)), validator.GetTokens())
+}
+
+func TestOracleEmptyVoteExtensions(t *testing.T) {
+	gapp := Setup(t)
+	params := oracletypes.DefaultParams()
+	params.SlashWindow = 4
+	ctx := oracleTestContext(t, gapp, params).WithBlockHeight(9)
+
+	validators, err := gapp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	votes := make([]abci.ExtendedVoteInfo, len(validators))
+	for i, validator := range validators {
+		consAddr, err := validator.GetConsAddr()
+		require.NoError(t, err)
+		votes[i] = abci.ExtendedVoteInfo{
+			Validator:   abci.Validator{Address: consAddr, Power: validator.GetConsensusPower(gapp.StakingKeeper.PowerReduction(ctx))},
+			BlockIdFlag: cmtproto.BlockIDFlagCommit,
+		}
+	}
+
+	// no validator has a provider, nothing is updated and nobody misses
+	for height := int64(9); height <= 12; height++ {
+		require.NoError(t, gapp.OracleKeeper.PreBlocker(ctx.WithBlockHeight(height), injectVotes(t, votes...)))
+	}
+	_, err = gapp.OracleKeeper.GetMedian(ctx, "ETH/USD")
+	require.ErrorIs(t, err, oracletypes.ErrMedianNotFound)
+
+	for _, vote := range votes {
+		validator, err := gapp.StakingKeeper.GetValidatorByConsAddr(ctx, vote.Validator.Address)
+		require.NoError(t, err)
+		require.False(t, validator.IsJailed())
+	}
+}
+
+func TestOracleProviderConfig(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"ETH/USD": "3200.5"}`))
+	}))
+	defer server.Close()
+
+	cfg := oracle.Config{Provider: oracle.ProviderHTTP, Endpoint: server.URL, Timeout: time.Second}
+	provider, err := cfg.NewProvider()
+	require.NoError(t, err)
+	values, err := provider.Values(sdk.Context{}.WithContext(context.Background()))
+	require.NoError(t, err)
+	require.Equal(t, map[string]math.LegacyDec{"ETH/USD": math.LegacyMustNewDecFromStr("3200.5")}, values)
+
+	_, err = oracle.Config{Provider: "exchange", Timeout: time.Second}.NewProvider()
+	require.ErrorContains(t, err, "unknown oracle provider")
+	_, err = oracle.Config{Provider: oracle.ProviderHTTP, Endpoint: "localhost:8080", Timeout: time.Second}.NewProvider()
+	require.ErrorContains(t, err, "the scheme must be http or https")
+
+	// the provider is read from app.toml
+	appOpts := simtestutil.AppOptionsMap{
+		flags.FlagHome:      t.TempDir(),
+		oracle.FlagProvider: oracle.ProviderHTTP,
+		oracle.FlagEndpoint: server.URL,
+	}
+	require.Equal(t, oracle.Config{Provider: oracle.ProviderHTTP, Endpoint: server.URL, Timeout: oracle.DefaultTimeout}, oracle.ConfigFromAppOptions(appOpts))
+
+	// a node without a provider refuses to run a chain with oracle pairs
+	gapp := Setup(t)
+	ctx := oracleTestContext(t, gapp, oracletypes.DefaultParams())
+	require.ErrorContains(t, gapp.checkOracleProvider(ctx), "no oracle provider is set")
+	gapp.oracleConfig.Provider = oracle.ProviderNone
+	require.NoError(t, gapp.checkOracleProvider(ctx))
+	gapp.oracleConfig.Provider = ""
+	require.NoError(t, gapp.OracleKeeper.Params.Set(ctx, oracletypes.DefaultParams()))
+	require.NoError(t, gapp.checkOracleProvider(ctx))
+}
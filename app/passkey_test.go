@@ -0,0 +1,187 @@
+package app
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/rollchains/flora/crypto/keys/passkey"
+	contractaccountstypes "github.com/rollchains/flora/x/contractaccounts/types"
+)
+
+// passkeyRPID is the relying party id of the passkeys in the tests.
+const passkeyRPID = "localhost"
+
+// signWithPasskey returns the WebAuthn assertion of the passkey over the
+// message, with the given client data type.
+func signWithPasskey(t *testing.T, priv *ecdsa.PrivateKey, msg []byte, clientDataType string) []byte {
+	t.Helper()
+
+	rpIDHash := sha256.Sum256([]byte(passkeyRPID))
+	authenticatorData := append(rpIDHash[:], 0x05, 0, 0, 0, 1) // user present and verified
+	clientDataJSON, err := json.Marshal(map[string]string{
+		"type":      clientDataType,
+		"challenge": passkey.Challenge(msg),
+		"origin":    "http://localhost",
+	})
+	require.NoError(t, err)
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	hash := sha256.Sum256(append(authenticatorData, clientDataHash[:]...))
+	r, s, err := ecdsa.Sign(rand.Reader, priv, hash[:])
+	require.NoError(t, err)
+
+	// the wallets normalize the signatures of the authenticators to low S
+	if n := elliptic.P256().Params().N; s.Cmp(new(big.Int).Rsh(n, 1)) > 0 {
+		s.Sub(n, s)
+	}
+	signature := encodeP256Signature(t, r, s)
+
+	assertion := passkey.Assertion{AuthenticatorData: authenticatorData, ClientDataJson: clientDataJSON, Signature: signature}
+	bz, err := assertion.Marshal()
+	require.NoError(t, err)
+	return bz
+}
+
+// encodeP256Signature returns the ASN.1 DER encoding of an ECDSA signature.
+func encodeP256Signature(t *testing.T, r, s *big.Int) []byte {
+	t.Helper()
+
+	bz, err := asn1.Marshal(struct{ R, S *big.Int }{r, s})
+	require.NoError(t, err)
+	return bz
+}
+
+func TestPasskeySignedTx(t *testing.T) {
+	gapp := Setup(t)
+	ctx := gapp.BaseApp.NewContext(false).WithBlockHeight(1).
+		WithConsensusParams(cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxGas: 10_000_000}})
+	txConfig := gapp.TxConfig()
+	denom := evmtypes.GetEVMCoinDenom()
+
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	pubKey := passkey.NewPubKey(&priv.PublicKey)
+	addr := sdk.AccAddress(pubKey.Address())
+	initAccountWithCoins(gapp, ctx, addr, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntWithDecimal(1, 18))))
+	acc := gapp.AccountKeeper.GetAccount(ctx, addr)
+
+	signTx := func(clientDataType string, sequence uint64) sdk.Tx {
+		builder := txConfig.NewTxBuilder()
+		to := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(addr, to, sdk.NewCoins(sdk.NewInt64Coin(denom, 1)))))
+		builder.SetGasLimit(200_000)
+		builder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntWithDecimal(1, 15))))
+
+		sig := signing.SignatureV2{
+			PubKey:   pubKey,
+			Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+			Sequence: sequence,
+		}
+		require.NoError(t, builder.SetSignatures(sig))
+
+		signerData := authsigning.SignerData{
+			Address:       addr.String(),
+			ChainID:       ctx.ChainID(),
+			AccountNumber: acc.GetAccountNumber(),
+			Sequence:      sequence,
+			PubKey:        pubKey,
+		}
+		signBytes, err := authsigning.GetSignBytesAdapter(ctx, txConfig.SignModeHandler(), signing.SignMode_SIGN_MODE_DIRECT, signerData, builder.GetTx())
+		require.NoError(t, err)
+
+		sig.Data = &signing.SingleSignatureData{
+			SignMode:  signing.SignMode_SIGN_MODE_DIRECT,
+			Signature: signWithPasskey(t, priv, signBytes, clientDataType),
+		}
+		require.NoError(t, builder.SetSignatures(sig))
+		return builder.GetTx()
+	}
+
+	// the passkeys can't sign until the relying party id is set
+	_, err = gapp.AnteHandler()(ctx, signTx("webauthn.get", 0), false)
+	require.ErrorContains(t, err, "relying party id not configured")
+	require.ErrorContains(t, contractaccountstypes.NewParams(contractaccountstypes.DefaultSignatureGasCap, "https://localhost").Validate(), "must be a domain")
+	params := contractaccountstypes.NewParams(contractaccountstypes.DefaultSignatureGasCap, passkeyRPID)
+	require.NoError(t, params.Validate())
+	require.NoError(t, gapp.ContractAccountsKeeper.Params.Set(ctx, params))
+
+	// the assertion of another ceremony is rejected
+	_, err = gapp.AnteHandler()(ctx, signTx("webauthn.create", 0), false)
+	require.ErrorContains(t, err, "signature verification failed")
+
+	newCtx, err := gapp.AnteHandler()(ctx, signTx("webauthn.get", 0), false)
+	require.NoError(t, err)
+	require.True(t, pubKey.Equals(gapp.AccountKeeper.GetAccount(newCtx, addr).GetPubKey()))
+
+	// the signatures are replay protected by the account sequence
+	_, err = gapp.AnteHandler()(newCtx, signTx("webauthn.get", 0), false)
+	require.Error(t, err)
+	_, err = gapp.AnteHandler()(newCtx, signTx("webauthn.get", 1), false)
+	require.NoError(t, err)
+}
+
+func TestPasskeyAssertion(t *testing.T) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	pubKey := passkey.NewPubKey(&priv.PublicKey)
+	msg := []byte("sign bytes")
+
+	var assertion passkey.Assertion
+	require.NoError(t, assertion.Unmarshal(signWithPasskey(t, priv, msg, "webauthn.get")))
+	require.NoError(t, assertion.Verify(*pubKey, msg, passkeyRPID))
+	require.ErrorContains(t, assertion.Verify(*pubKey, []byte("other sign bytes"), passkeyRPID), "challenge does not match")
+	require.ErrorContains(t, assertion.Verify(*pubKey, msg, ""), "relying party id not configured")
+
+	// the assertions are only verified by the ante handler, with the relying
+	// party id of the params
+	require.False(t, pubKey.VerifySignature(msg, signWithPasskey(t, priv, msg, "webauthn.get")))
+
+	// the user has to be present
+	assertion.AuthenticatorData[32] = 0x04
+	require.ErrorContains(t, assertion.Verify(*pubKey, msg, passkeyRPID), "user not present")
+
+	// the assertion has to be of an authentication ceremony
+	require.NoError(t, assertion.Unmarshal(signWithPasskey(t, priv, msg, "webauthn.create")))
+	require.ErrorContains(t, assertion.Verify(*pubKey, msg, passkeyRPID), `invalid client data type "webauthn.create"`)
+
+	// the passkey has to be scoped to the relying party id of the chain
+	require.NoError(t, assertion.Unmarshal(signWithPasskey(t, priv, msg, "webauthn.get")))
+	otherRPIDHash := sha256.Sum256([]byte("wallet.example"))
+	copy(assertion.AuthenticatorData, otherRPIDHash[:])
+	require.ErrorContains(t, assertion.Verify(*pubKey, msg, passkeyRPID), "rp id hash does not match")
+	require.NoError(t, assertion.Unmarshal(signWithPasskey(t, priv, msg, "webauthn.get")))
+	require.ErrorContains(t, assertion.Verify(*pubKey, msg, "wallet.example"), "rp id hash does not match")
+
+	// the high S form of a valid signature is rejected
+	require.NoError(t, assertion.Unmarshal(signWithPasskey(t, priv, msg, "webauthn.get")))
+	require.NoError(t, assertion.Verify(*pubKey, msg, passkeyRPID))
+	var sig struct{ R, S *big.Int }
+	_, err = asn1.Unmarshal(assertion.Signature, &sig)
+	require.NoError(t, err)
+	assertion.Signature = encodeP256Signature(t, sig.R, new(big.Int).Sub(elliptic.P256().Params().N, sig.S))
+	require.ErrorContains(t, assertion.Verify(*pubKey, msg, passkeyRPID), "lower half of the curve order")
+
+	// another passkey
+	other, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	require.NoError(t, assertion.Unmarshal(signWithPasskey(t, other, msg, "webauthn.get")))
+	require.ErrorContains(t, assertion.Verify(*pubKey, msg, passkeyRPID), "invalid signature")
+}
@@ -0,0 +1,142 @@
+package app
+
+import (
+	"math/big"
+	"testing"
+
+	stakingprecompile "github.com/cosmos/evm/precompiles/staking"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	poakeeper "github.com/rollchains/flora/x/poa/keeper"
+	poatypes "github.com/rollchains/flora/x/poa/types"
+)
+
+// TestPOAValidatorLifecycle walks a validator through application, admin
+// approval, power changes and removal.
+func TestPOAValidatorLifecycle(t *testing.T) {
+	gapp := Setup(t)
+	ctx := gapp.BaseApp.NewContext(false)
+	msgServer := poakeeper.NewMsgServerImpl(gapp.PoaKeeper)
+
+	admin := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	operator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	valAddr := sdk.ValAddress(operator)
+
+	msg, err := poatypes.NewMsgCreateValidator(
+		valAddr.String(),
+		ed25519.GenPrivKey().PubKey(),
+		stakingtypes.NewDescription("poa", "", "", "", ""),
+		stakingtypes.NewCommissionRates(sdkmath.LegacyNewDecWithPrec(1, 1), sdkmath.LegacyNewDecWithPrec(2, 1), sdkmath.LegacyNewDecWithPrec(1, 2)),
+	)
+	require.NoError(t, err)
+
+	// applications are rejected until poa admins are set
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.ErrorIs(t, err, poatypes.ErrPOADisabled)
+
+	require.NoError(t, gapp.PoaKeeper.Params.Set(ctx, poatypes.NewParams([]string{admin.String()})))
+
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(t, err)
+
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.ErrorIs(t, err, poatypes.ErrPendingValidatorExists)
+
+	pending, err := gapp.PoaKeeper.GetPendingValidators(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+
+	// only admins can approve
+	_, err = msgServer.SetPower(ctx, &poatypes.MsgSetPower{Sender: operator.String(), ValidatorAddress: valAddr.String(), Power: 10})
+	require.ErrorIs(t, err, poatypes.ErrNotAnAdmin)
+
+	_, err = msgServer.SetPower(ctx, &poatypes.MsgSetPower{Sender: admin.String(), ValidatorAddress: valAddr.String(), Power: 10})
+	require.NoError(t, err)
+
+	powerReduction := gapp.StakingKeeper.PowerReduction(ctx)
+	validator, err := gapp.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, powerReduction.MulRaw(10), validator.GetTokens())
+
+	has, err := gapp.PoaKeeper.PendingValidators.Has(ctx, valAddr.String())
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// the staking hooks created the distribution records of the validator
+	_, err = gapp.DistrKeeper.GetValidatorCurrentRewards(ctx, valAddr)
+	require.NoError(t, err)
+
+	bondDenom, err := gapp.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+	supply := gapp.BankKeeper.GetSupply(ctx, bondDenom)
+
+	// lowering the power burns the unbonded tokens
+	_, err = msgServer.SetPower(ctx, &poatypes.MsgSetPower{Sender: admin.String(), ValidatorAddress: valAddr.String(), Power: 4})
+	require.NoError(t, err)
+
+	validator, err = gapp.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, powerReduction.MulRaw(4), validator.GetTokens())
+	require.Equal(t, supply.Amount.Sub(powerReduction.MulRaw(6)), gapp.BankKeeper.GetSupply(ctx, bondDenom).Amount)
+
+	_, err = msgServer.RemoveValidator(ctx, &poatypes.MsgRemoveValidator{Sender: admin.String(), ValidatorAddress: valAddr.String()})
+	require.NoError(t, err)
+
+	// without other delegations, the unbonded validator is deleted right away
+	_, err = gapp.StakingKeeper.GetValidator(ctx, valAddr)
+	require.ErrorIs(t, err, stakingtypes.ErrNoValidatorFound)
+	require.Equal(t, supply.Amount.Sub(powerReduction.MulRaw(10)), gapp.BankKeeper.GetSupply(ctx, bondDenom).Amount)
+
+	_, err = msgServer.SetPower(ctx, &poatypes.MsgSetPower{Sender: admin.String(), ValidatorAddress: valAddr.String(), Power: 2})
+	require.ErrorIs(t, err, stakingtypes.ErrNoValidatorFound)
+}
+
+// TestPOAStakingPrecompile checks the validator set can't be changed through
+// the staking precompile in proof-of-authority mode.
+func TestPOAStakingPrecompile(t *testing.T) {
+	gapp := Setup(t)
+	ctx := cronTestContext(t, gapp)
+	stakingABI, err := stakingprecompile.LoadABI()
+	require.NoError(t, err)
+	staking := common.HexToAddress(evmtypes.StakingPrecompileAddress)
+
+	delegator := common.BytesToAddress(secp256k1.GenPrivKey().PubKey().Address())
+	bondDenom, err := gapp.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+	coins := sdk.NewCoins(sdk.NewCoin(bondDenom, sdkmath.NewInt(1_000_000)))
+	require.NoError(t, gapp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, gapp.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, delegator.Bytes(), coins))
+
+	validators, err := gapp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	validator := validators[0].GetOperator()
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	require.NoError(t, err)
+
+	delegate := func(ctx sdk.Context) error {
+		_, err := gapp.EVMKeeper.CallEVM(ctx, stakingABI, delegator, staking, false, stakingprecompile.DelegateMethod, delegator, validator, big.NewInt(1000))
+		return err
+	}
+
+	// proof-of-stake delegations go through
+	require.NoError(t, delegate(ctx))
+
+	admin := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	require.NoError(t, gapp.PoaKeeper.Params.Set(ctx, poatypes.NewParams([]string{admin.String()})))
+
+	require.ErrorContains(t, delegate(ctx), "disabled in proof-of-authority mode")
+
+	// the queries are still served
+	_, err = gapp.EVMKeeper.CallEVM(ctx, stakingABI, delegator, staking, false, stakingprecompile.ValidatorMethod, common.BytesToAddress(valAddr))
+	require.NoError(t, err)
+}
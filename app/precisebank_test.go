@@ -0,0 +1,324 @@
+package app
+
+import (
+	"math/big"
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	precisebankkeeper "github.com/rollchains/flora/x/precisebank/keeper"
+	precisebanktypes "github.com/rollchains/flora/x/precisebank/types"
+)
+
+func TestPreciseBank(t *testing.T) {
+	gapp := Setup(t)
+	ctx := gapp.BaseApp.NewContext(false)
+
+	// the app of the tests has 18 decimals, the keeper extends a denom of 6
+	const integerDenom, extendedDenom = "ubloom", "abloom"
+	k := precisebankkeeper.NewKeeper(
+		gapp.AppCodec(),
+		runtime.NewKVStoreService(gapp.GetKey(precisebanktypes.StoreKey)),
+		gapp.BankKeeper,
+		gapp.AccountKeeper,
+		integerDenom,
+		extendedDenom,
+	)
+	cf := precisebanktypes.ConversionFactor().Int64()
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(extendedDenom, amount))
+	}
+
+	reserve := gapp.AccountKeeper.GetModuleAddress(precisebanktypes.ModuleName)
+	module := gapp.AccountKeeper.GetModuleAddress(evmtypes.ModuleName)
+	alice := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	bob := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	requireInvariants := func(ctx sdk.Context) {
+		t.Helper()
+		for _, invariant := range []sdk.Invariant{
+			precisebankkeeper.ValidFractionalBalancesInvariant(k),
+			precisebankkeeper.ValidRemainderInvariant(k),
+			precisebankkeeper.ReserveBacksFractionsInvariant(k),
+		} {
+			msg, broken := invariant(ctx)
+			require.False(t, broken, msg)
+		}
+	}
+	requireBalance := func(ctx sdk.Context, addr sdk.AccAddress, integer, fractional int64) {
+		t.Helper()
+		require.Equal(t, integer, gapp.BankKeeper.GetBalance(ctx, addr, integerDenom).Amount.Int64(), "integer balance")
+		amount, err := k.GetFractionalBalance(ctx, addr)
+		require.NoError(t, err)
+		require.Equal(t, fractional, amount.Int64(), "fractional balance")
+		require.Equal(t, sdkmath.NewInt(integer).MulRaw(cf).AddRaw(fractional), k.GetBalance(ctx, addr, extendedDenom).Amount)
+	}
+	requireReserve := func(ctx sdk.Context, integer, remainder int64) {
+		t.Helper()
+		require.Equal(t, integer, gapp.BankKeeper.GetBalance(ctx, reserve, integerDenom).Amount.Int64(), "reserve balance")
+		amount, err := k.GetRemainder(ctx)
+		require.NoError(t, err)
+		require.Equal(t, remainder, amount.Int64(), "remainder")
+		requireInvariants(ctx)
+	}
+
+	// every boundary of the mint and burn of the fractional amounts, from an
+	// empty reserve
+	var supply int64
+	mint := func(amount int64) func(ctx sdk.Context) error {
+		return func(ctx sdk.Context) error {
+			supply += amount
+			return k.MintCoins(ctx, evmtypes.ModuleName, coins(amount))
+		}
+	}
+	burn := func(amount int64) func(ctx sdk.Context) error {
+		return func(ctx sdk.Context) error {
+			supply -= amount
+			return k.BurnCoins(ctx, evmtypes.ModuleName, coins(amount))
+		}
+	}
+	send := func(from, to sdk.AccAddress, amount int64) func(ctx sdk.Context) error {
+		return func(ctx sdk.Context) error {
+			return k.SendCoins(ctx, from, to, coins(amount))
+		}
+	}
+	steps := []struct {
+		name    string
+		op      func(ctx sdk.Context) error
+		module  [2]int64
+		alice   [2]int64
+		bob     [2]int64
+		reserve [2]int64
+	}{
+		{"mint from the remainder minted to the reserve", mint(1), [2]int64{0, 1}, [2]int64{}, [2]int64{}, [2]int64{1, cf - 1}},
+		{"mint carried from the reserve", mint(cf - 1), [2]int64{1, 0}, [2]int64{}, [2]int64{}, [2]int64{0, 0}},
+		{"mint half a unit", mint(cf / 2), [2]int64{1, cf / 2}, [2]int64{}, [2]int64{}, [2]int64{1, cf / 2}},
+		{"mint carried from the unit minted to the reserve", mint(cf/2 + 1), [2]int64{2, 1}, [2]int64{}, [2]int64{}, [2]int64{1, cf - 1}},
+		{"mint integer units", mint(3 * cf), [2]int64{5, 1}, [2]int64{}, [2]int64{}, [2]int64{1, cf - 1}},
+		{"burn borrowed from the unit burned from the reserve", burn(2), [2]int64{4, cf - 1}, [2]int64{}, [2]int64{}, [2]int64{1, 1}},
+		{"burn overflowing the remainder", burn(cf - 1), [2]int64{4, 0}, [2]int64{}, [2]int64{}, [2]int64{0, 0}},
+		{"burn borrowed to the reserve", burn(1), [2]int64{3, cf - 1}, [2]int64{}, [2]int64{}, [2]int64{1, 1}},
+		{"send integer units", send(module, alice, cf), [2]int64{2, cf - 1}, [2]int64{1, 0}, [2]int64{}, [2]int64{1, 1}},
+		{"send borrowed to the reserve", send(alice, bob, 1), [2]int64{2, cf - 1}, [2]int64{0, cf - 1}, [2]int64{0, 1}, [2]int64{2, 1}},
+		{"send carried from the reserve", send(alice, bob, cf-1), [2]int64{2, cf - 1}, [2]int64{0, 0}, [2]int64{1, 0}, [2]int64{1, 1}},
+		{"send borrowed again", send(bob, alice, 1), [2]int64{2, cf - 1}, [2]int64{0, 1}, [2]int64{0, cf - 1}, [2]int64{2, 1}},
+		{"send carried again", send(module, alice, cf-1), [2]int64{2, 0}, [2]int64{1, 0}, [2]int64{0, cf - 1}, [2]int64{1, 1}},
+		{"send borrowed from the sender carried by the recipient", send(module, bob, 1), [2]int64{1, cf - 1}, [2]int64{1, 0}, [2]int64{1, 0}, [2]int64{1, 1}},
+		{"send to itself", send(alice, alice, cf), [2]int64{1, cf - 1}, [2]int64{1, 0}, [2]int64{1, 0}, [2]int64{1, 1}},
+	}
+	for _, step := range steps {
+		require.NoError(t, step.op(ctx), step.name)
+		requireBalance(ctx, module, step.module[0], step.module[1])
+		requireBalance(ctx, alice, step.alice[0], step.alice[1])
+		requireBalance(ctx, bob, step.bob[0], step.bob[1])
+		requireReserve(ctx, step.reserve[0], step.reserve[1])
+		require.Equal(t, supply, k.GetSupply(ctx, extendedDenom).Amount.Int64(), step.name)
+	}
+
+	t.Run("insufficient funds", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		require.ErrorIs(t, k.SendCoins(ctx, alice, bob, coins(cf+1)), sdkerrors.ErrInsufficientFunds)
+		require.ErrorIs(t, k.SendCoins(ctx, alice, alice, coins(cf+1)), sdkerrors.ErrInsufficientFunds)
+		require.ErrorIs(t, k.BurnCoins(ctx, evmtypes.ModuleName, coins(2*cf)), sdkerrors.ErrInsufficientFunds)
+		requireBalance(ctx, alice, 1, 0)
+		requireReserve(ctx, 1, 1)
+	})
+
+	t.Run("module accounts", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		require.ErrorIs(t, k.MintCoins(ctx, authtypes.FeeCollectorName, coins(1)), sdkerrors.ErrUnauthorized)
+		require.ErrorIs(t, k.BurnCoins(ctx, authtypes.FeeCollectorName, coins(1)), sdkerrors.ErrUnauthorized)
+		require.ErrorIs(t, k.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, reserve, coins(1)), sdkerrors.ErrUnauthorized)
+
+		// fees and refunds in the extended denom
+		require.NoError(t, k.SendCoinsFromAccountToModule(ctx, bob, authtypes.FeeCollectorName, coins(1)))
+		require.NoError(t, k.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, evmtypes.ModuleName, coins(1)))
+		require.NoError(t, k.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, bob, coins(1)))
+		requireBalance(ctx, bob, 1, 0)
+		requireReserve(ctx, 1, 1)
+	})
+
+	t.Run("passthrough", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		other := sdk.NewCoins(sdk.NewInt64Coin("other", 5))
+		require.NoError(t, k.MintCoins(ctx, evmtypes.ModuleName, other.Add(coins(cf)...)))
+		require.NoError(t, k.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, alice, other))
+		require.Equal(t, other, gapp.BankKeeper.GetAllBalances(ctx, alice).Sub(sdk.NewInt64Coin(integerDenom, 1)))
+		require.NoError(t, k.IsSendEnabledCoins(ctx, coins(1)...))
+
+		// every denom is passed through when no extended denom is set
+		passthrough := precisebankkeeper.NewKeeper(
+			gapp.AppCodec(),
+			runtime.NewKVStoreService(gapp.GetKey(precisebanktypes.StoreKey)),
+			gapp.BankKeeper,
+			gapp.AccountKeeper,
+			"",
+			"",
+		)
+		require.True(t, passthrough.GetBalance(ctx, alice, extendedDenom).IsZero())
+		require.ErrorIs(t, passthrough.SendCoins(ctx, alice, bob, coins(1)), sdkerrors.ErrInsufficientFunds)
+		_, err := precisebankkeeper.NewQuerier(passthrough).Remainder(ctx, &precisebanktypes.QueryRemainderRequest{})
+		require.ErrorIs(t, err, precisebanktypes.ErrNotConfigured)
+	})
+
+	t.Run("genesis", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		gs, err := precisebankkeeper.ExportGenesis(ctx, k)
+		require.NoError(t, err)
+		require.NoError(t, gs.Validate())
+		require.Len(t, gs.Balances, 1)
+		require.Equal(t, int64(1), gs.Remainder.Int64())
+		require.NoError(t, precisebankkeeper.InitGenesis(ctx, k, gs))
+
+		res, err := precisebankkeeper.NewQuerier(k).FractionalBalance(ctx, &precisebanktypes.QueryFractionalBalanceRequest{Address: module.String()})
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewInt64Coin(extendedDenom, cf-1), res.FractionalBalance)
+
+		// the fractional balances and the remainder are whole units
+		invalid := precisebanktypes.NewGenesisState(gs.Balances, sdkmath.NewInt(2))
+		require.ErrorIs(t, invalid.Validate(), precisebanktypes.ErrReserveMismatch)
+		invalid = precisebanktypes.NewGenesisState(gs.Balances, sdkmath.NewInt(cf))
+		require.ErrorIs(t, invalid.Validate(), precisebanktypes.ErrInvalidRemainder)
+
+		// which must be backed by the reserve
+		unbacked := precisebanktypes.NewGenesisState(
+			append(gs.Balances, precisebanktypes.NewFractionalBalance(alice.String(), sdkmath.NewInt(cf))),
+			gs.Remainder,
+		)
+		require.ErrorIs(t, unbacked.Validate(), precisebanktypes.ErrInvalidFractionalBalance)
+		unbacked.Balances[1].Amount = sdkmath.NewInt(cf / 2)
+		unbacked.Remainder = sdkmath.NewInt(cf/2 + 1)
+		require.NoError(t, unbacked.Validate())
+		require.ErrorIs(t, precisebankkeeper.InitGenesis(ctx, k, unbacked), precisebanktypes.ErrReserveMismatch)
+	})
+}
+
+// preciseBankChainEnv is set in the process running TestPreciseBankEVM, as the
+// EVM configuration of a chain of 6 decimals is global.
+const preciseBankChainEnv = "FLORA_TEST_PRECISEBANK_CHAIN"
+
+func TestPreciseBankEVM(t *testing.T) {
+	if os.Getenv(preciseBankChainEnv) == "" {
+		cmd := exec.Command(os.Args[0], "-test.run=^TestPreciseBankEVM$", "-test.v")
+		cmd.Env = append(os.Environ(), preciseBankChainEnv+"=1")
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+		return
+	}
+
+	info := ChainInfo{ChainID: "bloom_7668-1", EVMChainID: 7668, Denom: "ubloom", DisplayDenom: "bloom", Decimals: 6}
+	require.NoError(t, configureEVM(info))
+	require.Equal(t, "abloom", evmtypes.GetEVMCoinDenom())
+	gapp := Setup(t)
+	_, err := gapp.Commit()
+	require.NoError(t, err)
+
+	cf := precisebanktypes.ConversionFactor()
+	require.Equal(t, sdkmath.NewIntWithDecimal(1, 12), cf)
+	k := gapp.PreciseBankKeeper
+	requireInvariants := func(ctx sdk.Context) {
+		t.Helper()
+		for _, invariant := range []sdk.Invariant{
+			precisebankkeeper.ValidFractionalBalancesInvariant(k),
+			precisebankkeeper.ValidRemainderInvariant(k),
+			precisebankkeeper.ReserveBacksFractionsInvariant(k),
+		} {
+			msg, broken := invariant(ctx)
+			require.False(t, broken, msg)
+		}
+	}
+	// the 18 decimals balance is the integer balance of x/bank and the
+	// fractional balance of x/precisebank
+	requireBalance := func(ctx sdk.Context, addr common.Address, balance sdkmath.Int) {
+		t.Helper()
+		require.Equal(t, balance, sdkmath.NewIntFromBigInt(gapp.EVMKeeper.GetBalance(ctx, addr)))
+		require.Equal(t, balance.Quo(cf), gapp.BankKeeper.GetBalance(ctx, addr.Bytes(), info.Denom).Amount)
+		fractional, err := k.GetFractionalBalance(ctx, addr.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, balance.Mod(cf), fractional)
+	}
+
+	ctx := gapp.NewUncachedContext(false, cmtproto.Header{Height: gapp.LastBlockHeight()})
+	senderKey, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	sender := common.BytesToAddress(senderKey.PubKey().Address())
+	recipient := common.BytesToAddress(secp256k1.GenPrivKey().PubKey().Address())
+	initAccountWithCoins(gapp, ctx, sender.Bytes(), sdk.NewCoins(sdk.NewInt64Coin(info.Denom, 1_000_000)))
+	initial := sdkmath.NewInt(1_000_000).Mul(cf)
+	requireBalance(ctx, sender, initial)
+	requireInvariants(ctx)
+
+	// a transfer of a fraction of a unit, paying fees of fractions of units
+	// and refunded the unused gas
+	gasPrice := new(big.Int).Add(gapp.EVMKeeper.GetBaseFee(ctx), big.NewInt(1))
+	const gasLimit = 100_000
+	msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:  evmtypes.GetEthChainConfig().ChainID,
+		Nonce:    0,
+		To:       &recipient,
+		Amount:   big.NewInt(1),
+		GasLimit: gasLimit,
+		GasPrice: gasPrice,
+	})
+	msg.From = sender.Hex()
+	require.NoError(t, msg.Sign(ethtypes.LatestSignerForChainID(evmtypes.GetEthChainConfig().ChainID), utiltx.NewSigner(senderKey)))
+	tx, err := msg.BuildTx(gapp.TxConfig().NewTxBuilder(), evmtypes.GetEVMCoinDenom())
+	require.NoError(t, err)
+	bz, err := gapp.TxConfig().TxEncoder()(tx)
+	require.NoError(t, err)
+
+	validators, err := gapp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	proposer, err := validators[0].GetConsAddr()
+	require.NoError(t, err)
+	res, err := gapp.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height:          gapp.LastBlockHeight() + 1,
+		Time:            time.Now().UTC(),
+		ProposerAddress: proposer,
+		Txs:             [][]byte{bz},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.TxResults, 1)
+	require.Zero(t, res.TxResults[0].Code, res.TxResults[0].Log)
+	_, err = gapp.Commit()
+	require.NoError(t, err)
+
+	ctx = gapp.NewUncachedContext(false, cmtproto.Header{Height: gapp.LastBlockHeight()})
+	gasUsed := res.TxResults[0].GasUsed
+	require.Less(t, gasUsed, int64(gasLimit), "the unused gas is refunded")
+	fee := sdkmath.NewIntFromBigInt(gasPrice).MulRaw(gasUsed)
+	require.False(t, fee.Mod(cf).IsZero())
+	requireBalance(ctx, sender, initial.Sub(fee).SubRaw(1))
+	requireBalance(ctx, recipient, sdkmath.OneInt())
+	requireInvariants(ctx)
+
+	// the reserve backs the fractional balances and the remainder
+	remainder, err := k.GetRemainder(ctx)
+	require.NoError(t, err)
+	fractionalSum := remainder
+	require.NoError(t, k.FractionalBalances.Walk(ctx, nil, func(_ sdk.AccAddress, amount sdkmath.Int) (bool, error) {
+		fractionalSum = fractionalSum.Add(amount)
+		return false, nil
+	}))
+	reserve := gapp.AccountKeeper.GetModuleAddress(precisebanktypes.ModuleName)
+	require.Equal(t, gapp.BankKeeper.GetBalance(ctx, reserve, info.Denom).Amount.Mul(cf), fractionalSum)
+	require.Equal(t, k.GetSupply(ctx, info.EVMDenom()).Amount, gapp.BankKeeper.GetSupply(ctx, info.Denom).Amount.Mul(cf).Sub(remainder))
+}
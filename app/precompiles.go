@@ -3,8 +3,10 @@ package app
 import (
 	"fmt"
 	"maps"
+	"slices"
 
 	evidencekeeper "cosmossdk.io/x/evidence/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
@@ -24,12 +26,31 @@ import (
 	transferkeeper "github.com/cosmos/evm/x/ibc/transfer/keeper"
 	"github.com/cosmos/evm/x/vm/core/vm"
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 	channelkeeper "github.com/cosmos/ibc-go/v8/modules/core/04-channel/keeper"
 	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rollchains/flora/app/decorators"
+	cronprecompile "github.com/rollchains/flora/precompiles/cron"
+	oracleprecompile "github.com/rollchains/flora/precompiles/oracle"
+	cronkeeper "github.com/rollchains/flora/x/cron/keeper"
+	denylistkeeper "github.com/rollchains/flora/x/denylist/keeper"
+	denylisttypes "github.com/rollchains/flora/x/denylist/types"
+	evmcircuitkeeper "github.com/rollchains/flora/x/evmcircuit/keeper"
+	oraclekeeper "github.com/rollchains/flora/x/oracle/keeper"
 )
 
 const bech32PrecompileBaseGas = 6_000
 
+// AvailableStaticPrecompiles are the addresses of the static precompiles of
+// cosmos/evm and of the chain specific precompiles, sorted as required by the
+// EVM params.
+var AvailableStaticPrecompiles = func() []string {
+	precompiles := append(slices.Clone(evmtypes.AvailableStaticPrecompiles), cronprecompile.PrecompileAddress)
+	slices.Sort(precompiles)
+	return precompiles
+}()
+
 // NewAvailableStaticPrecompiles returns the list of all available static precompiled contracts from EVM.
 //
 // NOTE: this should only be used during initialization of the Keeper.
@@ -45,6 +66,9 @@ func NewAvailableStaticPrecompiles(
 	govKeeper govkeeper.Keeper,
 	slashingKeeper slashingkeeper.Keeper,
 	evidenceKeeper evidencekeeper.Keeper,
+	cronKeeper cronkeeper.Keeper,
+	evmCircuitKeeper evmcircuitkeeper.Keeper,
+	poaKeeper decorators.POAKeeper,
 ) map[common.Address]vm.PrecompiledContract {
 	// Clone the mapping from the latest EVM fork.
 	precompiles := maps.Clone(vm.PrecompiledContractsBerlin)
@@ -103,18 +127,71 @@ func NewAvailableStaticPrecompiles(
 		panic(fmt.Errorf("failed to instantiate evidence precompile: %w", err))
 	}
 
+	cronPrecompile, err := cronprecompile.NewPrecompile(cronKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate cron precompile: %w", err))
+	}
+
 	// Stateless precompiles
 	precompiles[bech32Precompile.Address()] = bech32Precompile
 	precompiles[p256Precompile.Address()] = p256Precompile
 
 	// Stateful precompiles
-	precompiles[stakingPrecompile.Address()] = stakingPrecompile
+	precompiles[stakingPrecompile.Address()] = decorators.GuardStakingPrecompile(stakingPrecompile, poaKeeper)
 	precompiles[distributionPrecompile.Address()] = distributionPrecompile
 	precompiles[ibcTransferPrecompile.Address()] = ibcTransferPrecompile
 	precompiles[bankPrecompile.Address()] = bankPrecompile
 	precompiles[govPrecompile.Address()] = govPrecompile
 	precompiles[slashingPrecompile.Address()] = slashingPrecompile
 	precompiles[evidencePrecompile.Address()] = evidencePrecompile
+	precompiles[cronPrecompile.Address()] = cronPrecompile
+
+	// Enforce the EVM circuit breakers on every call, including the internal
+	// calls from other contracts.
+	for address, precompile := range precompiles {
+		precompiles[address] = evmCircuitKeeper.GuardPrecompile(precompile)
+	}
 
 	return precompiles
 }
+
+var _ evmtypes.Erc20Keeper = dynamicPrecompiles{}
+
+// dynamicPrecompiles instantiates the precompiles living at addresses defined
+// in state: the price feeds of the oracle pairs and the ERC-20 token pairs.
+// The keepers are pointers as they are set after the EVM keeper.
+//
+// It is called for every call made by the EVM, so it also rejects the calls and
+// value transfers to denied addresses. The calls made by denied contracts are
+// rejected by the call access control of the EVM params, see x/denylist.
+type dynamicPrecompiles struct {
+	erc20Keeper    *erc20Keeper.Keeper
+	oracleKeeper   *oraclekeeper.Keeper
+	denylistKeeper *denylistkeeper.Keeper
+}
+
+// GetERC20PrecompileInstance implements evmtypes.Erc20Keeper, the oracle price
+// feeds are looked up before the ERC-20 token pairs.
+func (d dynamicPrecompiles) GetERC20PrecompileInstance(
+	ctx sdk.Context,
+	address common.Address,
+) (vm.PrecompiledContract, bool, error) {
+	if err := d.denylistKeeper.CheckAddress(ctx, denylisttypes.PathEVM, address.Bytes()); err != nil {
+		return nil, false, err
+	}
+
+	params, err := d.oracleKeeper.GetParams(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if key, found := params.FeedKey(address); found {
+		precompile, err := oracleprecompile.NewPrecompile(*d.oracleKeeper, key)
+		if err != nil {
+			return nil, false, err
+		}
+		return precompile, true, nil
+	}
+
+	return d.erc20Keeper.GetERC20PrecompileInstance(ctx, address)
+}
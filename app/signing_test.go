@@ -0,0 +1,66 @@
+package app
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// signedTestContext returns the context past genesis with a block gas limit,
+// for the signed txs to go through the ante handler.
+func signedTestContext(ctx sdk.Context) sdk.Context {
+	return ctx.WithBlockHeight(1).
+		WithConsensusParams(cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxGas: 10_000_000}})
+}
+
+// signTestTx returns a tx sending one token from the account of the public
+// key, signed in direct mode by the sign function.
+func signTestTx(t *testing.T, gapp *ChainApp, ctx sdk.Context, pubKey cryptotypes.PubKey, sequence uint64, sign func(signBytes []byte) []byte) sdk.Tx {
+	t.Helper()
+
+	txConfig := gapp.TxConfig()
+	denom := evmtypes.GetEVMCoinDenom()
+	addr := sdk.AccAddress(pubKey.Address())
+	acc := gapp.AccountKeeper.GetAccount(ctx, addr)
+
+	builder := txConfig.NewTxBuilder()
+	to := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(addr, to, sdk.NewCoins(sdk.NewInt64Coin(denom, 1)))))
+	builder.SetGasLimit(400_000)
+	builder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntWithDecimal(1, 15))))
+
+	sig := signing.SignatureV2{
+		PubKey:   pubKey,
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+		Sequence: sequence,
+	}
+	require.NoError(t, builder.SetSignatures(sig))
+
+	signerData := authsigning.SignerData{
+		Address:       addr.String(),
+		ChainID:       ctx.ChainID(),
+		AccountNumber: acc.GetAccountNumber(),
+		Sequence:      sequence,
+		PubKey:        pubKey,
+	}
+	signBytes, err := authsigning.GetSignBytesAdapter(ctx, txConfig.SignModeHandler(), signing.SignMode_SIGN_MODE_DIRECT, signerData, builder.GetTx())
+	require.NoError(t, err)
+
+	sig.Data = &signing.SingleSignatureData{
+		SignMode:  signing.SignMode_SIGN_MODE_DIRECT,
+		Signature: sign(signBytes),
+	}
+	require.NoError(t, builder.SetSignatures(sig))
+	return builder.GetTx()
+}
@@ -0,0 +1,154 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	solomachine "github.com/cosmos/ibc-go/v8/modules/light-clients/06-solomachine"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	ibctestingtypes "github.com/cosmos/ibc-go/v8/testing/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+)
+
+// channelIDOnSolomachine is the channel identifier the ibctesting solo machine
+// uses for its own end of the channel.
+const channelIDOnSolomachine = "channel-on-solomachine"
+
+// ibcTestingApp adapts ChainApp to the ibctesting.TestingApp interface.
+type ibcTestingApp struct {
+	*ChainApp
+}
+
+func (app ibcTestingApp) GetStakingKeeper() ibctestingtypes.StakingKeeper {
+	return app.StakingKeeper
+}
+
+func (app ibcTestingApp) GetTxConfig() client.TxConfig {
+	return app.TxConfig()
+}
+
+// InitChain adds the bank metadata of the chain denom back to the genesis, as
+// ibctesting replaces the bank genesis with one without metadata.
+func (app ibcTestingApp) InitChain(req *abci.RequestInitChain) (*abci.ResponseInitChain, error) {
+	var genesis map[string]json.RawMessage
+	if err := json.Unmarshal(req.AppStateBytes, &genesis); err != nil {
+		return nil, err
+	}
+	var bankGenState, defaultBankGenState banktypes.GenesisState
+	app.AppCodec().MustUnmarshalJSON(genesis[banktypes.ModuleName], &bankGenState)
+	app.AppCodec().MustUnmarshalJSON(app.DefaultGenesis()[banktypes.ModuleName], &defaultBankGenState)
+	bankGenState.DenomMetadata = defaultBankGenState.DenomMetadata
+	genesis[banktypes.ModuleName] = app.AppCodec().MustMarshalJSON(&bankGenState)
+
+	appState, err := json.Marshal(genesis)
+	if err != nil {
+		return nil, err
+	}
+	req.AppStateBytes = appState
+	return app.ChainApp.InitChain(req)
+}
+
+// setupIBCTestingApp is used as the ibctesting.DefaultTestingAppInit. The base
+// fee is disabled as the ibctesting chains send their txs without fees.
+func setupIBCTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	app := NewChainApp(
+		log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{},
+		EVMAppOptions,
+	)
+	genesis := app.DefaultGenesis()
+
+	feemarketGenState := feemarkettypes.DefaultGenesisState()
+	feemarketGenState.Params.NoBaseFee = true
+	genesis[feemarkettypes.ModuleName] = app.AppCodec().MustMarshalJSON(feemarketGenState)
+
+	return ibcTestingApp{app}, genesis
+}
+
+// useEthSender replaces the secp256k1 sender of the ibctesting chain with an
+// eth_secp256k1 account, as those are the only user keys accepted by the ante handler.
+func useEthSender(t *testing.T, chain *ibctesting.TestChain) {
+	t.Helper()
+
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+
+	app := chain.App.(ibcTestingApp)
+	ctx := chain.GetContext()
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, sdk.AccAddress(priv.PubKey().Address()))
+	app.AccountKeeper.SetAccount(ctx, acc)
+	chain.NextBlock()
+
+	chain.SenderPrivKey = priv
+	chain.SenderAccount = acc
+}
+
+func TestSolomachineRegistered(t *testing.T) {
+	gapp := NewChainApp(
+		log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()),
+		EVMAppOptions,
+	)
+
+	_, ok := gapp.ModuleManager.Modules[solomachine.ModuleName]
+	require.True(t, ok, "06-solomachine light client must be registered in the module manager")
+
+	_, err := gapp.InterfaceRegistry().Resolve(sdk.MsgTypeURL(&solomachine.ClientState{}))
+	require.NoError(t, err)
+}
+
+// TestSolomachineTransfer opens a connection and a transfer channel between a
+// local solo machine signer and the chain, then relays an ICS20 packet from the
+// solo machine and checks the voucher was minted to the receiver.
+func TestSolomachineTransfer(t *testing.T) {
+	defaultInit := ibctesting.DefaultTestingAppInit
+	ibctesting.DefaultTestingAppInit = setupIBCTestingApp
+	t.Cleanup(func() { ibctesting.DefaultTestingAppInit = defaultInit })
+
+	coord := ibctesting.NewCoordinator(t, 1)
+	chain := coord.GetChain(ibctesting.GetChainID(1))
+	useEthSender(t, chain)
+
+	solo := ibctesting.NewSolomachine(t, chain.Codec, "solomachine", "testing", 1)
+
+	clientID := solo.CreateClient(chain)
+	connectionID := solo.ConnOpenInit(chain, clientID)
+	solo.ConnOpenAck(chain, clientID, connectionID)
+	channelID := solo.ChanOpenInit(chain, connectionID)
+	solo.ChanOpenAck(chain, channelID)
+
+	receiver := chain.SenderAccount.GetAddress()
+	packetData := transfertypes.NewFungibleTokenPacketData("usolo", "1000", "solo-sender", receiver.String(), "")
+	packet := channeltypes.NewPacket(
+		packetData.GetBytes(),
+		1,
+		transfertypes.PortID,
+		channelIDOnSolomachine,
+		transfertypes.PortID,
+		channelID,
+		clienttypes.ZeroHeight(),
+		uint64(chain.GetContext().BlockTime().Add(time.Hour).UnixNano()),
+	)
+	solo.RecvPacket(chain, packet)
+
+	voucherDenom := transfertypes.ParseDenomTrace(
+		transfertypes.GetPrefixedDenom(transfertypes.PortID, channelID, "usolo"),
+	).IBCDenom()
+
+	app := chain.App.(ibcTestingApp)
+	balance := app.BankKeeper.GetBalance(chain.GetContext(), receiver, voucherDenom)
+	require.Equal(t, "1000", balance.Amount.String())
+}
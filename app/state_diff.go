@@ -0,0 +1,218 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	dbm "github.com/cosmos/cosmos-db"
+	iavlproto "github.com/cosmos/iavl/proto"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// StoreDiff counts the keys of a module store that differ between two
+// versions of the state.
+type StoreDiff struct {
+	Store   string
+	Added   int
+	Changed int
+	Deleted int
+}
+
+// Empty returns true if the store is the same in both versions.
+func (d StoreDiff) Empty() bool {
+	return d.Added == 0 && d.Changed == 0 && d.Deleted == 0
+}
+
+// KVDiff is a key of a module store that differs between two versions of the
+// state. From is nil when the key is added and To is nil when it is deleted.
+type KVDiff struct {
+	Key  []byte
+	From []byte
+	To   []byte
+}
+
+// Added returns true if the key is missing from the first version.
+func (d KVDiff) Added() bool {
+	return d.From == nil
+}
+
+// Deleted returns true if the key is missing from the second version.
+func (d KVDiff) Deleted() bool {
+	return d.To == nil
+}
+
+// DiffStores compares the module stores at two committed heights and returns
+// the stores that differ. The stores missing at a height, like the stores added
+// by an upgrade, are compared as empty.
+func (app *ChainApp) DiffStores(from, to int64) ([]StoreDiff, error) {
+	var diffs []StoreDiff
+	err := app.WalkStoreDiffs(from, to, nil, func(store string, diff KVDiff) error {
+		if len(diffs) == 0 || diffs[len(diffs)-1].Store != store {
+			diffs = append(diffs, StoreDiff{Store: store})
+		}
+
+		storeDiff := &diffs[len(diffs)-1]
+		switch {
+		case diff.Added():
+			storeDiff.Added++
+		case diff.Deleted():
+			storeDiff.Deleted++
+		default:
+			storeDiff.Changed++
+		}
+		return nil
+	})
+	return diffs, err
+}
+
+// WalkStoreDiffs calls fn with the keys that differ between two committed
+// heights of the state of the app, see WalkMultiStoreDiffs.
+func (app *ChainApp) WalkStoreDiffs(from, to int64, stores []string, fn func(store string, diff KVDiff) error) error {
+	rs, ok := app.CommitMultiStore().(*rootmulti.Store)
+	if !ok {
+		return fmt.Errorf("unexpected commit multi store %T", app.CommitMultiStore())
+	}
+	return WalkMultiStoreDiffs(rs, app.GetStoreKeys(), from, to, stores, fn)
+}
+
+// LoadMultiStore loads the module stores of the application database at a
+// committed height, without an app. The stores are the IAVL stores of the
+// commit info of the height. Loading the height writes nothing to the
+// database, so it can be opened read-only.
+func LoadMultiStore(db dbm.DB, height int64) (*rootmulti.Store, []storetypes.StoreKey, error) {
+	rs := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	// the fast nodes are upgraded on load otherwise
+	rs.SetIAVLDisableFastNode(true)
+
+	commitInfo, err := rs.GetCommitInfo(height)
+	if err != nil {
+		return nil, nil, fmt.Errorf("height %d: %w", height, err)
+	}
+
+	keys := make([]storetypes.StoreKey, 0, len(commitInfo.StoreInfos))
+	for _, info := range commitInfo.StoreInfos {
+		key := storetypes.NewKVStoreKey(info.Name)
+		rs.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b storetypes.StoreKey) int { return strings.Compare(a.Name(), b.Name()) })
+
+	if err := rs.LoadVersion(height); err != nil {
+		return nil, nil, fmt.Errorf("height %d: %w", height, err)
+	}
+	return rs, keys, nil
+}
+
+// WalkMultiStoreDiffs calls fn with the keys that differ between two committed
+// heights, store by store and in key order. Only the named stores of keys are
+// compared, all of them when stores is empty. The keys are read from the
+// changesets of the IAVL trees between the heights, the stores aren't walked
+// in full.
+func WalkMultiStoreDiffs(rs *rootmulti.Store, keys []storetypes.StoreKey, from, to int64, stores []string, fn func(store string, diff KVDiff) error) error {
+	for _, name := range stores {
+		if !slices.ContainsFunc(keys, func(key storetypes.StoreKey) bool { return key.Name() == name }) {
+			return fmt.Errorf("unknown store %s", name)
+		}
+	}
+
+	for _, key := range keys {
+		if len(stores) > 0 && !slices.Contains(stores, key.Name()) {
+			continue
+		}
+
+		if err := diffStore(rs, key, from, to, func(diff KVDiff) error { return fn(key.Name(), diff) }); err != nil {
+			return fmt.Errorf("store %s: %w", key.Name(), err)
+		}
+	}
+	return nil
+}
+
+// diffStore calls fn with the keys of a store that differ between two
+// versions, in key order. The last change of each key in the changesets of the
+// versions after from is compared with its value at from, so the keys set back
+// to their value aren't reported.
+func diffStore(rs *rootmulti.Store, key storetypes.StoreKey, from, to int64, fn func(KVDiff) error) error {
+	store, ok := rs.GetCommitKVStore(key).(*iavl.Store)
+	if !ok {
+		return fmt.Errorf("store %s is not an IAVL store", key.Name())
+	}
+
+	fromStore, err := storeAtVersion(rs, store, key, from)
+	if err != nil {
+		return err
+	}
+	toStore, err := storeAtVersion(rs, store, key, to)
+	if err != nil || toStore == nil {
+		return err
+	}
+
+	changes := make(map[string][]byte)
+	err = store.TraverseStateChanges(from+1, to, func(_ int64, changeSet *iavlproto.ChangeSet) error {
+		for _, pair := range changeSet.Pairs {
+			if pair.Delete {
+				changes[string(pair.Key)] = nil
+			} else {
+				changes[string(pair.Key)] = nonNil(pair.Value)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	changedKeys := slices.Sorted(maps.Keys(changes))
+	for _, changedKey := range changedKeys {
+		diff := KVDiff{Key: []byte(changedKey), To: changes[changedKey]}
+		if fromStore != nil {
+			if v := fromStore.Get(diff.Key); v != nil {
+				diff.From = nonNil(v)
+			}
+		}
+		if bytes.Equal(diff.From, diff.To) && (diff.From == nil) == (diff.To == nil) {
+			continue
+		}
+
+		if err := fn(diff); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// storeAtVersion returns the store of the key at a committed version, nil if
+// the store didn't exist at that version.
+func storeAtVersion(rs *rootmulti.Store, store *iavl.Store, key storetypes.StoreKey, version int64) (storetypes.KVStore, error) {
+	immutable, err := store.GetImmutable(version)
+	if err == nil {
+		return immutable, nil
+	}
+
+	commitInfo, infoErr := rs.GetCommitInfo(version)
+	if infoErr != nil {
+		return nil, fmt.Errorf("height %d: %w", version, infoErr)
+	}
+	for _, info := range commitInfo.StoreInfos {
+		if info.Name == key.Name() {
+			return nil, fmt.Errorf("store %s at height %d: %w", key.Name(), version, err)
+		}
+	}
+	return nil, nil
+}
+
+// nonNil returns the value of a key, an empty value is not nil so it isn't
+// taken for a missing key.
+func nonNil(value []byte) []byte {
+	if value != nil {
+		return value
+	}
+	return []byte{}
+}
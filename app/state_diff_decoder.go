@@ -0,0 +1,172 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+var (
+	balanceKeyCodec    = collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey)
+	denomIndexKeyCodec = collections.PairKeyCodec(collections.StringKey, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)) //nolint:staticcheck // the key codec of the bank denom index
+)
+
+// DecodeStoreDiff describes a key of a module store that differs between two
+// versions of the state. The EVM storage slots are decoded per contract and the
+// bank balances per denom, the keys of the other stores by the store decoders
+// of the simulation manager. The keys nothing decodes are printed in hex.
+func (app *ChainApp) DecodeStoreDiff(store string, diff KVDiff) string {
+	var (
+		decoded string
+		ok      bool
+	)
+	switch store {
+	case evmtypes.StoreKey:
+		decoded, ok = decodeEVMDiff(diff)
+	case banktypes.StoreKey:
+		decoded, ok = decodeBankDiff(diff)
+	}
+	if !ok {
+		if decoder, found := app.sm.StoreDecoders[store]; found {
+			decoded, ok = decodeWith(decoder, diff)
+		}
+	}
+	if !ok {
+		decoded = fmt.Sprintf("%X: %s", diff.Key, formatDiff(diff, func(bz []byte) string { return fmt.Sprintf("%X", bz) }))
+	}
+
+	switch {
+	case diff.Added():
+		return "+ " + decoded
+	case diff.Deleted():
+		return "- " + decoded
+	default:
+		return "~ " + decoded
+	}
+}
+
+// decodeEVMDiff decodes the storage slots, the code hashes of the accounts and
+// the codes of the EVM store.
+func decodeEVMDiff(diff KVDiff) (string, bool) {
+	key := diff.Key
+	switch {
+	case bytes.HasPrefix(key, evmtypes.KeyPrefixStorage) && len(key) == 1+common.AddressLength+common.HashLength:
+		contract := common.BytesToAddress(key[1 : 1+common.AddressLength])
+		slot := common.BytesToHash(key[1+common.AddressLength:])
+		return fmt.Sprintf("storage %s slot %s: %s", contract.Hex(), slot.Hex(), formatDiff(diff, func(bz []byte) string { return common.BytesToHash(bz).Hex() })), true
+	case bytes.HasPrefix(key, evmtypes.KeyPrefixCodeHash) && len(key) == 1+common.AddressLength:
+		account := common.BytesToAddress(key[1:])
+		return fmt.Sprintf("code hash %s: %s", account.Hex(), formatDiff(diff, func(bz []byte) string { return common.BytesToHash(bz).Hex() })), true
+	case bytes.HasPrefix(key, evmtypes.KeyPrefixCode) && len(key) == 1+common.HashLength:
+		codeHash := common.BytesToHash(key[1:])
+		return fmt.Sprintf("code %s: %s", codeHash.Hex(), formatDiff(diff, func(bz []byte) string { return fmt.Sprintf("%d bytes", len(bz)) })), true
+	}
+	return "", false
+}
+
+// decodeBankDiff decodes the balances of the accounts per denom, their denom
+// index and the supply per denom of the bank store.
+func decodeBankDiff(diff KVDiff) (string, bool) {
+	key := diff.Key
+	switch {
+	case bytes.HasPrefix(key, banktypes.BalancesPrefix):
+		_, balance, err := balanceKeyCodec.Decode(key[len(banktypes.BalancesPrefix):])
+		if err != nil {
+			return "", false
+		}
+		amounts, ok := formatAmounts(diff, banktypes.BalanceValueCodec.Decode)
+		if !ok {
+			return "", false
+		}
+		return fmt.Sprintf("balance %s %s: %s", balance.K1(), balance.K2(), amounts), true
+	case bytes.HasPrefix(key, banktypes.DenomAddressPrefix):
+		_, index, err := denomIndexKeyCodec.Decode(key[len(banktypes.DenomAddressPrefix):])
+		if err != nil {
+			return "", false
+		}
+		return fmt.Sprintf("denom index %s %s", index.K1(), index.K2()), true
+	case bytes.HasPrefix(key, banktypes.SupplyKey):
+		_, denom, err := collections.StringKey.Decode(key[len(banktypes.SupplyKey):])
+		if err != nil {
+			return "", false
+		}
+		amounts, ok := formatAmounts(diff, sdk.IntValue.Decode)
+		if !ok {
+			return "", false
+		}
+		return fmt.Sprintf("supply %s: %s", denom, amounts), true
+	}
+	return "", false
+}
+
+// decodeWith decodes the values with a store decoder of the simulation
+// manager. The decoders panic on the keys they don't know.
+func decodeWith(decoder func(kvA, kvB kv.Pair) string, diff KVDiff) (decoded string, ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			decoded, ok = "", false
+		}
+	}()
+
+	// a missing value is decoded as the other one, as the decoders don't
+	// expect missing values
+	from, to := diff.From, diff.To
+	if diff.Added() {
+		from = to
+	}
+	if diff.Deleted() {
+		to = from
+	}
+
+	// the decoders print both values on their own lines
+	values := strings.SplitN(decoder(kv.Pair{Key: diff.Key, Value: from}, kv.Pair{Key: diff.Key, Value: to}), "\n", 2)
+	if len(values) != 2 {
+		return fmt.Sprintf("%X: %s", diff.Key, strings.TrimSpace(values[0])), true
+	}
+	values[0], values[1] = strings.TrimSpace(values[0]), strings.TrimSpace(values[1])
+	if diff.Added() {
+		values[0] = "none"
+	}
+	if diff.Deleted() {
+		values[1] = "none"
+	}
+	return fmt.Sprintf("%X: %s -> %s", diff.Key, values[0], values[1]), true
+}
+
+// formatAmounts decodes the amounts of a key, ok is false if a value isn't an
+// amount.
+func formatAmounts[T fmt.Stringer](diff KVDiff, decode func([]byte) (T, error)) (string, bool) {
+	amounts := [2]string{"none", "none"}
+	for i, bz := range [][]byte{diff.From, diff.To} {
+		if bz == nil {
+			continue
+		}
+		amount, err := decode(bz)
+		if err != nil {
+			return "", false
+		}
+		amounts[i] = amount.String()
+	}
+	return amounts[0] + " -> " + amounts[1], true
+}
+
+// formatDiff prints the values of a key, a missing value is printed as none.
+func formatDiff(diff KVDiff, format func([]byte) string) string {
+	from, to := "none", "none"
+	if !diff.Added() {
+		from = format(diff.From)
+	}
+	if !diff.Deleted() {
+		to = format(diff.To)
+	}
+	return from + " -> " + to
+}
@@ -0,0 +1,112 @@
+package app
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func TestWalkStoreDiffs(t *testing.T) {
+	chainApp := Setup(t)
+	require.NoError(t, chainApp.simulateBlock(time.Now().UTC()))
+	from := chainApp.LastBlockHeight()
+	ctx := chainApp.NewUncachedContext(false, cmtproto.Header{Height: from})
+
+	bondDenom, err := chainApp.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+	supply := chainApp.BankKeeper.GetSupply(ctx, bondDenom).Amount
+
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewCoin(bondDenom, sdkmath.NewInt(1000)))
+	require.NoError(t, chainApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, chainApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, account, coins))
+
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	slot := common.BigToHash(common.Big1)
+	chainApp.EVMKeeper.SetState(ctx, contract, slot, common.BigToHash(common.Big2).Bytes())
+	// a slot set and deleted between the heights doesn't differ
+	tmpSlot := common.BigToHash(common.Big3)
+	chainApp.EVMKeeper.SetState(ctx, contract, tmpSlot, common.BigToHash(common.Big3).Bytes())
+	require.NoError(t, chainApp.simulateBlock(time.Now().UTC()))
+
+	ctx = chainApp.NewUncachedContext(false, cmtproto.Header{Height: chainApp.LastBlockHeight()})
+	chainApp.EVMKeeper.DeleteState(ctx, contract, tmpSlot)
+	require.NoError(t, chainApp.simulateBlock(time.Now().UTC()))
+
+	var lines []string
+	require.NoError(t, chainApp.WalkStoreDiffs(from, chainApp.LastBlockHeight(), []string{"bank", "evm"}, func(store string, diff KVDiff) error {
+		lines = append(lines, chainApp.DecodeStoreDiff(store, diff))
+		return nil
+	}))
+
+	require.Contains(t, lines, fmt.Sprintf("+ balance %s %s: none -> 1000", account, bondDenom))
+	require.Contains(t, lines, fmt.Sprintf("+ denom index %s %s", bondDenom, account))
+	require.Contains(t, lines, fmt.Sprintf("~ supply %s: %s -> %s", bondDenom, supply, supply.AddRaw(1000)))
+	require.Contains(t, lines, fmt.Sprintf("+ storage %s slot %s: none -> %s", contract.Hex(), slot.Hex(), common.BigToHash(common.Big2).Hex()))
+	for _, line := range lines {
+		require.NotContains(t, line, tmpSlot.Hex())
+	}
+
+	diffs, err := chainApp.DiffStores(from, chainApp.LastBlockHeight())
+	require.NoError(t, err)
+	require.NotEmpty(t, diffs)
+
+	require.ErrorContains(t, chainApp.WalkStoreDiffs(from, chainApp.LastBlockHeight(), []string{"unknown"}, func(string, KVDiff) error { return nil }), "unknown store unknown")
+}
+
+func TestLoadMultiStoreReadOnly(t *testing.T) {
+	dir := t.TempDir()
+	db, err := dbm.NewGoLevelDB("application", dir, nil)
+	require.NoError(t, err)
+
+	rs := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	key := storetypes.NewKVStoreKey("test")
+	rs.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+	rs.GetKVStore(key).Set([]byte("a"), []byte("1"))
+	rs.Commit()
+	rs.GetKVStore(key).Set([]byte("a"), []byte("2"))
+	rs.GetKVStore(key).Set([]byte("b"), []byte("1"))
+	rs.Commit()
+	require.NoError(t, db.Close())
+
+	// the stores are loaded from a database that can't be written
+	db, err = dbm.NewGoLevelDBWithOpts("application", dir, &opt.Options{ReadOnly: true})
+	require.NoError(t, err)
+	defer db.Close()
+
+	loaded, keys, err := LoadMultiStore(db, 2)
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	require.Equal(t, "test", keys[0].Name())
+
+	var diffs []KVDiff
+	require.NoError(t, WalkMultiStoreDiffs(loaded, keys, 1, 2, nil, func(store string, diff KVDiff) error {
+		require.Equal(t, "test", store)
+		diffs = append(diffs, diff)
+		return nil
+	}))
+	require.Equal(t, []KVDiff{
+		{Key: []byte("a"), From: []byte("1"), To: []byte("2")},
+		{Key: []byte("b"), To: []byte("1")},
+	}, diffs)
+
+	_, _, err = LoadMultiStore(db, 3)
+	require.Error(t, err)
+}
@@ -416,8 +416,10 @@ func GenesisStateWithValSet(
 		totalSupply = totalSupply.Add(b.Coins...)
 	}
 
-	// update total supply
-	bankGenesis := banktypes.NewGenesisState(banktypes.DefaultGenesisState().Params, balances, totalSupply, []banktypes.Metadata{}, []banktypes.SendEnabled{})
+	// update total supply, the denom metadata of the genesis is kept
+	var defaultBankGenesis banktypes.GenesisState
+	codec.MustUnmarshalJSON(genesisState[banktypes.ModuleName], &defaultBankGenesis)
+	bankGenesis := banktypes.NewGenesisState(banktypes.DefaultGenesisState().Params, balances, totalSupply, defaultBankGenesis.DenomMetadata, []banktypes.SendEnabled{})
 	genesisState[banktypes.ModuleName] = codec.MustMarshalJSON(bankGenesis)
 
 	return genesisState, nil
@@ -0,0 +1,363 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// DefaultTestnetVotingPeriod is the default gov voting period of an in-place
+// testnet.
+const DefaultTestnetVotingPeriod = time.Minute
+
+// testnetValidatorPower is the consensus power of the validator of an
+// in-place testnet.
+const testnetValidatorPower = 1_000_000
+
+// TestnetConfig is the configuration of an in-place testnet, a private fork of
+// the state of a chain, see InitForTestnet.
+type TestnetConfig struct {
+	// ConsPubKey is the consensus key of the single validator of the testnet.
+	ConsPubKey cmtcrypto.PubKey
+	// Operator is the operator of the validator, it must not be a validator of
+	// the chain.
+	Operator sdk.ValAddress
+
+	// Accounts are funded with the FundCoins, minted for the testnet. The
+	// FundCoins default to a million display units of the bond denom.
+	Accounts  []sdk.AccAddress
+	FundCoins sdk.Coins
+
+	// VotingPeriod is the gov voting period of the testnet, the voting ends of
+	// the proposals in voting period are brought forward to it. The expedited
+	// voting period is half of it.
+	VotingPeriod time.Duration
+
+	// Upgrade is the upgrade to run on the first block of the testnet, if any.
+	Upgrade string
+}
+
+// InitForTestnet rewrites the state of the last height into the state of an
+// in-place testnet: the validator set is replaced by a single validator, the
+// accounts are funded, the gov voting period is shortened and the IBC clients
+// are reset so they stay active without relayers. The changes are committed
+// with the first block of the testnet.
+func (app *ChainApp) InitForTestnet(cfg TestnetConfig) error {
+	if cfg.ConsPubKey == nil || cfg.Operator.Empty() {
+		return errors.New("the testnet validator must have a consensus key and an operator")
+	}
+
+	height := app.LastBlockHeight()
+	now := time.Now().UTC()
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: height, Time: now}).
+		WithHeaderInfo(header.Info{Height: height, Time: now})
+
+	if err := app.replaceValidatorSet(ctx, cfg.ConsPubKey, cfg.Operator); err != nil {
+		return fmt.Errorf("validator set: %w", err)
+	}
+	if err := app.fundTestnetAccounts(ctx, cfg.Accounts, cfg.FundCoins); err != nil {
+		return fmt.Errorf("fund accounts: %w", err)
+	}
+	if cfg.VotingPeriod > 0 {
+		if err := app.shortenVotingPeriod(ctx, cfg.VotingPeriod); err != nil {
+			return fmt.Errorf("gov: %w", err)
+		}
+	}
+	app.resetIBCClients(ctx)
+
+	if cfg.Upgrade != "" {
+		if !app.UpgradeKeeper.HasHandler(cfg.Upgrade) {
+			return fmt.Errorf("unknown upgrade %s", cfg.Upgrade)
+		}
+		if err := app.UpgradeKeeper.ScheduleUpgrade(ctx, upgradetypes.Plan{Name: cfg.Upgrade, Height: height + 1}); err != nil {
+			return err
+		}
+	}
+
+	app.Logger().Info("initialized in-place testnet", "height", height, "validator", cfg.Operator.String(), "funded_accounts", len(cfg.Accounts))
+	return nil
+}
+
+// replaceValidatorSet creates the validator of the testnet, bonded and self
+// delegated, and removes the validators of the chain from the validator set.
+// The bonded validators of the chain start unbonding, with their tokens moved
+// to the not bonded pool, and leave the power index so the end blocker doesn't
+// bond them again. Their last powers are removed too, so no validator update
+// is sent for them: the CometBFT validator set holds the testnet validator
+// alone.
+func (app *ChainApp) replaceValidatorSet(ctx sdk.Context, consPubKey cmtcrypto.PubKey, operator sdk.ValAddress) error {
+	if _, err := app.StakingKeeper.GetValidator(ctx, operator); err == nil {
+		return fmt.Errorf("%s is already a validator", operator)
+	}
+
+	pubKey, err := cryptocodec.FromCmtPubKeyInterface(consPubKey)
+	if err != nil {
+		return err
+	}
+
+	bondDenom, err := app.StakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+	if err := app.unbondValidators(ctx, bondDenom); err != nil {
+		return err
+	}
+
+	// the power index and the last powers hold the validator set
+	powerIndex, err := iteratorKeys(app.StakingKeeper.ValidatorsPowerStoreIterator(ctx))
+	if err != nil {
+		return err
+	}
+	lastPowers, err := iteratorKeys(app.StakingKeeper.LastValidatorsIterator(ctx))
+	if err != nil {
+		return err
+	}
+	stakingStore := ctx.KVStore(app.GetKey(stakingtypes.StoreKey))
+	for _, key := range append(powerIndex, lastPowers...) {
+		stakingStore.Delete(key)
+	}
+
+	tokens := app.StakingKeeper.PowerReduction(ctx).MulRaw(testnetValidatorPower)
+	validator, err := stakingtypes.NewValidator(operator.String(), pubKey, stakingtypes.Description{Moniker: "testnet"})
+	if err != nil {
+		return err
+	}
+	validator.Status = stakingtypes.Bonded
+	validator.Tokens = tokens
+	validator.DelegatorShares = math.LegacyNewDecFromInt(tokens)
+	validator.MinSelfDelegation = math.OneInt()
+	validator.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(5, 2), math.LegacyNewDecWithPrec(20, 2), math.LegacyNewDecWithPrec(1, 2))
+	validator.Commission.UpdateTime = ctx.BlockTime()
+
+	// the bonded tokens of the validator back its self delegation
+	coins := sdk.NewCoins(sdk.NewCoin(bondDenom, tokens))
+	if err := app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, stakingtypes.BondedPoolName, coins); err != nil {
+		return err
+	}
+
+	if err := app.StakingKeeper.SetValidator(ctx, validator); err != nil {
+		return err
+	}
+	if err := app.StakingKeeper.SetValidatorByConsAddr(ctx, validator); err != nil {
+		return err
+	}
+	if err := app.StakingKeeper.SetValidatorByPowerIndex(ctx, validator); err != nil {
+		return err
+	}
+	// a zero last power makes the end blocker send the power of the validator
+	// to CometBFT
+	if err := app.StakingKeeper.SetLastValidatorPower(ctx, operator, 0); err != nil {
+		return err
+	}
+	if err := app.StakingKeeper.Hooks().AfterValidatorCreated(ctx, operator); err != nil {
+		return err
+	}
+
+	delegator := sdk.AccAddress(operator)
+	if err := app.StakingKeeper.Hooks().BeforeDelegationCreated(ctx, delegator, operator); err != nil {
+		return err
+	}
+	if err := app.StakingKeeper.SetDelegation(ctx, stakingtypes.NewDelegation(delegator.String(), operator.String(), validator.DelegatorShares)); err != nil {
+		return err
+	}
+	if err := app.StakingKeeper.Hooks().AfterDelegationModified(ctx, delegator, operator); err != nil {
+		return err
+	}
+	if !app.AccountKeeper.HasAccount(ctx, delegator) {
+		app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, delegator))
+	}
+
+	consAddr := sdk.ConsAddress(pubKey.Address())
+	return app.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(consAddr, ctx.BlockHeight(), 0, time.Unix(0, 0), false, 0))
+}
+
+// unbondValidators starts the unbonding of the bonded validators, as the
+// staking end blocker does for the validators leaving the validator set: their
+// tokens move from the bonded pool to the not bonded pool and they complete
+// unbonding after the unbonding time.
+func (app *ChainApp) unbondValidators(ctx sdk.Context, bondDenom string) error {
+	validators, err := app.StakingKeeper.GetAllValidators(ctx)
+	if err != nil {
+		return err
+	}
+	unbondingTime, err := app.StakingKeeper.UnbondingTime(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, validator := range validators {
+		if !validator.IsBonded() {
+			continue
+		}
+
+		validator = validator.UpdateStatus(stakingtypes.Unbonding)
+		validator.UnbondingHeight = ctx.BlockHeight()
+		validator.UnbondingTime = ctx.BlockTime().Add(unbondingTime)
+		if err := app.StakingKeeper.SetValidator(ctx, validator); err != nil {
+			return err
+		}
+		if err := app.StakingKeeper.InsertUnbondingValidatorQueue(ctx, validator); err != nil {
+			return err
+		}
+
+		coins := sdk.NewCoins(sdk.NewCoin(bondDenom, validator.GetTokens()))
+		if err := app.BankKeeper.SendCoinsFromModuleToModule(ctx, stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName, coins); err != nil {
+			return err
+		}
+
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			return err
+		}
+		valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator())
+		if err != nil {
+			return err
+		}
+		if err := app.StakingKeeper.Hooks().AfterValidatorBeginUnbonding(ctx, consAddr, valAddr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// iteratorKeys returns the keys of the iterator, to delete them once it's
+// closed.
+func iteratorKeys(iterator corestore.Iterator, err error) ([][]byte, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	return keys, nil
+}
+
+// fundTestnetAccounts mints the coins to each account.
+func (app *ChainApp) fundTestnetAccounts(ctx sdk.Context, accounts []sdk.AccAddress, coins sdk.Coins) error {
+	if coins.IsZero() {
+		bondDenom, err := app.StakingKeeper.BondDenom(ctx)
+		if err != nil {
+			return err
+		}
+		coins = sdk.NewCoins(sdk.NewCoin(bondDenom, app.StakingKeeper.PowerReduction(ctx).MulRaw(1_000_000)))
+	}
+
+	for _, account := range accounts {
+		if err := app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins); err != nil {
+			return err
+		}
+		if err := app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, account, coins); err != nil {
+			return fmt.Errorf("%s: %w", account, err)
+		}
+	}
+	return nil
+}
+
+// shortenVotingPeriod sets the voting periods of the gov params and brings
+// the voting ends of the active proposals forward to the voting period.
+func (app *ChainApp) shortenVotingPeriod(ctx sdk.Context, votingPeriod time.Duration) error {
+	params, err := app.GovKeeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	expeditedVotingPeriod := votingPeriod / 2
+	params.VotingPeriod = &votingPeriod
+	params.ExpeditedVotingPeriod = &expeditedVotingPeriod
+	if params.MaxDepositPeriod == nil || *params.MaxDepositPeriod > votingPeriod {
+		params.MaxDepositPeriod = &votingPeriod
+	}
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := app.GovKeeper.Params.Set(ctx, params); err != nil {
+		return err
+	}
+
+	votingEnd := ctx.BlockTime().Add(votingPeriod)
+	var shortened []collections.Pair[time.Time, uint64]
+	err = app.GovKeeper.ActiveProposalsQueue.Walk(ctx, nil, func(key collections.Pair[time.Time, uint64], _ uint64) (bool, error) {
+		if key.K1().After(votingEnd) {
+			shortened = append(shortened, key)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range shortened {
+		proposal, err := app.GovKeeper.Proposals.Get(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+		proposal.VotingEndTime = &votingEnd
+		if err := app.GovKeeper.Proposals.Set(ctx, proposal.Id, proposal); err != nil {
+			return err
+		}
+		if err := app.GovKeeper.ActiveProposalsQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+		if err := app.GovKeeper.ActiveProposalsQueue.Set(ctx, collections.Join(votingEnd, proposal.Id), proposal.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resetIBCClients unfreezes the Tendermint clients and extends their trusting
+// and unbonding periods by the time elapsed since their latest consensus state,
+// so they are active for their whole trusting period from the start of the
+// testnet. The counterparty chains don't know the testnet, no relayer updates
+// the clients.
+func (app *ChainApp) resetIBCClients(ctx sdk.Context) {
+	clientKeeper := app.IBCKeeper.ClientKeeper
+
+	type client struct {
+		id    string
+		state *ibctm.ClientState
+	}
+	var clients []client
+	clientKeeper.IterateClientStates(ctx, nil, func(clientID string, cs exported.ClientState) bool {
+		if state, ok := cs.(*ibctm.ClientState); ok {
+			clients = append(clients, client{id: clientID, state: state})
+		}
+		return false
+	})
+
+	for _, client := range clients {
+		client.state.FrozenHeight = clienttypes.ZeroHeight()
+
+		if consState, found := clientKeeper.GetLatestClientConsensusState(ctx, client.id); found {
+			elapsed := ctx.BlockTime().Sub(time.Unix(0, int64(consState.GetTimestamp())))
+			if elapsed > 0 {
+				client.state.TrustingPeriod += elapsed
+				client.state.UnbondingPeriod += elapsed
+			}
+		}
+		clientKeeper.SetClientState(ctx, client.id, client.state)
+	}
+}
@@ -0,0 +1,121 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestInitForTestnet(t *testing.T) {
+	chainApp := Setup(t)
+	ctx := chainApp.NewUncachedContext(false, cmtproto.Header{Height: chainApp.LastBlockHeight()})
+
+	validators, err := chainApp.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, validators)
+
+	// a client of a counterparty chain, frozen and expired
+	const clientID = "07-tendermint-0"
+	clientHeight := clienttypes.NewHeight(1, 10)
+	clientState := ibctm.NewClientState("counterparty-1", ibctm.DefaultTrustLevel, time.Hour, 2*time.Hour, time.Minute, clientHeight, commitmenttypes.GetSDKSpecs(), nil)
+	clientState.FrozenHeight = clienttypes.NewHeight(1, 11)
+	chainApp.IBCKeeper.ClientKeeper.SetClientState(ctx, clientID, clientState)
+	chainApp.IBCKeeper.ClientKeeper.SetClientConsensusState(ctx, clientID, clientHeight, ibctm.NewConsensusState(time.Now().Add(-24*time.Hour), commitmenttypes.NewMerkleRoot([]byte("root")), []byte("next vals hash")))
+
+	consPubKey := ed25519.GenPrivKey().PubKey()
+	operator := sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	cfg := TestnetConfig{
+		ConsPubKey:   consPubKey,
+		Operator:     operator,
+		Accounts:     []sdk.AccAddress{account},
+		VotingPeriod: time.Minute,
+	}
+	require.NoError(t, chainApp.InitForTestnet(cfg))
+
+	// the operator is a validator of the testnet now
+	require.ErrorContains(t, chainApp.InitForTestnet(TestnetConfig{ConsPubKey: consPubKey, Operator: operator}), "is already a validator")
+
+	blockTime := time.Now().UTC()
+	req, err := chainApp.nextBlockRequest(blockTime)
+	require.NoError(t, err)
+	res, err := chainApp.FinalizeBlock(req)
+	require.NoError(t, err)
+	_, err = chainApp.Commit()
+	require.NoError(t, err)
+
+	// the testnet validator alone is sent to CometBFT
+	pubKey, err := cryptoenc.PubKeyToProto(consPubKey)
+	require.NoError(t, err)
+	require.Len(t, res.ValidatorUpdates, 1)
+	require.Equal(t, pubKey, res.ValidatorUpdates[0].PubKey)
+	require.EqualValues(t, testnetValidatorPower, res.ValidatorUpdates[0].Power)
+
+	ctx = chainApp.NewUncachedContext(false, cmtproto.Header{Height: chainApp.LastBlockHeight(), Time: blockTime})
+	require.Empty(t, chainApp.brokenInvariants(ctx))
+
+	bonded, err := chainApp.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	require.NoError(t, err)
+	require.Len(t, bonded, 1)
+	require.Equal(t, operator.String(), bonded[0].OperatorAddress)
+
+	bondDenom, err := chainApp.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+	require.Equal(t, chainApp.StakingKeeper.PowerReduction(ctx).MulRaw(1_000_000), chainApp.BankKeeper.GetBalance(ctx, account, bondDenom).Amount)
+
+	// the validators of the chain are unbonding, their tokens aren't bonded
+	bondedPool := chainApp.StakingKeeper.GetBondedPool(ctx).GetAddress()
+	notBondedPool := chainApp.StakingKeeper.GetNotBondedPool(ctx).GetAddress()
+	require.Equal(t, bonded[0].Tokens, chainApp.BankKeeper.GetBalance(ctx, bondedPool, bondDenom).Amount)
+	for _, validator := range validators {
+		valAddr, err := sdk.ValAddressFromBech32(validator.OperatorAddress)
+		require.NoError(t, err)
+		unbonding, err := chainApp.StakingKeeper.GetValidator(ctx, valAddr)
+		require.NoError(t, err)
+		require.True(t, unbonding.IsUnbonding())
+		require.True(t, chainApp.BankKeeper.GetBalance(ctx, notBondedPool, bondDenom).Amount.GTE(validator.Tokens))
+	}
+
+	params, err := chainApp.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, time.Minute, *params.VotingPeriod)
+	require.Equal(t, 30*time.Second, *params.ExpeditedVotingPeriod)
+
+	cs, found := chainApp.IBCKeeper.ClientKeeper.GetClientState(ctx, clientID)
+	require.True(t, found)
+	require.True(t, cs.(*ibctm.ClientState).FrozenHeight.IsZero())
+	require.Equal(t, exported.Active, chainApp.IBCKeeper.ClientKeeper.GetClientStatus(ctx, cs, clientID))
+
+	// the validators of the chain complete unbonding after the unbonding time
+	unbondingTime, err := chainApp.StakingKeeper.UnbondingTime(ctx)
+	require.NoError(t, err)
+	blockTime = blockTime.Add(unbondingTime + time.Second)
+	req, err = chainApp.nextBlockRequest(blockTime)
+	require.NoError(t, err)
+	res, err = chainApp.FinalizeBlock(req)
+	require.NoError(t, err)
+	require.Empty(t, res.ValidatorUpdates)
+	_, err = chainApp.Commit()
+	require.NoError(t, err)
+
+	ctx = chainApp.NewUncachedContext(false, cmtproto.Header{Height: chainApp.LastBlockHeight(), Time: blockTime})
+	require.Empty(t, chainApp.brokenInvariants(ctx))
+	for _, validator := range validators {
+		valAddr, err := sdk.ValAddressFromBech32(validator.OperatorAddress)
+		require.NoError(t, err)
+		unbonded, err := chainApp.StakingKeeper.GetValidator(ctx, valAddr)
+		require.NoError(t, err)
+		require.True(t, unbonded.IsUnbonded())
+	}
+}
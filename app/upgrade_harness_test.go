@@ -0,0 +1,355 @@
+package app
+
+import (
+	"encoding/json"
+	"flag"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/upgrade"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	bam "github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/server"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+
+	"github.com/rollchains/flora/app/upgrades"
+)
+
+var updateUpgradeFixture = flag.Bool("update-upgrade-fixture", false, "regenerate the genesis fixture of the upgrade tests")
+
+// upgradeFixture is the genesis the upgrade tests start their chain from.
+var upgradeFixture = filepath.Join("testdata", "upgrade_genesis.json")
+
+// upgradeFixtureKeys returns the keys of the validator and of the account of
+// the genesis fixture.
+func upgradeFixtureKeys() (*ed25519.PrivKey, *ethsecp256k1.PrivKey) {
+	return ed25519.GenPrivKeyFromSecret([]byte("upgrade fixture validator")),
+		&ethsecp256k1.PrivKey{Key: crypto.Keccak256([]byte("upgrade fixture account"))}
+}
+
+// writeUpgradeFixture writes the genesis fixture: one validator delegated by
+// the account, a short voting period and no base fee.
+func writeUpgradeFixture(t *testing.T, path string) {
+	t.Helper()
+
+	gapp := SetupWithEmptyStore(t)
+	cdc := gapp.AppCodec()
+	valKey, accKey := upgradeFixtureKeys()
+
+	cmtPubKey, err := cryptocodec.ToCmtPubKeyInterface(valKey.PubKey())
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(cmtPubKey, 1)})
+
+	acc := authtypes.NewBaseAccount(accKey.PubKey().Address().Bytes(), accKey.PubKey(), 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100000000000000))),
+	}
+	genesis, err := GenesisStateWithValSet(cdc, gapp.DefaultGenesis(), valSet, []authtypes.GenesisAccount{acc}, balance)
+	require.NoError(t, err)
+
+	var govGenesis govv1.GenesisState
+	cdc.MustUnmarshalJSON(genesis[govtypes.ModuleName], &govGenesis)
+	votingPeriod := 10 * time.Second
+	govGenesis.Params.VotingPeriod = &votingPeriod
+	genesis[govtypes.ModuleName] = cdc.MustMarshalJSON(&govGenesis)
+
+	var feemarketGenesis feemarkettypes.GenesisState
+	cdc.MustUnmarshalJSON(genesis[feemarkettypes.ModuleName], &feemarketGenesis)
+	feemarketGenesis.Params.NoBaseFee = true
+	genesis[feemarkettypes.ModuleName] = cdc.MustMarshalJSON(&feemarketGenesis)
+
+	bz, err := json.MarshalIndent(genesis, "", "  ")
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, append(bz, '\n'), 0o644))
+}
+
+// upgradeChain runs a chain across the binaries of an upgrade. The app of the
+// old binary produces the blocks until the upgrade halts it and is closed,
+// then the app of the new binary restarts on the same home and database.
+type upgradeChain struct {
+	t       *testing.T
+	home    string
+	app     *ChainApp
+	account *ethsecp256k1.PrivKey
+	time    time.Time
+}
+
+// newUpgradeChain starts a chain from the genesis fixture with the app of a
+// binary registering the upgrades.
+func newUpgradeChain(t *testing.T, fixture string, upgrades []upgrades.Upgrade) *upgradeChain {
+	t.Helper()
+
+	if *updateUpgradeFixture {
+		writeUpgradeFixture(t, fixture)
+	}
+	appState, err := os.ReadFile(fixture)
+	require.NoError(t, err)
+
+	_, account := upgradeFixtureKeys()
+	c := &upgradeChain{
+		t:       t,
+		home:    t.TempDir(),
+		account: account,
+		time:    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	c.start(upgrades)
+	t.Cleanup(c.stop)
+
+	consensusParams := simtestutil.DefaultConsensusParams
+	consensusParams.Block.MaxGas = 100 * simtestutil.DefaultGenTxGas
+	_, err = c.app.InitChain(&abci.RequestInitChain{
+		ChainId:         chainID,
+		Time:            c.time,
+		ConsensusParams: consensusParams,
+		InitialHeight:   1,
+		AppStateBytes:   appState,
+	})
+	require.NoError(t, err)
+	c.nextBlock()
+
+	return c
+}
+
+// start opens the database of the chain home and creates the app of a binary
+// registering the upgrades on it, as a node starting.
+func (c *upgradeChain) start(binaryUpgrades []upgrades.Upgrade) {
+	c.t.Helper()
+
+	saved := Upgrades
+	Upgrades = binaryUpgrades
+	defer func() { Upgrades = saved }()
+
+	db, err := dbm.NewDB("application", dbm.GoLevelDBBackend, filepath.Join(c.home, "data"))
+	require.NoError(c.t, err)
+
+	appOptions := simtestutil.AppOptionsMap{
+		flags.FlagHome: c.home,
+		// the invariants are asserted at every block
+		server.FlagInvCheckPeriod: uint(1),
+	}
+	c.app = NewChainApp(log.NewNopLogger(), db, nil, true, appOptions, EVMAppOptions, bam.SetChainID(chainID))
+}
+
+// stop closes the app and its database, as a node stopping.
+func (c *upgradeChain) stop() {
+	if c.app == nil {
+		return
+	}
+	require.NoError(c.t, c.app.Close())
+	c.app = nil
+}
+
+// finalizeBlock finalizes the next block with the txs, without committing it.
+func (c *upgradeChain) finalizeBlock(txs ...[]byte) (*abci.ResponseFinalizeBlock, error) {
+	c.t.Helper()
+
+	req, err := c.app.nextBlockRequest(c.time.Add(5*time.Second), txs...)
+	require.NoError(c.t, err)
+	res, err := c.app.FinalizeBlock(req)
+	if err == nil {
+		c.time = req.Time
+	}
+	return res, err
+}
+
+// nextBlock finalizes and commits the next block with the txs.
+func (c *upgradeChain) nextBlock(txs ...[]byte) *abci.ResponseFinalizeBlock {
+	c.t.Helper()
+
+	res, err := c.finalizeBlock(txs...)
+	require.NoError(c.t, err)
+	_, err = c.app.Commit()
+	require.NoError(c.t, err)
+	return res
+}
+
+// context returns a context on the committed state, its writes are committed
+// with the next block.
+func (c *upgradeChain) context() sdk.Context {
+	c.t.Helper()
+
+	req, err := c.app.nextBlockRequest(c.time)
+	require.NoError(c.t, err)
+	return c.app.NewUncachedContext(false, cmtproto.Header{
+		ChainID:         chainID,
+		Height:          c.app.LastBlockHeight(),
+		Time:            c.time,
+		ProposerAddress: req.ProposerAddress,
+	})
+}
+
+// deliver signs the messages with the account of the fixture and delivers
+// them in the next block.
+func (c *upgradeChain) deliver(msgs ...sdk.Msg) *abci.ExecTxResult {
+	c.t.Helper()
+
+	acc := c.app.AccountKeeper.GetAccount(c.context(), c.account.PubKey().Address().Bytes())
+	tx, err := simtestutil.GenSignedMockTx(
+		rand.New(rand.NewSource(1)),
+		c.app.TxConfig(),
+		msgs,
+		sdk.NewCoins(),
+		simtestutil.DefaultGenTxGas,
+		chainID,
+		[]uint64{acc.GetAccountNumber()},
+		[]uint64{acc.GetSequence()},
+		c.account,
+	)
+	require.NoError(c.t, err)
+	bz, err := c.app.TxConfig().TxEncoder()(tx)
+	require.NoError(c.t, err)
+
+	res := c.nextBlock(bz)
+	require.Len(c.t, res.TxResults, 1)
+	require.Zero(c.t, res.TxResults[0].Code, res.TxResults[0].Log)
+	return res.TxResults[0]
+}
+
+// passUpgrade submits a proposal scheduling the upgrade, votes it and
+// produces the blocks of its voting period.
+func (c *upgradeChain) passUpgrade(plan upgradetypes.Plan) {
+	c.t.Helper()
+
+	ctx := c.context()
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	proposer := sdk.AccAddress(c.account.PubKey().Address()).String()
+	params, err := c.app.GovKeeper.Params.Get(ctx)
+	require.NoError(c.t, err)
+	proposalID, err := c.app.GovKeeper.ProposalID.Peek(ctx)
+	require.NoError(c.t, err)
+
+	msg, err := govv1.NewMsgSubmitProposal(
+		[]sdk.Msg{&upgradetypes.MsgSoftwareUpgrade{Authority: authority, Plan: plan}},
+		params.MinDeposit, proposer, "", "Upgrade "+plan.Name, "Upgrade to "+plan.Name, false,
+	)
+	require.NoError(c.t, err)
+	c.deliver(msg)
+	c.deliver(govv1.NewMsgVote(sdk.AccAddress(c.account.PubKey().Address()), proposalID, govv1.OptionYes, ""))
+
+	for i := 0; ; i++ {
+		proposal, err := c.app.GovKeeper.Proposals.Get(c.context(), proposalID)
+		require.NoError(c.t, err)
+		if proposal.Status != govv1.StatusVotingPeriod {
+			require.Equal(c.t, govv1.StatusPassed, proposal.Status, proposal.FailedReason)
+			break
+		}
+		require.Less(c.t, i, 10, "the voting period doesn't end")
+		c.nextBlock()
+	}
+
+	scheduled, err := c.app.UpgradeKeeper.GetUpgradePlan(c.context())
+	require.NoError(c.t, err)
+	require.Equal(c.t, plan.Name, scheduled.Name)
+}
+
+// upgrade produces the blocks up to the upgrade height, where the app of the
+// old binary halts, stops it and restarts the chain with the app of the new
+// binary.
+func (c *upgradeChain) upgrade(plan upgradetypes.Plan, newUpgrades []upgrades.Upgrade) {
+	c.t.Helper()
+
+	for c.app.LastBlockHeight() < plan.Height-1 {
+		c.nextBlock()
+	}
+
+	// the old app halts at the upgrade height, it commits nothing and leaves
+	// the upgrade info for the new binary
+	for i := 0; i < 2; i++ {
+		_, err := c.finalizeBlock()
+		require.ErrorContains(c.t, err, upgrade.BuildUpgradeNeededMsg(plan))
+		require.Equal(c.t, plan.Height-1, c.app.LastBlockHeight())
+	}
+	upgradeInfo, err := c.app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	require.NoError(c.t, err)
+	require.Equal(c.t, plan.Name, upgradeInfo.Name)
+	require.Equal(c.t, plan.Height, upgradeInfo.Height)
+	lastCommitID := c.app.LastCommitID()
+	c.stop()
+
+	// the new app resumes from the state of the old one
+	c.start(newUpgrades)
+	require.Equal(c.t, plan.Height-1, c.app.LastBlockHeight())
+	require.Equal(c.t, lastCommitID, c.app.LastCommitID())
+	c.nextBlock()
+	require.Equal(c.t, plan.Height, c.app.LastBlockHeight())
+
+	doneHeight, err := c.app.UpgradeKeeper.GetDoneHeight(c.context(), plan.Name)
+	require.NoError(c.t, err)
+	require.Equal(c.t, plan.Height, doneHeight)
+}
+
+func TestUpgradeHarness(t *testing.T) {
+	const upgradeName = "harness"
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+	oldUpgrades := Upgrades
+	newUpgrades := append(oldUpgrades[:len(oldUpgrades):len(oldUpgrades)], upgrades.NewUpgrade(upgradeName,
+		upgrades.RunMigrations(),
+		upgrades.RegisterTokenPairs(ibcDenom),
+		upgrades.SetFeeMarketParams(func(params *feemarkettypes.Params) {
+			params.BaseFeeChangeDenominator = 16
+		}),
+	))
+
+	chain := newUpgradeChain(t, upgradeFixture, oldUpgrades)
+
+	// a contract with code and storage before the upgrade
+	ctx := chain.context()
+	contract := deployCronTestContract(t, chain.app, ctx, counterCode)
+	caller := common.BytesToAddress(chain.account.PubKey().Address())
+	_, err := chain.app.EVMKeeper.CallEVMWithData(ctx, caller, &contract, nil, true)
+	require.NoError(t, err)
+	chain.nextBlock()
+
+	plan := upgradetypes.Plan{Name: upgradeName, Height: chain.app.LastBlockHeight() + 10}
+	chain.passUpgrade(plan)
+	chain.upgrade(plan, newUpgrades)
+
+	// the blocks after the upgrade assert the invariants
+	for i := 0; i < 3; i++ {
+		chain.nextBlock()
+	}
+	ctx = chain.context()
+	require.Empty(t, chain.app.brokenInvariants(ctx))
+
+	// the steps of the upgrade
+	require.True(t, chain.app.Erc20Keeper.IsDenomRegistered(ctx, ibcDenom))
+	require.Equal(t, uint32(16), chain.app.FeeMarketKeeper.GetParams(ctx).BaseFeeChangeDenominator)
+
+	// the contract keeps its code and storage, and runs
+	account := chain.app.EVMKeeper.GetAccount(ctx, contract)
+	require.NotNil(t, account)
+	require.Equal(t, counterCode, chain.app.EVMKeeper.GetCode(ctx, common.BytesToHash(account.CodeHash)))
+	require.Equal(t, common.BigToHash(common.Big1), chain.app.EVMKeeper.GetState(ctx, contract, common.Hash{}))
+
+	_, err = chain.app.EVMKeeper.CallEVMWithData(ctx, caller, &contract, nil, true)
+	require.NoError(t, err)
+	chain.nextBlock()
+	require.Equal(t, common.BigToHash(common.Big2), chain.app.EVMKeeper.GetState(chain.context(), contract, common.Hash{}))
+}
@@ -0,0 +1,147 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/core/header"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// simulationBlockInterval is the time between the simulated blocks.
+const simulationBlockInterval = 5 * time.Second
+
+// UpgradeSimulation is the outcome of SimulateUpgrade.
+type UpgradeSimulation struct {
+	Upgrade string
+	Height  int64
+
+	// BrokenInvariants are the crisis invariants broken after the upgrade.
+	BrokenInvariants []string
+
+	// Diffs are the module stores changed by the upgrade and the empty blocks
+	// following it.
+	Diffs []StoreDiff
+}
+
+// SimulateUpgrade schedules the upgrade at the next height, runs its block,
+// asserts the crisis invariants and runs empty blocks after it. The app must
+// be loaded with the store upgrades of the upgrade, and its state is committed
+// so it must run on a copy of the data.
+func (app *ChainApp) SimulateUpgrade(name string, emptyBlocks int) (UpgradeSimulation, error) {
+	if !app.UpgradeKeeper.HasHandler(name) {
+		return UpgradeSimulation{}, fmt.Errorf("unknown upgrade %s", name)
+	}
+
+	lastHeight := app.LastBlockHeight()
+	if lastHeight == 0 {
+		return UpgradeSimulation{}, errors.New("the state has no blocks")
+	}
+
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: lastHeight}).WithHeaderInfo(header.Info{Height: lastHeight})
+	doneHeight, err := app.UpgradeKeeper.GetDoneHeight(ctx, name)
+	if err != nil {
+		return UpgradeSimulation{}, err
+	}
+	if doneHeight != 0 {
+		return UpgradeSimulation{}, fmt.Errorf("upgrade %s was applied at height %d", name, doneHeight)
+	}
+
+	plan := upgradetypes.Plan{Name: name, Height: lastHeight + 1}
+	if err := app.UpgradeKeeper.ScheduleUpgrade(ctx, plan); err != nil {
+		return UpgradeSimulation{}, err
+	}
+
+	// the historical info holds the header of the last block
+	blockTime := time.Now().UTC()
+	if info, err := app.StakingKeeper.GetHistoricalInfo(ctx, lastHeight); err == nil {
+		blockTime = info.Header.Time
+	}
+
+	simulation := UpgradeSimulation{Upgrade: name, Height: plan.Height}
+	for i := 0; i <= emptyBlocks; i++ {
+		blockTime = blockTime.Add(simulationBlockInterval)
+		if err := app.simulateBlock(blockTime); err != nil {
+			return simulation, fmt.Errorf("height %d: %w", app.LastBlockHeight()+1, err)
+		}
+
+		if i == 0 {
+			ctx := app.NewUncachedContext(false, cmtproto.Header{Height: plan.Height, Time: blockTime})
+			simulation.BrokenInvariants = app.brokenInvariants(ctx)
+		}
+	}
+
+	simulation.Diffs, err = app.DiffStores(lastHeight, app.LastBlockHeight())
+	return simulation, err
+}
+
+// simulateBlock finalizes and commits an empty block.
+func (app *ChainApp) simulateBlock(blockTime time.Time) error {
+	req, err := app.nextBlockRequest(blockTime)
+	if err != nil {
+		return err
+	}
+	if _, err := app.FinalizeBlock(req); err != nil {
+		return err
+	}
+
+	_, err = app.Commit()
+	return err
+}
+
+// nextBlockRequest returns the request finalizing the next block with the
+// txs, proposed and signed by the bonded validators.
+func (app *ChainApp) nextBlockRequest(blockTime time.Time, txs ...[]byte) (*abci.RequestFinalizeBlock, error) {
+	height := app.LastBlockHeight() + 1
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: height - 1})
+
+	validators, err := app.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		proposer []byte
+		votes    []abci.VoteInfo
+	)
+	for _, validator := range validators {
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			return nil, err
+		}
+		if proposer == nil {
+			proposer = consAddr
+		}
+		votes = append(votes, abci.VoteInfo{
+			Validator:   abci.Validator{Address: consAddr, Power: validator.ConsensusPower(sdk.DefaultPowerReduction)},
+			BlockIdFlag: cmtproto.BlockIDFlagCommit,
+		})
+	}
+
+	return &abci.RequestFinalizeBlock{
+		Height:            height,
+		Time:              blockTime,
+		Txs:               txs,
+		Hash:              app.LastCommitID().Hash,
+		ProposerAddress:   proposer,
+		DecidedLastCommit: abci.CommitInfo{Round: 0, Votes: votes},
+	}, nil
+}
+
+// brokenInvariants returns the crisis invariants broken by the state.
+func (app *ChainApp) brokenInvariants(ctx sdk.Context) []string {
+	var broken []string
+	for _, route := range app.CrisisKeeper.Routes() {
+		cacheCtx, _ := ctx.CacheContext()
+		if res, stop := route.Invar(cacheCtx); stop {
+			broken = append(broken, res)
+		}
+	}
+	return broken
+}
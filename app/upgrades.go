@@ -2,15 +2,21 @@ package app
 
 import (
 	"fmt"
+	"strings"
 
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/rollchains/flora/app/upgrades"
 	"github.com/rollchains/flora/app/upgrades/noop"
+	v2 "github.com/rollchains/flora/app/upgrades/v2"
 )
 
 // Upgrades list of chain upgrades
-var Upgrades = []upgrades.Upgrade{}
+var Upgrades = []upgrades.Upgrade{
+	v2.NewUpgrade(),
+}
 
 // RegisterUpgradeHandlers registers the chain upgrade handlers
 func (app *ChainApp) RegisterUpgradeHandlers() {
@@ -20,15 +26,7 @@ func (app *ChainApp) RegisterUpgradeHandlers() {
 		Upgrades = append(Upgrades, noop.NewUpgrade(app.Version()))
 	}
 
-	keepers := upgrades.AppKeepers{
-		AccountKeeper:         &app.AccountKeeper,
-		ParamsKeeper:          &app.ParamsKeeper,
-		ConsensusParamsKeeper: &app.ConsensusParamsKeeper,
-		CapabilityKeeper:      app.CapabilityKeeper,
-		IBCKeeper:             app.IBCKeeper,
-		Codec:                 app.appCodec,
-		GetStoreKey:           app.GetKey,
-	}
+	keepers := app.upgradeKeepers()
 
 	// register all upgrade handlers
 	for _, upgrade := range Upgrades {
@@ -59,3 +57,88 @@ func (app *ChainApp) RegisterUpgradeHandlers() {
 		}
 	}
 }
+
+// upgradeKeepers returns the keepers of the upgrade handlers.
+func (app *ChainApp) upgradeKeepers() upgrades.AppKeepers {
+	return upgrades.AppKeepers{
+		Codec:       app.appCodec,
+		GetStoreKey: app.GetKey,
+
+		AccountKeeper:         &app.AccountKeeper,
+		BankKeeper:            &app.BankKeeper,
+		CapabilityKeeper:      app.CapabilityKeeper,
+		StakingKeeper:         app.StakingKeeper,
+		SlashingKeeper:        &app.SlashingKeeper,
+		MintKeeper:            &app.MintKeeper,
+		DistrKeeper:           &app.DistrKeeper,
+		GovKeeper:             &app.GovKeeper,
+		CrisisKeeper:          app.CrisisKeeper,
+		UpgradeKeeper:         app.UpgradeKeeper,
+		ParamsKeeper:          &app.ParamsKeeper,
+		AuthzKeeper:           &app.AuthzKeeper,
+		EvidenceKeeper:        &app.EvidenceKeeper,
+		FeeGrantKeeper:        &app.FeeGrantKeeper,
+		GroupKeeper:           &app.GroupKeeper,
+		NFTKeeper:             &app.NFTKeeper,
+		ConsensusParamsKeeper: &app.ConsensusParamsKeeper,
+		CircuitKeeper:         &app.CircuitKeeper,
+
+		IBCKeeper:           app.IBCKeeper,
+		IBCFeeKeeper:        &app.IBCFeeKeeper,
+		ICAControllerKeeper: &app.ICAControllerKeeper,
+		ICAHostKeeper:       &app.ICAHostKeeper,
+		TransferKeeper:      &app.TransferKeeper,
+
+		ScopedIBCKeeper:           &app.ScopedIBCKeeper,
+		ScopedICAHostKeeper:       &app.ScopedICAHostKeeper,
+		ScopedICAControllerKeeper: &app.ScopedICAControllerKeeper,
+		ScopedTransferKeeper:      &app.ScopedTransferKeeper,
+		ScopedIBCFeeKeeper:        &app.ScopedIBCFeeKeeper,
+
+		FeeMarketKeeper: &app.FeeMarketKeeper,
+		EVMKeeper:       app.EVMKeeper,
+		Erc20Keeper:     &app.Erc20Keeper,
+
+		TokenFactoryKeeper:     &app.TokenFactoryKeeper,
+		PoaKeeper:              &app.PoaKeeper,
+		ValidatorPolicyKeeper:  &app.ValidatorPolicyKeeper,
+		CronKeeper:             &app.CronKeeper,
+		InflationKeeper:        &app.InflationKeeper,
+		LanesKeeper:            &app.LanesKeeper,
+		OracleKeeper:           &app.OracleKeeper,
+		EVMCircuitKeeper:       &app.EVMCircuitKeeper,
+		DeployPolicyKeeper:     &app.DeployPolicyKeeper,
+		DenylistKeeper:         &app.DenylistKeeper,
+		ContractAccountsKeeper: &app.ContractAccountsKeeper,
+		PreciseBankKeeper:      &app.PreciseBankKeeper,
+	}
+}
+
+// checkConsensusParams refuses to run a state whose consensus params are
+// still in x/params, unless the upgrade of the next block migrates them.
+func (app *ChainApp) checkConsensusParams(ctx sdk.Context) error {
+	keepers := app.upgradeKeepers()
+	needed, err := upgrades.NeedsConsensusParamsMigration(ctx, &keepers)
+	if err != nil || !needed {
+		return err
+	}
+
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	for _, upgrade := range Upgrades {
+		if !upgrade.MigratesConsensusParams {
+			continue
+		}
+		if upgrade.UpgradeName == upgradeInfo.Name && upgradeInfo.Height == app.LastBlockHeight()+1 {
+			return nil
+		}
+		names = append(names, upgrade.UpgradeName)
+	}
+
+	return fmt.Errorf("the consensus params of the state at height %d are still in x/params, as before SDK v0.47: "+
+		"the node must be upgraded at the height of an upgrade migrating them (%s)", app.LastBlockHeight(), strings.Join(names, ", "))
+}
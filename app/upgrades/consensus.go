@@ -0,0 +1,45 @@
+package upgrades
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NeedsConsensusParamsMigration returns true if the consensus params of the
+// state are still in the baseapp subspace of x/params, as in the states
+// predating SDK v0.47.
+func NeedsConsensusParamsMigration(ctx context.Context, keepers *AppKeepers) (bool, error) {
+	migrated, err := keepers.ConsensusParamsKeeper.ParamsStore.Has(ctx)
+	if err != nil || migrated {
+		return false, err
+	}
+
+	legacy, found := keepers.ParamsKeeper.GetSubspace(baseapp.Paramspace)
+	if !found {
+		return false, fmt.Errorf("the %s subspace of x/params is not registered", baseapp.Paramspace)
+	}
+	return legacy.Has(sdk.UnwrapSDKContext(ctx), baseapp.ParamStoreKeyBlockParams), nil
+}
+
+// migrateConsensusParams moves the consensus params of a state predating SDK
+// v0.47 from the baseapp subspace of x/params to x/consensus. The states whose
+// consensus params are in x/consensus are left as is.
+func migrateConsensusParams(ctx context.Context, keepers *AppKeepers) error {
+	needed, err := NeedsConsensusParamsMigration(ctx, keepers)
+	if err != nil || !needed {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	legacy, _ := keepers.ParamsKeeper.GetSubspace(baseapp.Paramspace)
+	if cp := baseapp.GetConsensusParams(sdkCtx, legacy); cp.Block == nil || cp.Evidence == nil || cp.Validator == nil {
+		return errors.New("the legacy consensus params are incomplete")
+	}
+
+	sdkCtx.Logger().Info("migrating the consensus params from x/params to x/consensus")
+	return baseapp.MigrateParams(sdkCtx, legacy, keepers.ConsensusParamsKeeper.ParamsStore)
+}
@@ -0,0 +1,335 @@
+package upgrades
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+)
+
+// Step is a reusable part of an upgrade handler. The store upgrades of the
+// steps of an upgrade are merged into the store upgrades of the upgrade.
+type Step struct {
+	// Name describes the step in the logs and the dry-run reports.
+	Name string
+
+	// StoreUpgrades are the stores the step adds, renames or deletes.
+	StoreUpgrades storetypes.StoreUpgrades
+
+	// MigratesConsensusParams is true for the step moving the consensus
+	// params to x/consensus, see Upgrade.
+	MigratesConsensusParams bool
+
+	// Run applies the step and returns the changes it made, one per line of
+	// the reports.
+	Run func(ctx sdk.Context, env *Env) ([]string, error)
+
+	runsMigrations bool
+}
+
+// Env is the environment of the steps of an upgrade handler.
+type Env struct {
+	ModuleManager ModuleManager
+	Configurator  module.Configurator
+	Keepers       *AppKeepers
+	Plan          upgradetypes.Plan
+
+	// VersionMap is the module version map of the state, updated by the
+	// RunMigrations step and returned by the handler.
+	VersionMap module.VersionMap
+}
+
+// Report is the list of changes made by a step.
+type Report struct {
+	Step    string
+	Changes []string
+}
+
+// String returns the report as one line per change.
+func (r Report) String() string {
+	if len(r.Changes) == 0 {
+		return r.Step + ": no changes"
+	}
+
+	var b strings.Builder
+	for i, change := range r.Changes {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "%s: %s", r.Step, change)
+	}
+	return b.String()
+}
+
+// NewUpgrade assembles an upgrade running the steps in order. The steps must
+// include RunMigrations exactly once.
+func NewUpgrade(name string, steps ...Step) Upgrade {
+	upgrade := Upgrade{
+		UpgradeName: name,
+		Steps:       steps,
+		StoreUpgrades: storetypes.StoreUpgrades{
+			Added:   []string{},
+			Renamed: []storetypes.StoreRename{},
+			Deleted: []string{},
+		},
+	}
+
+	migrations := 0
+	for _, step := range steps {
+		if step.runsMigrations {
+			migrations++
+		}
+		upgrade.MigratesConsensusParams = upgrade.MigratesConsensusParams || step.MigratesConsensusParams
+		upgrade.StoreUpgrades.Added = append(upgrade.StoreUpgrades.Added, step.StoreUpgrades.Added...)
+		upgrade.StoreUpgrades.Renamed = append(upgrade.StoreUpgrades.Renamed, step.StoreUpgrades.Renamed...)
+		upgrade.StoreUpgrades.Deleted = append(upgrade.StoreUpgrades.Deleted, step.StoreUpgrades.Deleted...)
+	}
+	if migrations != 1 {
+		panic(fmt.Sprintf("upgrade %s must run the module migrations once, got %d", name, migrations))
+	}
+
+	upgrade.CreateUpgradeHandler = func(mm ModuleManager, configurator module.Configurator, keepers *AppKeepers) upgradetypes.UpgradeHandler {
+		return func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			env := &Env{
+				ModuleManager: mm,
+				Configurator:  configurator,
+				Keepers:       keepers,
+				Plan:          plan,
+				VersionMap:    fromVM,
+			}
+			if _, err := runSteps(sdk.UnwrapSDKContext(ctx), env, steps); err != nil {
+				return nil, err
+			}
+			return env.VersionMap, nil
+		}
+	}
+
+	return upgrade
+}
+
+// DryRun runs the steps of the upgrade on a cached context and returns their
+// reports. The state is left as is.
+func (u Upgrade) DryRun(ctx sdk.Context, mm ModuleManager, configurator module.Configurator, keepers *AppKeepers, fromVM module.VersionMap) ([]Report, error) {
+	cacheCtx, _ := ctx.CacheContext()
+	env := &Env{
+		ModuleManager: mm,
+		Configurator:  configurator,
+		Keepers:       keepers,
+		Plan:          upgradetypes.Plan{Name: u.UpgradeName, Height: ctx.BlockHeight()},
+		VersionMap:    fromVM,
+	}
+	return runSteps(cacheCtx, env, u.Steps)
+}
+
+func runSteps(ctx sdk.Context, env *Env, steps []Step) ([]Report, error) {
+	reports := make([]Report, 0, len(steps))
+	for _, step := range steps {
+		changes, err := step.Run(ctx, env)
+		if err != nil {
+			return nil, fmt.Errorf("upgrade %s: %s: %w", env.Plan.Name, step.Name, err)
+		}
+		ctx.Logger().Info("upgrade step applied", "upgrade", env.Plan.Name, "step", step.Name, "changes", len(changes))
+		reports = append(reports, Report{Step: step.Name, Changes: changes})
+	}
+	return reports, nil
+}
+
+// MigrateConsensusParams moves the consensus params of a state predating
+// SDK v0.47 to x/consensus. It must run before the module migrations, the
+// upgrade runs with empty consensus params until then.
+func MigrateConsensusParams() Step {
+	return Step{
+		Name:                    "migrate consensus params",
+		MigratesConsensusParams: true,
+		Run: func(ctx sdk.Context, env *Env) ([]string, error) {
+			needed, err := NeedsConsensusParamsMigration(ctx, env.Keepers)
+			if err != nil || !needed {
+				return nil, err
+			}
+			if err := migrateConsensusParams(ctx, env.Keepers); err != nil {
+				return nil, err
+			}
+			return []string{"moved the consensus params from x/params to x/consensus"}, nil
+		},
+	}
+}
+
+// RunMigrations runs the module migrations, the added modules are initialized
+// with their default genesis.
+func RunMigrations() Step {
+	return Step{
+		Name:           "run migrations",
+		runsMigrations: true,
+		Run: func(ctx sdk.Context, env *Env) ([]string, error) {
+			versionMap, err := env.ModuleManager.RunMigrations(ctx, env.Configurator, env.VersionMap)
+			if err != nil {
+				return nil, err
+			}
+
+			var changes []string
+			for _, name := range sortedModules(versionMap) {
+				from, found := env.VersionMap[name]
+				switch {
+				case !found:
+					changes = append(changes, fmt.Sprintf("added %s at version %d", name, versionMap[name]))
+				case from != versionMap[name]:
+					changes = append(changes, fmt.Sprintf("migrated %s from version %d to %d", name, from, versionMap[name]))
+				}
+			}
+			env.VersionMap = versionMap
+			return changes, nil
+		},
+	}
+}
+
+// AddStores adds the stores of new modules.
+func AddStores(storeKeys ...string) Step {
+	return Step{
+		Name:          "add stores",
+		StoreUpgrades: storetypes.StoreUpgrades{Added: storeKeys},
+		Run: func(sdk.Context, *Env) ([]string, error) {
+			changes := make([]string, len(storeKeys))
+			for i, key := range storeKeys {
+				changes[i] = "added store " + key
+			}
+			return changes, nil
+		},
+	}
+}
+
+// DeleteStores deletes the stores of removed modules.
+func DeleteStores(storeKeys ...string) Step {
+	return Step{
+		Name:          "delete stores",
+		StoreUpgrades: storetypes.StoreUpgrades{Deleted: storeKeys},
+		Run: func(sdk.Context, *Env) ([]string, error) {
+			changes := make([]string, len(storeKeys))
+			for i, key := range storeKeys {
+				changes[i] = "deleted store " + key
+			}
+			return changes, nil
+		},
+	}
+}
+
+// RegisterTokenPairs registers the ERC-20 extensions of IBC vouchers, by their
+// ibc/{hash} denom. The denoms already registered are skipped.
+func RegisterTokenPairs(denoms ...string) Step {
+	return Step{
+		Name: "register token pairs",
+		Run: func(ctx sdk.Context, env *Env) ([]string, error) {
+			var changes []string
+			for _, denom := range denoms {
+				if env.Keepers.Erc20Keeper.IsDenomRegistered(ctx, denom) {
+					continue
+				}
+				pair, err := env.Keepers.Erc20Keeper.RegisterERC20Extension(ctx, denom)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", denom, err)
+				}
+				changes = append(changes, fmt.Sprintf("registered %s at %s", denom, pair.Erc20Address))
+			}
+			return changes, nil
+		},
+	}
+}
+
+// EnablePrecompiles adds the static precompiles at the addresses to the
+// active ones. The active precompiles are skipped.
+func EnablePrecompiles(addresses ...string) Step {
+	return Step{
+		Name: "enable precompiles",
+		Run: func(ctx sdk.Context, env *Env) ([]string, error) {
+			active := env.Keepers.EVMKeeper.GetParams(ctx).ActiveStaticPrecompiles
+
+			var (
+				enabled []common.Address
+				changes []string
+			)
+			for _, address := range addresses {
+				if !common.IsHexAddress(address) {
+					return nil, fmt.Errorf("invalid precompile address %q", address)
+				}
+				addr := common.HexToAddress(address)
+				if slices.Contains(active, addr.Hex()) || slices.Contains(enabled, addr) {
+					continue
+				}
+				enabled = append(enabled, addr)
+				changes = append(changes, "enabled "+addr.Hex())
+			}
+			if len(enabled) == 0 {
+				return nil, nil
+			}
+			return changes, env.Keepers.EVMKeeper.EnableStaticPrecompiles(ctx, enabled...)
+		},
+	}
+}
+
+// SetFeeMarketParams updates the feemarket params, the updated params are
+// validated.
+func SetFeeMarketParams(update func(*feemarkettypes.Params)) Step {
+	return Step{
+		Name: "set feemarket params",
+		Run: func(ctx sdk.Context, env *Env) ([]string, error) {
+			params := env.Keepers.FeeMarketKeeper.GetParams(ctx)
+			updated := params
+			update(&updated)
+			if err := updated.Validate(); err != nil {
+				return nil, err
+			}
+
+			changes := diffFields(params, updated)
+			if len(changes) == 0 {
+				return nil, nil
+			}
+			return changes, env.Keepers.FeeMarketKeeper.SetParams(ctx, updated)
+		},
+	}
+}
+
+// diffFields returns the fields of two structs of the same type whose values
+// differ, named after their json tags.
+func diffFields(from, to any) []string {
+	fromValue, toValue := reflect.ValueOf(from), reflect.ValueOf(to)
+	if fromValue.Type() != toValue.Type() || fromValue.Kind() != reflect.Struct {
+		panic(errors.New("diffFields takes two structs of the same type"))
+	}
+
+	var changes []string
+	for i := 0; i < fromValue.NumField(); i++ {
+		field := fromValue.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			name = field.Name
+		}
+
+		fromField, toField := fmt.Sprint(fromValue.Field(i).Interface()), fmt.Sprint(toValue.Field(i).Interface())
+		if fromField != toField {
+			changes = append(changes, fmt.Sprintf("%s: %s -> %s", name, fromField, toField))
+		}
+	}
+	return changes
+}
+
+func sortedModules(versionMap module.VersionMap) []string {
+	names := make([]string, 0, len(versionMap))
+	for name := range versionMap {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
@@ -4,27 +4,112 @@ import (
 	"context"
 
 	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/keeper"
+	icahostkeeper "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/keeper"
+	ibcfeekeeper "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/keeper"
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 
 	storetypes "cosmossdk.io/store/types"
+	circuitkeeper "cosmossdk.io/x/circuit/keeper"
+	evidencekeeper "cosmossdk.io/x/evidence/keeper"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
+	nftkeeper "cosmossdk.io/x/nft/keeper"
+	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	consensusparamkeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
+	crisiskeeper "github.com/cosmos/cosmos-sdk/x/crisis/keeper"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	groupkeeper "github.com/cosmos/cosmos-sdk/x/group/keeper"
+	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	erc20keeper "github.com/cosmos/evm/x/erc20/keeper"
+	feemarketkeeper "github.com/cosmos/evm/x/feemarket/keeper"
+	ibctransferkeeper "github.com/cosmos/evm/x/ibc/transfer/keeper"
+	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
+	tokenfactorykeeper "github.com/strangelove-ventures/tokenfactory/x/tokenfactory/keeper"
+
+	contractaccountskeeper "github.com/rollchains/flora/x/contractaccounts/keeper"
+	cronkeeper "github.com/rollchains/flora/x/cron/keeper"
+	denylistkeeper "github.com/rollchains/flora/x/denylist/keeper"
+	deploypolicykeeper "github.com/rollchains/flora/x/deploypolicy/keeper"
+	evmcircuitkeeper "github.com/rollchains/flora/x/evmcircuit/keeper"
+	inflationkeeper "github.com/rollchains/flora/x/inflation/keeper"
+	laneskeeper "github.com/rollchains/flora/x/lanes/keeper"
+	oraclekeeper "github.com/rollchains/flora/x/oracle/keeper"
+	poakeeper "github.com/rollchains/flora/x/poa/keeper"
+	precisebankkeeper "github.com/rollchains/flora/x/precisebank/keeper"
+	validatorpolicykeeper "github.com/rollchains/flora/x/validatorpolicy/keeper"
 )
 
+// AppKeepers gives the upgrade handlers access to every keeper of the app.
+// The keepers held by value in the app are referenced, so that the handlers
+// see the same instances as the modules.
 type AppKeepers struct {
+	Codec       codec.Codec
+	GetStoreKey func(storeKey string) *storetypes.KVStoreKey
+
+	// SDK
 	AccountKeeper         *authkeeper.AccountKeeper
+	BankKeeper            *bankkeeper.BaseKeeper
+	CapabilityKeeper      *capabilitykeeper.Keeper
+	StakingKeeper         *stakingkeeper.Keeper
+	SlashingKeeper        *slashingkeeper.Keeper
+	MintKeeper            *mintkeeper.Keeper
+	DistrKeeper           *distrkeeper.Keeper
+	GovKeeper             *govkeeper.Keeper
+	CrisisKeeper          *crisiskeeper.Keeper
+	UpgradeKeeper         *upgradekeeper.Keeper
 	ParamsKeeper          *paramskeeper.Keeper
+	AuthzKeeper           *authzkeeper.Keeper
+	EvidenceKeeper        *evidencekeeper.Keeper
+	FeeGrantKeeper        *feegrantkeeper.Keeper
+	GroupKeeper           *groupkeeper.Keeper
+	NFTKeeper             *nftkeeper.Keeper
 	ConsensusParamsKeeper *consensusparamkeeper.Keeper
-	Codec                 codec.Codec
-	GetStoreKey           func(storeKey string) *storetypes.KVStoreKey
-	CapabilityKeeper      *capabilitykeeper.Keeper
-	IBCKeeper             *ibckeeper.Keeper
+	CircuitKeeper         *circuitkeeper.Keeper
+
+	// IBC
+	IBCKeeper           *ibckeeper.Keeper
+	IBCFeeKeeper        *ibcfeekeeper.Keeper
+	ICAControllerKeeper *icacontrollerkeeper.Keeper
+	ICAHostKeeper       *icahostkeeper.Keeper
+	TransferKeeper      *ibctransferkeeper.Keeper
+
+	ScopedIBCKeeper           *capabilitykeeper.ScopedKeeper
+	ScopedICAHostKeeper       *capabilitykeeper.ScopedKeeper
+	ScopedICAControllerKeeper *capabilitykeeper.ScopedKeeper
+	ScopedTransferKeeper      *capabilitykeeper.ScopedKeeper
+	ScopedIBCFeeKeeper        *capabilitykeeper.ScopedKeeper
+
+	// EVM
+	FeeMarketKeeper *feemarketkeeper.Keeper
+	EVMKeeper       *evmkeeper.Keeper
+	Erc20Keeper     *erc20keeper.Keeper
+
+	// Flora
+	TokenFactoryKeeper     *tokenfactorykeeper.Keeper
+	PoaKeeper              *poakeeper.Keeper
+	ValidatorPolicyKeeper  *validatorpolicykeeper.Keeper
+	CronKeeper             *cronkeeper.Keeper
+	InflationKeeper        *inflationkeeper.Keeper
+	LanesKeeper            *laneskeeper.Keeper
+	OracleKeeper           *oraclekeeper.Keeper
+	EVMCircuitKeeper       *evmcircuitkeeper.Keeper
+	DeployPolicyKeeper     *deploypolicykeeper.Keeper
+	DenylistKeeper         *denylistkeeper.Keeper
+	ContractAccountsKeeper *contractaccountskeeper.Keeper
+	PreciseBankKeeper      *precisebankkeeper.Keeper
 }
+
 type ModuleManager interface {
 	RunMigrations(ctx context.Context, cfg module.Configurator, fromVM module.VersionMap) (module.VersionMap, error)
 	GetVersionMap() module.VersionMap
@@ -41,4 +126,13 @@ type Upgrade struct {
 	// CreateUpgradeHandler defines the function that creates an upgrade handler
 	CreateUpgradeHandler func(ModuleManager, module.Configurator, *AppKeepers) upgradetypes.UpgradeHandler
 	StoreUpgrades        storetypes.StoreUpgrades
+
+	// Steps are the steps of the upgrades assembled with NewUpgrade, in the
+	// order the handler runs them. They are dry-run by DryRun.
+	Steps []Step
+
+	// MigratesConsensusParams is true if the handler moves the consensus params
+	// of a state predating SDK v0.47 to x/consensus, see MigrateConsensusParams.
+	// The nodes of such a state only start at the height of such an upgrade.
+	MigratesConsensusParams bool
 }
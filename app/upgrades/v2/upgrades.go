@@ -0,0 +1,102 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+
+	"github.com/rollchains/flora/app/upgrades"
+	cronprecompile "github.com/rollchains/flora/precompiles/cron"
+	contractaccountstypes "github.com/rollchains/flora/x/contractaccounts/types"
+	crontypes "github.com/rollchains/flora/x/cron/types"
+	denylisttypes "github.com/rollchains/flora/x/denylist/types"
+	deploypolicytypes "github.com/rollchains/flora/x/deploypolicy/types"
+	evmcircuittypes "github.com/rollchains/flora/x/evmcircuit/types"
+	inflationtypes "github.com/rollchains/flora/x/inflation/types"
+	lanestypes "github.com/rollchains/flora/x/lanes/types"
+	oracletypes "github.com/rollchains/flora/x/oracle/types"
+	poatypes "github.com/rollchains/flora/x/poa/types"
+	precisebanktypes "github.com/rollchains/flora/x/precisebank/types"
+	validatorpolicykeeper "github.com/rollchains/flora/x/validatorpolicy/keeper"
+	validatorpolicytypes "github.com/rollchains/flora/x/validatorpolicy/types"
+)
+
+// UpgradeName defines the on-chain upgrade name.
+const UpgradeName = "v2"
+
+// NewUpgrade constructor
+func NewUpgrade() upgrades.Upgrade {
+	return upgrades.NewUpgrade(UpgradeName,
+		// the chains skipping SDK v0.47 still have their consensus params in
+		// x/params, the upgrade runs in the PreBlocker with empty ones until
+		// they are migrated
+		upgrades.MigrateConsensusParams(),
+		upgrades.AddStores(
+			poatypes.StoreKey,
+			validatorpolicytypes.StoreKey,
+			crontypes.StoreKey,
+			inflationtypes.StoreKey,
+			lanestypes.StoreKey,
+			oracletypes.StoreKey,
+			evmcircuittypes.StoreKey,
+			deploypolicytypes.StoreKey,
+			denylisttypes.StoreKey,
+			contractaccountstypes.StoreKey,
+			precisebanktypes.StoreKey,
+		),
+		upgrades.RunMigrations(),
+		upgrades.Step{
+			Name: "bump commissions",
+			Run: func(ctx sdk.Context, env *upgrades.Env) ([]string, error) {
+				return BumpCommissions(ctx, env.Keepers.StakingKeeper, env.Keepers.ValidatorPolicyKeeper)
+			},
+		},
+		upgrades.EnablePrecompiles(cronprecompile.PrecompileAddress),
+	)
+}
+
+// BumpCommissions raises the commission of the validators below the commission
+// floor to the floor. Their max rate is raised too when it is below the floor.
+// It returns the bumped validators.
+func BumpCommissions(ctx context.Context, sk *stakingkeeper.Keeper, pk *validatorpolicykeeper.Keeper) ([]string, error) {
+	params, err := pk.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	validators, err := sk.GetAllValidators(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	var bumped []string
+	for _, validator := range validators {
+		if validator.Commission.Rate.GTE(params.MinCommissionRate) {
+			continue
+		}
+
+		valAddr, err := sk.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+		if err != nil {
+			return nil, err
+		}
+		if err := sk.Hooks().BeforeValidatorModified(ctx, valAddr); err != nil {
+			return nil, err
+		}
+
+		bumped = append(bumped, fmt.Sprintf("%s: %s -> %s", validator.GetOperator(), validator.Commission.Rate, params.MinCommissionRate))
+		validator.Commission.Rate = params.MinCommissionRate
+		if validator.Commission.MaxRate.LT(params.MinCommissionRate) {
+			validator.Commission.MaxRate = params.MinCommissionRate
+		}
+		validator.Commission.UpdateTime = blockTime
+
+		if err := sk.SetValidator(ctx, validator); err != nil {
+			return nil, err
+		}
+	}
+
+	return bumped, nil
+}
@@ -0,0 +1,360 @@
+package app
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/evm/utils"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/rollchains/flora/app/upgrades"
+	v2 "github.com/rollchains/flora/app/upgrades/v2"
+	cronprecompile "github.com/rollchains/flora/precompiles/cron"
+	precisebanktypes "github.com/rollchains/flora/x/precisebank/types"
+	validatorpolicytypes "github.com/rollchains/flora/x/validatorpolicy/types"
+)
+
+func TestV2BumpCommissions(t *testing.T) {
+	gapp := Setup(t)
+	ctx := gapp.BaseApp.NewContext(false)
+
+	validators, err := gapp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, validators)
+	for _, validator := range validators {
+		require.True(t, validator.Commission.Rate.LT(validatorpolicytypes.DefaultMinCommissionRate))
+	}
+
+	bumped, err := v2.BumpCommissions(ctx, gapp.StakingKeeper, &gapp.ValidatorPolicyKeeper)
+	require.NoError(t, err)
+	require.Len(t, bumped, len(validators))
+
+	validators, err = gapp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	for _, validator := range validators {
+		require.Equal(t, validatorpolicytypes.DefaultMinCommissionRate, validator.Commission.Rate)
+		require.True(t, validator.Commission.MaxRate.GTE(validator.Commission.Rate))
+	}
+}
+
+func TestV2MigrateConsensusParams(t *testing.T) {
+	gapp := Setup(t)
+	_, err := gapp.Commit()
+	require.NoError(t, err)
+
+	finalizeBlock := func() {
+		t.Helper()
+		_, err := gapp.FinalizeBlock(&abci.RequestFinalizeBlock{
+			Height: gapp.LastBlockHeight() + 1,
+			Hash:   gapp.LastCommitID().Hash,
+		})
+		require.NoError(t, err)
+		_, err = gapp.Commit()
+		require.NoError(t, err)
+	}
+
+	// a state predating SDK v0.47 has its consensus params in x/params, the v2
+	// upgrade is scheduled for the next block
+	finalizeBlock()
+	height := gapp.LastBlockHeight()
+	ctx := gapp.NewUncachedContext(false, cmtproto.Header{Height: height}).WithHeaderInfo(header.Info{Height: height})
+	cp, err := gapp.ConsensusParamsKeeper.ParamsStore.Get(ctx)
+	require.NoError(t, err)
+
+	legacy := gapp.GetSubspace(baseapp.Paramspace)
+	legacy.Set(ctx, baseapp.ParamStoreKeyBlockParams, *cp.Block)
+	legacy.Set(ctx, baseapp.ParamStoreKeyEvidenceParams, *cp.Evidence)
+	legacy.Set(ctx, baseapp.ParamStoreKeyValidatorParams, *cp.Validator)
+	require.NoError(t, gapp.ConsensusParamsKeeper.ParamsStore.Remove(ctx))
+
+	plan := upgradetypes.Plan{Name: v2.UpgradeName, Height: height + 1}
+	require.NoError(t, gapp.UpgradeKeeper.ScheduleUpgrade(ctx, plan))
+
+	// the node refuses to start the legacy state but at the upgrade height
+	require.ErrorContains(t, gapp.checkConsensusParams(ctx), "must be upgraded at the height of an upgrade migrating them (v2)")
+	require.NoError(t, gapp.UpgradeKeeper.DumpUpgradeInfoToDisk(plan.Height, plan))
+	require.NoError(t, gapp.checkConsensusParams(ctx))
+
+	// the upgrade migrates the consensus params in the pre-blocker
+	finalizeBlock()
+	ctx = gapp.NewUncachedContext(false, cmtproto.Header{Height: gapp.LastBlockHeight()})
+	migrated, err := gapp.ConsensusParamsKeeper.ParamsStore.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, cp.Block, migrated.Block)
+	require.Equal(t, cp.Evidence, migrated.Evidence)
+	require.Equal(t, cp.Validator, migrated.Validator)
+	doneHeight, err := gapp.UpgradeKeeper.GetDoneHeight(ctx, v2.UpgradeName)
+	require.NoError(t, err)
+	require.Equal(t, plan.Height, doneHeight)
+	require.NoError(t, gapp.checkConsensusParams(ctx))
+
+	// the blocks after the upgrade run with the migrated params
+	finalizeBlock()
+}
+
+// v1Home is the home of a node of the v1 binary halted at the height of the
+// v2 upgrade, written by the v1 binary with the validator and the account of
+// the upgrade fixture. Its state has its consensus params in x/params, as the
+// states predating SDK v0.47, none of the stores added by v2 and no bank
+// metadata for the denom.
+var v1Home = filepath.Join("testdata", "v1_home")
+
+func TestV2UpgradeV1State(t *testing.T) {
+	home := t.TempDir()
+	require.NoError(t, os.CopyFS(home, os.DirFS(v1Home)))
+	plan := upgradetypes.Plan{Name: v2.UpgradeName, Height: 5}
+
+	// the stores of v2 aren't in the state
+	db, err := dbm.NewDB("application", dbm.GoLevelDBBackend, filepath.Join(home, "data"))
+	require.NoError(t, err)
+	commitInfo, err := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics()).GetCommitInfo(plan.Height - 1)
+	require.NoError(t, err)
+	for _, store := range commitInfo.StoreInfos {
+		require.NotContains(t, v2.NewUpgrade().StoreUpgrades.Added, store.Name)
+	}
+	require.NoError(t, db.Close())
+
+	// the v2 binary starts at the upgrade height
+	_, account := upgradeFixtureKeys()
+	chain := &upgradeChain{
+		t:       t,
+		home:    home,
+		account: account,
+		time:    time.Date(2025, 1, 1, 0, 0, 20, 0, time.UTC),
+	}
+	chain.start(Upgrades)
+	t.Cleanup(chain.stop)
+	require.Equal(t, plan.Height-1, chain.app.LastBlockHeight())
+
+	ctx := chain.context()
+	keepers := chain.app.upgradeKeepers()
+	needed, err := upgrades.NeedsConsensusParamsMigration(ctx, &keepers)
+	require.NoError(t, err)
+	require.True(t, needed)
+	_, found := chain.app.BankKeeper.GetDenomMetaData(ctx, BaseDenom)
+	require.False(t, found)
+
+	chain.nextBlock()
+	ctx = chain.context()
+	doneHeight, err := chain.app.UpgradeKeeper.GetDoneHeight(ctx, plan.Name)
+	require.NoError(t, err)
+	require.Equal(t, plan.Height, doneHeight)
+
+	// the consensus params are migrated and the modules of v2 initialized
+	needed, err = upgrades.NeedsConsensusParamsMigration(ctx, &keepers)
+	require.NoError(t, err)
+	require.False(t, needed)
+	policy, err := chain.app.ValidatorPolicyKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	validators, err := chain.app.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.Len(t, validators, 1)
+	require.Equal(t, policy.MinCommissionRate, validators[0].Commission.Rate)
+	require.Contains(t, chain.app.EVMKeeper.GetParams(ctx).ActiveStaticPrecompiles, cronprecompile.PrecompileAddress)
+
+	for i := 0; i < 3; i++ {
+		chain.nextBlock()
+	}
+	require.Empty(t, chain.app.brokenInvariants(chain.context()))
+
+	// the node restarts on the upgraded state
+	chain.stop()
+	chain.start(Upgrades)
+	chain.nextBlock()
+	require.Equal(t, plan.Height+4, chain.app.LastBlockHeight())
+}
+
+func TestUpgradeKeepers(t *testing.T) {
+	gapp := Setup(t)
+	keepers := reflect.ValueOf(gapp.upgradeKeepers())
+
+	// every keeper of the app is given to the upgrade handlers
+	appValue := reflect.ValueOf(gapp).Elem()
+	for i := 0; i < appValue.NumField(); i++ {
+		field := appValue.Type().Field(i)
+		if !field.IsExported() || !strings.HasSuffix(field.Name, "Keeper") {
+			continue
+		}
+		keeper := keepers.FieldByName(field.Name)
+		require.True(t, keeper.IsValid(), "%s is missing from the upgrade keepers", field.Name)
+		require.False(t, keeper.IsNil(), field.Name)
+	}
+}
+
+func TestUpgradeSteps(t *testing.T) {
+	gapp := Setup(t)
+	ctx := gapp.BaseApp.NewContext(false)
+	keepers := gapp.upgradeKeepers()
+
+	dryRun := func(steps ...upgrades.Step) []upgrades.Report {
+		t.Helper()
+		upgrade := upgrades.NewUpgrade("test", append(steps, upgrades.RunMigrations())...)
+		reports, err := upgrade.DryRun(ctx, gapp.ModuleManager, gapp.configurator, &keepers, gapp.ModuleManager.GetVersionMap())
+		require.NoError(t, err)
+		require.Len(t, reports, len(steps)+1)
+		return reports[:len(steps)]
+	}
+
+	t.Run("new upgrade", func(t *testing.T) {
+		upgrade := upgrades.NewUpgrade("test",
+			upgrades.MigrateConsensusParams(),
+			upgrades.AddStores("a", "b"),
+			upgrades.RunMigrations(),
+			upgrades.DeleteStores("c"),
+		)
+		require.Equal(t, []string{"a", "b"}, upgrade.StoreUpgrades.Added)
+		require.Equal(t, []string{"c"}, upgrade.StoreUpgrades.Deleted)
+		require.True(t, upgrade.MigratesConsensusParams)
+
+		require.Panics(t, func() { upgrades.NewUpgrade("test", upgrades.AddStores("a")) })
+		require.Panics(t, func() { upgrades.NewUpgrade("test", upgrades.RunMigrations(), upgrades.RunMigrations()) })
+	})
+
+	t.Run("run migrations", func(t *testing.T) {
+		fromVM := gapp.ModuleManager.GetVersionMap()
+		delete(fromVM, precisebanktypes.ModuleName)
+
+		upgrade := upgrades.NewUpgrade("test", upgrades.RunMigrations())
+		reports, err := upgrade.DryRun(ctx, gapp.ModuleManager, gapp.configurator, &keepers, fromVM)
+		require.NoError(t, err)
+		require.Equal(t, []upgrades.Report{{
+			Step:    "run migrations",
+			Changes: []string{"added precisebank at version 1"},
+		}}, reports)
+	})
+
+	t.Run("migrate consensus params", func(t *testing.T) {
+		require.Equal(t, []upgrades.Report{{Step: "migrate consensus params"}}, dryRun(upgrades.MigrateConsensusParams()))
+	})
+
+	t.Run("register token pairs", func(t *testing.T) {
+		denom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+		address, err := utils.GetIBCDenomAddress(denom)
+		require.NoError(t, err)
+
+		step := upgrades.RegisterTokenPairs(denom)
+		reports := dryRun(step)
+		require.Equal(t, []string{"registered " + denom + " at " + address.Hex()}, reports[0].Changes)
+		require.False(t, gapp.Erc20Keeper.IsDenomRegistered(ctx, denom))
+
+		_, err = step.Run(ctx, &upgrades.Env{Keepers: &keepers})
+		require.NoError(t, err)
+		require.True(t, gapp.Erc20Keeper.IsDenomRegistered(ctx, denom))
+		require.Contains(t, gapp.Erc20Keeper.GetParams(ctx).DynamicPrecompiles, address.Hex())
+		require.Empty(t, dryRun(step)[0].Changes)
+
+		_, err = upgrades.RegisterTokenPairs("uflora").Run(ctx, &upgrades.Env{Keepers: &keepers})
+		require.Error(t, err)
+	})
+
+	t.Run("enable precompiles", func(t *testing.T) {
+		params := gapp.EVMKeeper.GetParams(ctx)
+		params.ActiveStaticPrecompiles = slices.DeleteFunc(slices.Clone(params.ActiveStaticPrecompiles), func(address string) bool {
+			return address == cronprecompile.PrecompileAddress
+		})
+		require.NoError(t, gapp.EVMKeeper.SetParams(ctx, params))
+
+		step := upgrades.EnablePrecompiles(cronprecompile.PrecompileAddress, evmtypes.StakingPrecompileAddress)
+		require.Equal(t, []string{"enabled " + cronprecompile.PrecompileAddress}, dryRun(step)[0].Changes)
+		require.NotContains(t, gapp.EVMKeeper.GetParams(ctx).ActiveStaticPrecompiles, cronprecompile.PrecompileAddress)
+
+		_, err := step.Run(ctx, &upgrades.Env{Keepers: &keepers})
+		require.NoError(t, err)
+		require.Contains(t, gapp.EVMKeeper.GetParams(ctx).ActiveStaticPrecompiles, cronprecompile.PrecompileAddress)
+		require.Empty(t, dryRun(step)[0].Changes)
+
+		_, err = upgrades.EnablePrecompiles("cron").Run(ctx, &upgrades.Env{Keepers: &keepers})
+		require.ErrorContains(t, err, "invalid precompile address")
+	})
+
+	t.Run("set feemarket params", func(t *testing.T) {
+		before := gapp.FeeMarketKeeper.GetParams(ctx)
+		step := upgrades.SetFeeMarketParams(func(params *feemarkettypes.Params) {
+			params.BaseFeeChangeDenominator = 16
+			params.MinGasPrice = math.LegacyNewDec(7)
+		})
+		require.Equal(t, []string{
+			fmt.Sprintf("base_fee_change_denominator: %d -> 16", before.BaseFeeChangeDenominator),
+			fmt.Sprintf("min_gas_price: %s -> %s", before.MinGasPrice, math.LegacyNewDec(7)),
+		}, dryRun(step)[0].Changes)
+		require.Equal(t, before, gapp.FeeMarketKeeper.GetParams(ctx))
+
+		_, err := step.Run(ctx, &upgrades.Env{Keepers: &keepers})
+		require.NoError(t, err)
+		require.Equal(t, uint32(16), gapp.FeeMarketKeeper.GetParams(ctx).BaseFeeChangeDenominator)
+		require.Empty(t, dryRun(step)[0].Changes)
+
+		_, err = upgrades.SetFeeMarketParams(func(params *feemarkettypes.Params) {
+			params.BaseFeeChangeDenominator = 0
+		}).Run(ctx, &upgrades.Env{Keepers: &keepers})
+		require.Error(t, err)
+	})
+
+	t.Run("v2", func(t *testing.T) {
+		reports, err := v2.NewUpgrade().DryRun(ctx, gapp.ModuleManager, gapp.configurator, &keepers, gapp.ModuleManager.GetVersionMap())
+		require.NoError(t, err)
+		require.Len(t, reports, 5)
+		require.Equal(t, "bump commissions", reports[3].Step)
+		require.NotEmpty(t, reports[3].Changes)
+
+		// the dry run leaves the commissions as they are
+		validators, err := gapp.StakingKeeper.GetAllValidators(ctx)
+		require.NoError(t, err)
+		for _, validator := range validators {
+			require.True(t, validator.Commission.Rate.LT(validatorpolicytypes.DefaultMinCommissionRate))
+		}
+	})
+}
+
+func TestSimulateUpgrade(t *testing.T) {
+	gapp := Setup(t)
+	_, err := gapp.Commit()
+	require.NoError(t, err)
+	height := gapp.LastBlockHeight()
+
+	_, err = gapp.SimulateUpgrade("unknown", 2)
+	require.ErrorContains(t, err, "unknown upgrade unknown")
+
+	simulation, err := gapp.SimulateUpgrade(v2.UpgradeName, 2)
+	require.NoError(t, err)
+	require.Equal(t, height+1, simulation.Height)
+	require.Equal(t, height+3, gapp.LastBlockHeight())
+	require.Empty(t, simulation.BrokenInvariants)
+
+	// the upgrade bumps the commissions and marks itself done
+	stores := make(map[string]StoreDiff)
+	for _, diff := range simulation.Diffs {
+		require.False(t, diff.Empty())
+		stores[diff.Store] = diff
+	}
+	require.Contains(t, stores, "staking")
+	require.Contains(t, stores, "upgrade")
+
+	ctx := gapp.NewUncachedContext(false, cmtproto.Header{Height: gapp.LastBlockHeight()})
+	doneHeight, err := gapp.UpgradeKeeper.GetDoneHeight(ctx, v2.UpgradeName)
+	require.NoError(t, err)
+	require.Equal(t, simulation.Height, doneHeight)
+
+	_, err = gapp.SimulateUpgrade(v2.UpgradeName, 1)
+	require.ErrorContains(t, err, "was applied at height")
+}
@@ -0,0 +1,163 @@
+package eip712
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+const flagLegacy = "legacy"
+
+// GetTypedDataCmd returns the command printing the EIP-712 typed data of an
+// unsigned tx, for a browser wallet to sign it with eth_signTypedData_v4.
+func GetTypedDataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "eip712-typed-data [file]",
+		Short: "Print the EIP-712 typed data of an unsigned tx for a browser wallet to sign",
+		Long: `Print the EIP-712 typed data of an unsigned tx generated with --generate-only.
+The account number and sequence of the --from account are queried unless --offline is set.
+As the key is in the wallet, --from may be an address when --generate-only is set.
+
+By default the typed data of the amino JSON sign doc is printed, its signature is set as a
+SIGN_MODE_LEGACY_AMINO_JSON signature of the tx. With --legacy the typed data of the Web3Tx
+flow is printed, its signature is set in the ExtensionOptionsWeb3Tx of the tx.
+
+The signature of the wallet is attached to the tx with eip712-attach-signature.`,
+		Example: fmt.Sprintf("%s tx eip712-typed-data tx.json --from flora1... --generate-only --offline --account-number 1 --sequence 0 --chain-id localchain_9000-1", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, _, typedData, err := readTypedData(cmd, clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(typedData, "", "  ")
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintString(string(bz) + "\n")
+		},
+	}
+
+	cmd.Flags().Bool(flagLegacy, false, "Print the typed data of the legacy Web3Tx flow")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetAttachSignatureCmd returns the command setting the EIP-712 signature of
+// a browser wallet on an unsigned tx.
+func GetAttachSignatureCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "eip712-attach-signature [file] [signature]",
+		Short: "Attach the EIP-712 signature of a browser wallet to an unsigned tx",
+		Long: `Attach the hex signature returned by eth_signTypedData_v4 for the typed data printed
+by eip712-typed-data to the unsigned tx, and print the signed tx.
+The --from, --chain-id, --account-number, --sequence and --legacy flags must be the ones
+the typed data was printed with. The public key of the signer is recovered from the
+signature, which must be the one of the --from account.`,
+		Example: fmt.Sprintf("%s tx eip712-attach-signature tx.json 0x... --from flora1... --generate-only --offline --account-number 1 --sequence 0 --chain-id localchain_9000-1 > signed.json", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sig, err := hexutil.Decode(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid signature: %w", err)
+			}
+
+			stdTx, signerData, typedData, err := readTypedData(cmd, clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			hash, err := Hash(typedData)
+			if err != nil {
+				return err
+			}
+			pubKey, err := RecoverPubKey(hash, sig)
+			if err != nil {
+				return err
+			}
+			if signer := sdk.AccAddress(pubKey.Address()); !signer.Equals(clientCtx.FromAddress) {
+				return fmt.Errorf("the signature is of %s, not of %s", signer, clientCtx.FromAddress)
+			}
+
+			builder, err := clientCtx.TxConfig.WrapTxBuilder(stdTx)
+			if err != nil {
+				return err
+			}
+			if legacy, _ := cmd.Flags().GetBool(flagLegacy); legacy {
+				err = SetLegacySignature(builder, signerData.ChainID, pubKey, signerData.Sequence, sig)
+			} else {
+				err = SetSignature(builder, pubKey, signerData.Sequence, sig)
+			}
+			if err != nil {
+				return err
+			}
+
+			bz, err := clientCtx.TxConfig.TxJSONEncoder()(builder.GetTx())
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintString(string(bz) + "\n")
+		},
+	}
+
+	cmd.Flags().Bool(flagLegacy, false, "Attach the signature of the legacy Web3Tx flow")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// readTypedData reads an unsigned tx and returns the EIP-712 typed data the
+// --from account signs for it, in the flow set by --legacy.
+func readTypedData(cmd *cobra.Command, clientCtx client.Context, file string) (sdk.Tx, authsigning.SignerData, apitypes.TypedData, error) {
+	stdTx, err := authclient.ReadTxFromFile(clientCtx, file)
+	if err != nil {
+		return nil, authsigning.SignerData{}, apitypes.TypedData{}, err
+	}
+	sigTx, ok := stdTx.(authsigning.Tx)
+	if !ok {
+		return nil, authsigning.SignerData{}, apitypes.TypedData{}, fmt.Errorf("unexpected tx type %T", stdTx)
+	}
+
+	txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+	if err != nil {
+		return nil, authsigning.SignerData{}, apitypes.TypedData{}, err
+	}
+	if txf, err = txf.Prepare(clientCtx); err != nil {
+		return nil, authsigning.SignerData{}, apitypes.TypedData{}, err
+	}
+
+	signerData := authsigning.SignerData{
+		Address:       clientCtx.FromAddress.String(),
+		ChainID:       txf.ChainID(),
+		AccountNumber: txf.AccountNumber(),
+		Sequence:      txf.Sequence(),
+	}
+
+	var typedData apitypes.TypedData
+	if legacy, _ := cmd.Flags().GetBool(flagLegacy); legacy {
+		typedData, err = LegacyTypedData(clientCtx.InterfaceRegistry, signerData, sigTx, clientCtx.FromAddress)
+	} else {
+		typedData, err = TypedData(cmd.Context(), clientCtx.TxConfig.SignModeHandler(), signerData, sigTx)
+	}
+	return stdTx, signerData, typedData, err
+}
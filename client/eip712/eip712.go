@@ -0,0 +1,136 @@
+// Package eip712 builds the EIP-712 typed data of Cosmos transactions, for
+// browser wallets such as MetaMask to sign them with eth_signTypedData_v4.
+//
+// Two flows are supported:
+//   - typed: the wallet signs the typed data of the amino JSON sign doc and the
+//     signature is set as a SIGN_MODE_LEGACY_AMINO_JSON signature of the tx. The
+//     ethsecp256k1 public key verifies it against the typed data.
+//   - legacy: the wallet signs the typed data of the Web3Tx flow and the
+//     signature is set in the ExtensionOptionsWeb3Tx of the tx, while the
+//     Cosmos signature stays empty.
+package eip712
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/ethereum/eip712"
+	evmostypes "github.com/cosmos/evm/types"
+)
+
+// TypedData returns the EIP-712 typed data of the amino JSON sign doc of the
+// tx, signed in the typed flow.
+func TypedData(ctx context.Context, handlerMap *txsigning.HandlerMap, signerData authsigning.SignerData, tx sdk.Tx) (apitypes.TypedData, error) {
+	signBytes, err := authsigning.GetSignBytesAdapter(ctx, handlerMap, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signerData, tx)
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+	return eip712.GetEIP712TypedDataForMsg(signBytes)
+}
+
+// LegacyTypedData returns the EIP-712 typed data of the tx signed in the legacy
+// Web3Tx flow. The messages of the tx must be of a single type, as the typed
+// data has a single message schema.
+func LegacyTypedData(cdc codectypes.AnyUnpacker, signerData authsigning.SignerData, tx authsigning.Tx, feePayer sdk.AccAddress) (apitypes.TypedData, error) {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return apitypes.TypedData{}, fmt.Errorf("tx has no messages")
+	}
+	for _, msg := range msgs[1:] {
+		if sdk.MsgTypeURL(msg) != sdk.MsgTypeURL(msgs[0]) {
+			return apitypes.TypedData{}, fmt.Errorf("legacy EIP-712 txs must have messages of a single type, got %s and %s", sdk.MsgTypeURL(msgs[0]), sdk.MsgTypeURL(msg))
+		}
+	}
+
+	chainID, err := evmostypes.ParseChainID(signerData.ChainID)
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+
+	signBytes := legacytx.StdSignBytes(
+		signerData.ChainID,
+		signerData.AccountNumber,
+		signerData.Sequence,
+		tx.GetTimeoutHeight(),
+		legacytx.StdFee{Amount: tx.GetFee(), Gas: tx.GetGas()},
+		msgs,
+		tx.GetMemo(),
+	)
+	return eip712.LegacyWrapTxToTypedData(cdc, chainID.Uint64(), msgs[0], signBytes, &eip712.FeeDelegationOptions{FeePayer: feePayer})
+}
+
+// Hash returns the hash of the typed data signed by the wallets.
+func Hash(typedData apitypes.TypedData) ([]byte, error) {
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	return hash, err
+}
+
+// RecoverPubKey returns the public key of the wallet signature of a typed data
+// hash, in the [R || S || V] format with V possibly offset by 27.
+func RecoverPubKey(hash, sig []byte) (cryptotypes.PubKey, error) {
+	if len(sig) != ethcrypto.SignatureLength {
+		return nil, fmt.Errorf("expected a %d bytes signature, got %d", ethcrypto.SignatureLength, len(sig))
+	}
+
+	rsv := slices.Clone(sig)
+	if rsv[ethcrypto.RecoveryIDOffset] >= 27 {
+		rsv[ethcrypto.RecoveryIDOffset] -= 27
+	}
+	pubKey, err := ethcrypto.SigToPub(hash, rsv)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature: %w", err)
+	}
+	return &ethsecp256k1.PubKey{Key: ethcrypto.CompressPubkey(pubKey)}, nil
+}
+
+// SetSignature sets the signature of the typed flow on the tx.
+func SetSignature(builder client.TxBuilder, pubKey cryptotypes.PubKey, sequence uint64, sig []byte) error {
+	return builder.SetSignatures(signing.SignatureV2{
+		PubKey: pubKey,
+		Data: &signing.SingleSignatureData{
+			SignMode:  signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+			Signature: sig,
+		},
+		Sequence: sequence,
+	})
+}
+
+// SetLegacySignature sets the signature of the legacy Web3Tx flow on the tx,
+// leaving the Cosmos signature empty.
+func SetLegacySignature(builder client.TxBuilder, chainID string, pubKey cryptotypes.PubKey, sequence uint64, sig []byte) error {
+	extBuilder, ok := builder.(authtx.ExtensionOptionsTxBuilder)
+	if !ok {
+		return fmt.Errorf("tx builder %T does not support extension options", builder)
+	}
+
+	id, err := evmostypes.ParseChainID(chainID)
+	if err != nil {
+		return err
+	}
+	ext, err := codectypes.NewAnyWithValue(&evmostypes.ExtensionOptionsWeb3Tx{
+		TypedDataChainID: id.Uint64(),
+		FeePayer:         sdk.AccAddress(pubKey.Address()).String(),
+		FeePayerSig:      sig,
+	})
+	if err != nil {
+		return err
+	}
+	extBuilder.SetExtensionOptions(ext)
+
+	return SetSignature(builder, pubKey, sequence, nil)
+}
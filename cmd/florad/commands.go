@@ -2,12 +2,18 @@ package main
 
 import (
 	"errors"
+	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 
 	cmtcfg "github.com/cometbft/cometbft/config"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/rollchains/flora/app"
+	appmempool "github.com/rollchains/flora/app/mempool"
+	eip712cli "github.com/rollchains/flora/client/eip712"
+	"github.com/rollchains/flora/x/oracle"
+	poacli "github.com/rollchains/flora/x/poa/client/cli"
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -17,7 +23,6 @@ import (
 
 	cmtcli "github.com/cometbft/cometbft/libs/cli"
 	"github.com/cosmos/cosmos-sdk/client"
-	"github.com/cosmos/cosmos-sdk/client/debug"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/pruning"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
@@ -53,6 +58,10 @@ func initCometBFTConfig() *cmtcfg.Config {
 type CustomAppConfig struct {
 	serverconfig.Config
 
+	PriorityMempool appmempool.Config
+
+	Oracle oracle.Config
+
 	EVM     evmosserverconfig.EVMConfig
 	JSONRPC evmosserverconfig.JSONRPCConfig
 	TLS     evmosserverconfig.TLSConfig
@@ -81,17 +90,26 @@ func initAppConfig() (string, interface{}) {
 	srvCfg.MinGasPrices = "0stake"
 	// srvCfg.BaseConfig.IAVLDisableFastNode = true // disable fastnode by default
 
+	// enable the priority nonce mempool, its settings extend the [mempool] section
+	srvCfg.Mempool.MaxTxs = appmempool.DefaultMaxTxs
+
 	customAppConfig := CustomAppConfig{
-		Config:  *srvCfg,
-		EVM:     *evmosserverconfig.DefaultEVMConfig(),
-		JSONRPC: *evmosserverconfig.DefaultJSONRPCConfig(),
-		TLS:     *evmosserverconfig.DefaultTLSConfig(),
+		Config:          *srvCfg,
+		PriorityMempool: appmempool.DefaultConfig(),
+		Oracle:          oracle.DefaultConfig(),
+		EVM:             *evmosserverconfig.DefaultEVMConfig(),
+		JSONRPC:         *evmosserverconfig.DefaultJSONRPCConfig(),
+		TLS:             *evmosserverconfig.DefaultTLSConfig(),
 	}
 
 	customAppTemplate := serverconfig.DefaultConfigTemplate
 
+	customAppTemplate += appmempool.DefaultConfigTemplate
+
 	customAppTemplate += evmosserverconfig.DefaultEVMConfigTemplate
 
+	customAppTemplate += oracle.DefaultConfigTemplate
+
 	return customAppTemplate, customAppConfig
 }
 
@@ -104,9 +122,17 @@ func initRootCmd(
 
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(chainApp.BasicModuleManager, app.DefaultNodeHome),
-		genutilcli.Commands(chainApp.TxConfig(), chainApp.BasicModuleManager, app.DefaultNodeHome),
+		genesisCommand(
+			chainApp.TxConfig(), chainApp.BasicModuleManager,
+			poacli.GetGenesisCmd(),
+			bankGenesisCmd(),
+			evmGenesisCmd(),
+			erc20GenesisCmd(),
+			tokenfactoryGenesisCmd(),
+			feemarketGenesisCmd(),
+		),
 		cmtcli.NewCompletionCmd(rootCmd, true),
-		debug.Cmd(),
+		debugCmd(),
 		confixcmd.ConfigCommand(),
 		pruning.Cmd(newApp, app.DefaultNodeHome),
 		snapshot.Cmd(newApp),
@@ -119,6 +145,11 @@ func initRootCmd(
 		appExport,
 		addModuleInitFlags,
 	)
+	for _, cmd := range rootCmd.Commands() {
+		if cmd.Name() == "export" {
+			addExportFlags(cmd)
+		}
+	}
 
 	// add EVM key commands
 	rootCmd.AddCommand(
@@ -131,6 +162,8 @@ func initRootCmd(
 
 		queryCommand(),
 		txCommand(),
+		upgradeCommand(),
+		inPlaceTestnetCmd(),
 	)
 
 	var err error
@@ -146,10 +179,31 @@ func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
 }
 
+const (
+	flagStreamDir       = "stream-dir"
+	flagStreamFormat    = "stream-format"
+	flagStreamChunkSize = "stream-chunk-size"
+)
+
+// addExportFlags adds the flags of the streamed export, see appExport.
+func addExportFlags(exportCmd *cobra.Command) {
+	exportCmd.Flags().String(flagStreamDir, "", "Stream the module state to this directory instead of the genesis file, the genesis references it relative to the output document. Only the EVM contracts are streamed record by record, the state of the other modules is marshalled in memory one module at a time")
+	exportCmd.Flags().String(flagStreamFormat, app.StreamFormatNDJSON, fmt.Sprintf("Format of the streamed EVM state (%s|%s)", app.StreamFormatNDJSON, app.StreamFormatChunked))
+	exportCmd.Flags().Int(flagStreamChunkSize, app.DefaultStreamChunkSize, "Number of records per file of the chunked format")
+}
+
 // genesisCommand builds genesis-related `simd genesis` command. Users may provide application specific commands as a parameter
 func genesisCommand(txConfig client.TxConfig, basicManager module.BasicManager, cmds ...*cobra.Command) *cobra.Command {
 	cmd := genutilcli.Commands(txConfig, basicManager, app.DefaultNodeHome)
 
+	// the validation of the streamed genesis replaces the one of genutil
+	for _, subCmd := range cmd.Commands() {
+		if subCmd.Name() == "validate" {
+			cmd.RemoveCommand(subCmd)
+		}
+	}
+	cmd.AddCommand(validateGenesisCmd(basicManager))
+
 	for _, subCmd := range cmds {
 		cmd.AddCommand(subCmd)
 	}
@@ -199,6 +253,8 @@ func txCommand() *cobra.Command {
 		authcmd.GetEncodeCommand(),
 		authcmd.GetDecodeCommand(),
 		authcmd.GetSimulateCmd(),
+		eip712cli.GetTypedDataCmd(),
+		eip712cli.GetAttachSignatureCmd(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
@@ -221,7 +277,7 @@ func newApp(
 	return app.NewChainApp(
 		logger, db, traceStore, true,
 		appOpts,
-		app.EVMAppOptions,
+		app.NewEVMAppOptions(cast.ToString(appOpts.Get(flags.FlagHome))),
 		baseappOptions...,
 	)
 }
@@ -259,7 +315,7 @@ func appExport(
 		traceStore,
 		height == -1,
 		appOpts,
-		app.EVMAppOptions,
+		app.NewEVMAppOptions(homePath),
 	)
 
 	if height != -1 {
@@ -268,6 +324,19 @@ func appExport(
 		}
 	}
 
+	if streamDir := cast.ToString(appOpts.Get(flagStreamDir)); streamDir != "" {
+		// the genesis references the directory relative to the genesis file
+		genesisDir := "."
+		if outputDocument := cast.ToString(appOpts.Get(flags.FlagOutputDocument)); outputDocument != "" {
+			genesisDir = filepath.Dir(outputDocument)
+		}
+		return chainApp.ExportAppStateToDir(
+			streamDir, genesisDir,
+			cast.ToString(appOpts.Get(flagStreamFormat)),
+			cast.ToInt(appOpts.Get(flagStreamChunkSize)),
+			forZeroHeight, jailAllowedAddrs, modulesToExport,
+		)
+	}
 	return chainApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, modulesToExport)
 }
 
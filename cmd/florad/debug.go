@@ -0,0 +1,175 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/rootmulti"
+
+	"github.com/cosmos/cosmos-sdk/client/debug"
+	"github.com/cosmos/cosmos-sdk/server"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/rollchains/flora/app"
+)
+
+const (
+	flagFrom   = "from"
+	flagTo     = "to"
+	flagStores = "stores"
+)
+
+// debugCmd adds the commands inspecting the application state to the debug
+// commands of the SDK.
+func debugCmd() *cobra.Command {
+	cmd := debug.Cmd()
+	cmd.AddCommand(stateDiffCmd())
+	return cmd
+}
+
+// stateDiffCmd prints the keys of the module stores that differ between two
+// committed heights of the application state of the node home.
+func stateDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state-diff",
+		Short: "Print the changes of the application state between two heights",
+		Long: `Print the changes of the module stores between two committed heights of the
+application state of the node home, the last block of the state when no height is set.
+
+The keys are decoded per module: the EVM storage slots per contract, the bank balances
+per denom and the keys of the other modules by their store decoders. The keys nothing
+decodes are printed in hex. Both heights must be kept by the pruning of the node.
+
+The module stores are loaded from the application database, nothing is written to it.
+A goleveldb database is opened read-only, the node must be stopped for the other backends.`,
+		Example: fmt.Sprintf("%s debug state-diff --from 100 --to 101 --stores bank,evm", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			home := serverCtx.Config.RootDir
+
+			db, err := openReadOnlyDB(server.GetAppDBBackend(serverCtx.Viper), filepath.Join(home, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			latest := rootmulti.GetLatestVersion(db)
+			if latest == 0 {
+				return fmt.Errorf("no application state in %s", home)
+			}
+
+			from, err := cmd.Flags().GetInt64(flagFrom)
+			if err != nil {
+				return err
+			}
+			to, err := cmd.Flags().GetInt64(flagTo)
+			if err != nil {
+				return err
+			}
+			if to == 0 {
+				to = latest
+			}
+			if from == 0 {
+				from = to - 1
+			}
+			if from < 1 || from >= to || to > latest {
+				return fmt.Errorf("invalid heights from %d to %d, the state is at height %d", from, to, latest)
+			}
+
+			stores, err := cmd.Flags().GetStringSlice(flagStores)
+			if err != nil {
+				return err
+			}
+
+			rs, keys, err := app.LoadMultiStore(db, to)
+			if err != nil {
+				return err
+			}
+			walk := func(from, to int64, stores []string, fn func(string, app.KVDiff) error) error {
+				return app.WalkMultiStoreDiffs(rs, keys, from, to, stores, fn)
+			}
+
+			// the app only decodes the keys, it runs on an empty database
+			decoderApp := app.NewChainApp(
+				log.NewNopLogger(), dbm.NewMemDB(), nil, false, simtestutil.NewAppOptionsWithFlagHome(tempDir()),
+				app.NoOpEVMOptions,
+			)
+			return printStateDiff(cmd.OutOrStdout(), walk, decoderApp.DecodeStoreDiff, from, to, stores)
+		},
+	}
+
+	cmd.Flags().Int64(flagFrom, 0, "Height compared from, the height before --to when not set")
+	cmd.Flags().Int64(flagTo, 0, "Height compared to, the last height of the state when not set")
+	cmd.Flags().StringSlice(flagStores, nil, "Module stores to compare, all the stores when not set")
+
+	return cmd
+}
+
+// openReadOnlyDB opens the application database of the data directory,
+// read-only for the goleveldb backend.
+func openReadOnlyDB(backend dbm.BackendType, dataDir string) (dbm.DB, error) {
+	if backend == dbm.GoLevelDBBackend {
+		return dbm.NewGoLevelDBWithOpts("application", dataDir, &opt.Options{ReadOnly: true})
+	}
+	return dbm.NewDB("application", backend, dataDir)
+}
+
+// storeDiffWalker walks the keys of the module stores that differ between two
+// heights, as ChainApp.WalkStoreDiffs.
+type storeDiffWalker func(from, to int64, stores []string, fn func(store string, diff app.KVDiff) error) error
+
+// printStateDiff prints the decoded keys per module store, the keys of a store
+// are printed once it is walked, after its counts.
+func printStateDiff(out io.Writer, walk storeDiffWalker, decode func(store string, diff app.KVDiff) string, from, to int64, stores []string) error {
+	fmt.Fprintf(out, "state diff from height %d to %d:\n", from, to)
+
+	var (
+		current string
+		counts  app.StoreDiff
+		lines   []string
+	)
+	flush := func() {
+		if current == "" {
+			return
+		}
+		fmt.Fprintf(out, "\n%s: %d added, %d changed, %d deleted\n", current, counts.Added, counts.Changed, counts.Deleted)
+		for _, line := range lines {
+			fmt.Fprintf(out, "  %s\n", line)
+		}
+	}
+
+	err := walk(from, to, stores, func(store string, diff app.KVDiff) error {
+		if store != current {
+			flush()
+			current, counts, lines = store, app.StoreDiff{Store: store}, nil
+		}
+
+		switch {
+		case diff.Added():
+			counts.Added++
+		case diff.Deleted():
+			counts.Deleted++
+		default:
+			counts.Changed++
+		}
+		lines = append(lines, decode(store, diff))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	flush()
+
+	if current == "" {
+		fmt.Fprintln(out, "no changes")
+	}
+	return nil
+}
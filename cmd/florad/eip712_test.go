@@ -0,0 +1,105 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	evmostypes "github.com/cosmos/evm/types"
+
+	"github.com/rollchains/flora/app"
+	"github.com/rollchains/flora/client/eip712"
+)
+
+func TestEIP712AttachSignature(t *testing.T) {
+	home := t.TempDir()
+	key, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := sdk.AccAddress(key.PubKey().Address()).String()
+	to := sdk.AccAddress(ethsecp256k1.PrivKey{Key: ethcrypto.Keccak256([]byte("to"))}.PubKey().Address()).String()
+	signerFlags := []string{"--from", from, "--generate-only", "--offline", "--chain-id", app.ChainID, "--account-number", "1", "--sequence", "2"}
+
+	unsigned, err := executeOutput(t, home, "tx", "bank", "send", from, to, "1"+app.BaseDenom, "--generate-only", "--fees", "1"+app.BaseDenom)
+	require.NoError(t, err)
+	txFile := filepath.Join(t.TempDir(), "tx.json")
+	require.NoError(t, os.WriteFile(txFile, []byte(unsigned), 0o600))
+
+	// signs the typed data as a wallet
+	sign := func(legacyFlags ...string) string {
+		out, err := executeOutput(t, home, append(append([]string{"tx", "eip712-typed-data", txFile}, signerFlags...), legacyFlags...)...)
+		require.NoError(t, err)
+		var typedData apitypes.TypedData
+		require.NoError(t, json.Unmarshal([]byte(out), &typedData))
+		hash, err := eip712.Hash(typedData)
+		require.NoError(t, err)
+
+		privKey, err := key.ToECDSA()
+		require.NoError(t, err)
+		sig, err := ethcrypto.Sign(hash, privKey)
+		require.NoError(t, err)
+		sig[ethcrypto.RecoveryIDOffset] += 27
+		return hexutil.Encode(sig)
+	}
+	attach := func(sig string, legacyFlags ...string) (authsigning.Tx, error) {
+		out, err := executeOutput(t, home, append(append([]string{"tx", "eip712-attach-signature", txFile, sig}, signerFlags...), legacyFlags...)...)
+		if err != nil {
+			return nil, err
+		}
+		tx, err := app.MakeEncodingConfig(t).TxConfig.TxJSONDecoder()([]byte(out))
+		require.NoError(t, err)
+		return tx.(authsigning.Tx), nil
+	}
+
+	t.Run("typed", func(t *testing.T) {
+		sig := sign()
+		tx, err := attach(sig)
+		require.NoError(t, err)
+
+		sigs, err := tx.GetSignaturesV2()
+		require.NoError(t, err)
+		require.Len(t, sigs, 1)
+		require.Equal(t, key.PubKey(), sigs[0].PubKey)
+		require.Equal(t, uint64(2), sigs[0].Sequence)
+		require.Equal(t, &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, Signature: hexutil.MustDecode(sig)}, sigs[0].Data)
+
+		// the signature of the typed data of another sequence is of another key
+		_, err = executeOutput(t, home, append([]string{"tx", "eip712-attach-signature", txFile, sig, "--sequence", "3"}, signerFlags[:len(signerFlags)-2]...)...)
+		require.ErrorContains(t, err, "the signature is of")
+		_, err = attach("0x01")
+		require.ErrorContains(t, err, "expected a 65 bytes signature")
+	})
+
+	t.Run("legacy", func(t *testing.T) {
+		sig := sign("--legacy")
+		tx, err := attach(sig, "--legacy")
+		require.NoError(t, err)
+
+		sigs, err := tx.GetSignaturesV2()
+		require.NoError(t, err)
+		require.Len(t, sigs, 1)
+		require.Equal(t, key.PubKey(), sigs[0].PubKey)
+		require.Empty(t, sigs[0].Data.(*signing.SingleSignatureData).Signature)
+
+		opts := tx.(ante.HasExtensionOptionsTx).GetExtensionOptions()
+		require.Len(t, opts, 1)
+		ext, ok := opts[0].GetCachedValue().(*evmostypes.ExtensionOptionsWeb3Tx)
+		require.True(t, ok)
+		require.Equal(t, from, ext.FeePayer)
+		require.Equal(t, hexutil.MustDecode(sig), ext.FeePayerSig)
+
+		// the signature of the typed flow isn't the one of the legacy flow
+		_, err = attach(sign(), "--legacy")
+		require.ErrorContains(t, err, "the signature is of")
+	})
+}
@@ -0,0 +1,572 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	evmutils "github.com/cosmos/evm/utils"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+	tokenfactorytypes "github.com/strangelove-ventures/tokenfactory/x/tokenfactory/types"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/version"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/rollchains/flora/app"
+)
+
+const (
+	flagAll                      = "all"
+	flagSlot                     = "slot"
+	flagOwner                    = "owner"
+	flagDynamic                  = "dynamic"
+	flagAdmin                    = "admin"
+	flagDisplay                  = "display"
+	flagExponent                 = "exponent"
+	flagName                     = "name"
+	flagSymbol                   = "symbol"
+	flagDescription              = "description"
+	flagNoBaseFee                = "no-base-fee"
+	flagBaseFee                  = "base-fee"
+	flagMinGasPrice              = "min-gas-price"
+	flagMinGasMultiplier         = "min-gas-multiplier"
+	flagBaseFeeChangeDenominator = "base-fee-change-denominator"
+	flagElasticityMultiplier     = "elasticity-multiplier"
+	flagEnableHeight             = "enable-height"
+
+	ownerModule   = "module"
+	ownerExternal = "external"
+)
+
+// bankGenesisCmd returns the genesis commands of the bank module.
+func bankGenesisCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        banktypes.ModuleName,
+		Short:                      "Bank genesis subcommands",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(addDenomMetadataCmd())
+
+	return cmd
+}
+
+// addDenomMetadataCmd adds the bank metadata of a denom in genesis.json.
+func addDenomMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-denom-metadata [denom] [display] [exponent]",
+		Short: "Add the bank metadata of a denom in genesis.json",
+		Long: `Add the bank metadata of a denom in genesis.json, with the denom as base unit and a
+display unit of the exponent. The metadata of the denom of the chain must match the
+display denom and decimals of the chain info, the node doesn't start otherwise.`,
+		Example: fmt.Sprintf("%s genesis bank add-denom-metadata %s %s 18", version.AppName, app.BaseDenom, app.DisplayDenom),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			denom, display := args[0], args[1]
+			exponent, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid exponent %s: %w", args[2], err)
+			}
+
+			name, err := cmd.Flags().GetString(flagName)
+			if err != nil {
+				return err
+			}
+			symbol, err := cmd.Flags().GetString(flagSymbol)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(flagDescription)
+			if err != nil {
+				return err
+			}
+			if name == "" {
+				name = display
+			}
+			if symbol == "" {
+				symbol = strings.ToUpper(display)
+			}
+
+			metadata := banktypes.Metadata{
+				Description: description,
+				DenomUnits: []*banktypes.DenomUnit{
+					{Denom: denom, Exponent: 0},
+					{Denom: display, Exponent: uint32(exponent)},
+				},
+				Base:    denom,
+				Display: display,
+				Name:    name,
+				Symbol:  symbol,
+			}
+			return updateAppGenesis(cmd, func(cdc codec.Codec, genesis app.GenesisState) error {
+				return genesis.AddDenomMetadata(cdc, metadata)
+			})
+		},
+	}
+
+	cmd.Flags().String(flagName, "", "Name of the denom, the display denom when not set")
+	cmd.Flags().String(flagSymbol, "", "Symbol of the denom, the upper case display denom when not set")
+	cmd.Flags().String(flagDescription, "", "Description of the denom")
+
+	return cmd
+}
+
+// evmGenesisCmd returns the genesis commands of the EVM module.
+func evmGenesisCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        evmtypes.ModuleName,
+		Short:                      "EVM genesis subcommands",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		setPrecompilesCmd(),
+		predeployCmd(),
+	)
+
+	return cmd
+}
+
+// setPrecompilesCmd sets the active static precompiles in genesis.json.
+func setPrecompilesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-precompiles [[address]...]",
+		Short: "Set the active static precompiles in genesis.json",
+		Long: fmt.Sprintf(`Set the active static precompiles of the EVM params in genesis.json, the precompiles
+not listed are disabled. The available precompiles are:
+  %s`, strings.Join(app.AvailableStaticPrecompiles, "\n  ")),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			all, err := cmd.Flags().GetBool(flagAll)
+			if err != nil {
+				return err
+			}
+			if all {
+				if len(args) > 0 {
+					return fmt.Errorf("--%s sets all the available precompiles, no address is expected", flagAll)
+				}
+				args = app.AvailableStaticPrecompiles
+			}
+
+			return updateAppGenesis(cmd, func(cdc codec.Codec, genesis app.GenesisState) error {
+				return genesis.SetActiveStaticPrecompiles(cdc, args)
+			})
+		},
+	}
+
+	cmd.Flags().Bool(flagAll, false, "Set all the available precompiles")
+
+	return cmd
+}
+
+// predeployCmd deploys a contract in genesis.json.
+func predeployCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "predeploy [address] [bytecode]",
+		Short: "Deploy a contract with its runtime bytecode and storage in genesis.json",
+		Long: `Deploy a contract at an address in genesis.json. The bytecode is the hex runtime
+bytecode of the contract, or the path of a file holding it. The storage slots of the
+contract are set with --slot, as slot=value pairs of 32 bytes hex words.`,
+		Example: fmt.Sprintf("%s genesis evm predeploy 0x4e59b44847b379578588920cA78FbF26c0B4956C ./create2.hex --slot 0x0=0x1", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid contract address %s", args[0])
+			}
+
+			code, err := readBytecode(args[1])
+			if err != nil {
+				return err
+			}
+
+			slots, err := cmd.Flags().GetStringSlice(flagSlot)
+			if err != nil {
+				return err
+			}
+			storage := make(evmtypes.Storage, 0, len(slots))
+			for _, slot := range slots {
+				key, value, ok := strings.Cut(slot, "=")
+				if !ok {
+					return fmt.Errorf("invalid storage slot %s, expected slot=value", slot)
+				}
+				storage = append(storage, evmtypes.NewState(common.HexToHash(key), common.HexToHash(value)))
+			}
+
+			return updateAppGenesis(cmd, func(cdc codec.Codec, genesis app.GenesisState) error {
+				return genesis.AddPredeploy(cdc, common.HexToAddress(args[0]), code, storage)
+			})
+		},
+	}
+
+	cmd.Flags().StringSlice(flagSlot, nil, "Storage slot of the contract, as a slot=value pair, repeatable")
+
+	return cmd
+}
+
+// readBytecode reads the hex bytecode of an argument, the argument is either
+// the bytecode or the path of a file holding it.
+func readBytecode(arg string) ([]byte, error) {
+	bytecode := arg
+	if bz, err := os.ReadFile(arg); err == nil {
+		bytecode = string(bz)
+	}
+
+	bytecode = strings.TrimPrefix(strings.TrimSpace(bytecode), "0x")
+	code := common.Hex2Bytes(bytecode)
+	if len(code) == 0 || common.Bytes2Hex(code) != strings.ToLower(bytecode) {
+		return nil, fmt.Errorf("%s is neither a hex bytecode nor a file holding one", arg)
+	}
+	return code, nil
+}
+
+// erc20GenesisCmd returns the genesis commands of the erc20 module.
+func erc20GenesisCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        erc20types.ModuleName,
+		Short:                      "ERC20 genesis subcommands",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(addTokenPairCmd())
+
+	return cmd
+}
+
+// addTokenPairCmd adds a token pair in genesis.json.
+func addTokenPairCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-token-pair [denom] [[erc20-address]]",
+		Short: "Add an ERC20 token pair in genesis.json",
+		Long: `Add a token pair between a denom and an ERC20 contract in genesis.json.
+
+A pair owned by the erc20 module is the pair of a coin, its ERC20 contract is a native
+precompile at the ERC20 address, or a dynamic precompile with --dynamic. The ERC20
+address of an IBC denom defaults to the address derived from its hash, as a dynamic
+precompile. A pair owned by an external account is the pair of a deployed ERC20 contract.`,
+		Example: fmt.Sprintf(`%[1]s genesis erc20 add-token-pair petal 0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE
+%[1]s genesis erc20 add-token-pair ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2`, version.AppName),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			owner, err := cmd.Flags().GetString(flagOwner)
+			if err != nil {
+				return err
+			}
+			dynamic, err := cmd.Flags().GetBool(flagDynamic)
+			if err != nil {
+				return err
+			}
+
+			var contractOwner erc20types.Owner
+			switch owner {
+			case ownerModule:
+				contractOwner = erc20types.OWNER_MODULE
+			case ownerExternal:
+				contractOwner = erc20types.OWNER_EXTERNAL
+			default:
+				return fmt.Errorf("invalid --%s %s, expected %s or %s", flagOwner, owner, ownerModule, ownerExternal)
+			}
+
+			var address common.Address
+			if len(args) == 2 {
+				if !common.IsHexAddress(args[1]) {
+					return fmt.Errorf("invalid ERC20 address %s", args[1])
+				}
+				address = common.HexToAddress(args[1])
+			} else {
+				if contractOwner != erc20types.OWNER_MODULE {
+					return fmt.Errorf("the ERC20 address of a pair owned by an external account is required")
+				}
+				address, err = evmutils.GetIBCDenomAddress(args[0])
+				if err != nil {
+					return fmt.Errorf("the ERC20 address of %s is required: %w", args[0], err)
+				}
+				dynamic = true
+			}
+
+			return updateAppGenesis(cmd, func(cdc codec.Codec, genesis app.GenesisState) error {
+				return genesis.AddTokenPair(cdc, erc20types.NewTokenPair(address, args[0], contractOwner), dynamic)
+			})
+		},
+	}
+
+	cmd.Flags().String(flagOwner, ownerModule, "Owner of the ERC20 contract, module or external")
+	cmd.Flags().Bool(flagDynamic, false, "Register the ERC20 contract of a coin as a dynamic precompile")
+
+	return cmd
+}
+
+// tokenfactoryGenesisCmd returns the genesis commands of the tokenfactory
+// module.
+func tokenfactoryGenesisCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        tokenfactorytypes.ModuleName,
+		Short:                      "Tokenfactory genesis subcommands",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(createDenomCmd())
+
+	return cmd
+}
+
+// createDenomCmd creates a tokenfactory denom with its metadata in
+// genesis.json.
+func createDenomCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-denom [creator] [subdenom]",
+		Short: "Create a tokenfactory denom with its metadata in genesis.json",
+		Long: `Create the denom factory/{creator}/{subdenom} in genesis.json, administered by the
+creator or by --admin. Its bank metadata has the denom as base unit and, with --display
+and --exponent, a display unit.`,
+		Example: fmt.Sprintf("%s genesis tokenfactory create-denom flora1... ufoo --display foo --exponent 6 --name Foo --symbol FOO", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			creator, subdenom := args[0], args[1]
+			denom, err := tokenfactorytypes.GetTokenDenom(creator, subdenom)
+			if err != nil {
+				return err
+			}
+
+			admin, err := cmd.Flags().GetString(flagAdmin)
+			if err != nil {
+				return err
+			}
+			if admin == "" {
+				admin = creator
+			}
+
+			display, err := cmd.Flags().GetString(flagDisplay)
+			if err != nil {
+				return err
+			}
+			exponent, err := cmd.Flags().GetUint32(flagExponent)
+			if err != nil {
+				return err
+			}
+			name, err := cmd.Flags().GetString(flagName)
+			if err != nil {
+				return err
+			}
+			symbol, err := cmd.Flags().GetString(flagSymbol)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(flagDescription)
+			if err != nil {
+				return err
+			}
+
+			metadata := banktypes.Metadata{
+				Description: description,
+				DenomUnits:  []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+				Base:        denom,
+				Display:     denom,
+				Name:        name,
+				Symbol:      symbol,
+			}
+			if display != "" {
+				metadata.DenomUnits = append(metadata.DenomUnits, &banktypes.DenomUnit{Denom: display, Exponent: exponent})
+				metadata.Display = display
+			}
+			if metadata.Name == "" {
+				metadata.Name = subdenom
+			}
+			if metadata.Symbol == "" {
+				metadata.Symbol = strings.ToUpper(subdenom)
+			}
+
+			return updateAppGenesis(cmd, func(cdc codec.Codec, genesis app.GenesisState) error {
+				return genesis.AddFactoryDenom(cdc, admin, metadata)
+			})
+		},
+	}
+
+	cmd.Flags().String(flagAdmin, "", "Admin of the denom, the creator when not set")
+	cmd.Flags().String(flagDisplay, "", "Display unit of the denom")
+	cmd.Flags().Uint32(flagExponent, 6, "Exponent of the display unit")
+	cmd.Flags().String(flagName, "", "Name of the denom, the subdenom when not set")
+	cmd.Flags().String(flagSymbol, "", "Symbol of the denom, the upper case subdenom when not set")
+	cmd.Flags().String(flagDescription, "", "Description of the denom")
+
+	return cmd
+}
+
+// feemarketGenesisCmd returns the genesis commands of the feemarket module.
+func feemarketGenesisCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        feemarkettypes.ModuleName,
+		Short:                      "Fee market genesis subcommands",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(setFeeMarketParamsCmd())
+
+	return cmd
+}
+
+// setFeeMarketParamsCmd sets the feemarket params in genesis.json.
+func setFeeMarketParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-params",
+		Short:   "Set the fee market params in genesis.json",
+		Long:    `Set the fee market params in genesis.json, the params without a flag are kept.`,
+		Example: fmt.Sprintf("%s genesis feemarket set-params --no-base-fee --base-fee 0 --min-gas-price 0", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return updateAppGenesis(cmd, func(cdc codec.Codec, genesis app.GenesisState) error {
+				return genesis.UpdateFeeMarketParams(cdc, func(params *feemarkettypes.Params) error {
+					return feeMarketParamsFromFlags(cmd, params)
+				})
+			})
+		},
+	}
+
+	cmd.Flags().Bool(flagNoBaseFee, false, "Disable the EIP-1559 base fee")
+	cmd.Flags().String(flagBaseFee, "", "Initial base fee")
+	cmd.Flags().String(flagMinGasPrice, "", "Global minimum gas price")
+	cmd.Flags().String(flagMinGasMultiplier, "", "Minimum gas used by a transaction, as a fraction of its gas limit")
+	cmd.Flags().Uint32(flagBaseFeeChangeDenominator, 0, "Bound of the base fee change between blocks")
+	cmd.Flags().Uint32(flagElasticityMultiplier, 0, "Bound of the gas limit of a block, as a multiple of its gas target")
+	cmd.Flags().Int64(flagEnableHeight, 0, "Height the base fee is enabled at")
+
+	return cmd
+}
+
+// feeMarketParamsFromFlags sets the params of the flags set on the command.
+func feeMarketParamsFromFlags(cmd *cobra.Command, params *feemarkettypes.Params) error {
+	flags := cmd.Flags()
+
+	var err error
+	if flags.Changed(flagNoBaseFee) {
+		if params.NoBaseFee, err = flags.GetBool(flagNoBaseFee); err != nil {
+			return err
+		}
+	}
+	for flag, dec := range map[string]*math.LegacyDec{
+		flagBaseFee:          &params.BaseFee,
+		flagMinGasPrice:      &params.MinGasPrice,
+		flagMinGasMultiplier: &params.MinGasMultiplier,
+	} {
+		if !flags.Changed(flag) {
+			continue
+		}
+		value, err := flags.GetString(flag)
+		if err != nil {
+			return err
+		}
+		if *dec, err = math.LegacyNewDecFromStr(value); err != nil {
+			return fmt.Errorf("invalid --%s: %w", flag, err)
+		}
+	}
+	if flags.Changed(flagBaseFeeChangeDenominator) {
+		if params.BaseFeeChangeDenominator, err = flags.GetUint32(flagBaseFeeChangeDenominator); err != nil {
+			return err
+		}
+	}
+	if flags.Changed(flagElasticityMultiplier) {
+		if params.ElasticityMultiplier, err = flags.GetUint32(flagElasticityMultiplier); err != nil {
+			return err
+		}
+	}
+	if flags.Changed(flagEnableHeight) {
+		if params.EnableHeight, err = flags.GetInt64(flagEnableHeight); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateAppGenesis applies fn to the app state of the genesis file in the
+// home directory of the command and writes the result back.
+func updateAppGenesis(cmd *cobra.Command, fn func(cdc codec.Codec, genesis app.GenesisState) error) error {
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	config := server.GetServerContextFromCmd(cmd).Config
+	config.SetRoot(clientCtx.HomeDir)
+
+	genFile := config.GenesisFile()
+	appState, appGenesis, err := genutiltypes.GenesisStateFromGenFile(genFile)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+	}
+
+	if err := fn(clientCtx.Codec, appState); err != nil {
+		return err
+	}
+
+	appGenesis.AppState, err = json.Marshal(appState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal application genesis state: %w", err)
+	}
+
+	return genutil.ExportGenesisFile(appGenesis, genFile)
+}
+
+// validateGenesisCmd validates a genesis file like the genutil command, and
+// the state of a streamed genesis, read from its directory, see
+// app.StreamedGenesis.
+func validateGenesisCmd(basicManager module.BasicManager) *cobra.Command {
+	return &cobra.Command{
+		Use:     "validate [file]",
+		Aliases: []string{"validate-genesis"},
+		Args:    cobra.RangeArgs(0, 1),
+		Short:   "Validates the genesis file at the default location or at the location passed as an arg",
+		Long: `Validates the genesis file at the default location or at the location passed as an arg.
+
+The module state of a streamed genesis, exported with --stream-dir, is read from the directory it
+references, relative to the genesis file. The EVM records are checked one at a time, the state of
+the other modules is loaded in memory.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			genFile := serverCtx.Config.GenesisFile()
+			if len(args) > 0 {
+				genFile = args[0]
+			}
+
+			appGenesis, err := genutiltypes.AppGenesisFromFile(genFile)
+			if err != nil {
+				return err
+			}
+			if err := appGenesis.ValidateAndComplete(); err != nil {
+				return fmt.Errorf("make sure that you have correctly migrated all CometBFT consensus params: %w", err)
+			}
+
+			var genState map[string]json.RawMessage
+			if err := json.Unmarshal(appGenesis.AppState, &genState); err != nil {
+				return fmt.Errorf("error unmarshalling genesis doc %s: %w", genFile, err)
+			}
+			if stream, ok := genState[app.StreamedGenesisKey]; ok {
+				if genState, err = app.LoadStreamedGenesis(filepath.Dir(genFile), stream); err != nil {
+					return fmt.Errorf("error loading streamed genesis of %s: %w", genFile, err)
+				}
+			}
+
+			if err := basicManager.ValidateGenesis(clientCtx.Codec, clientCtx.TxConfig, genState); err != nil {
+				return fmt.Errorf("error validating genesis file %s: %w", genFile, err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "File at %s is a valid genesis file\n", genFile)
+			return nil
+		},
+	}
+}
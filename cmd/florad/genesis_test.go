@@ -0,0 +1,202 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/ethereum/go-ethereum/common"
+	tokenfactorytypes "github.com/strangelove-ventures/tokenfactory/x/tokenfactory/types"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/rollchains/flora/app"
+)
+
+func TestMain(m *testing.M) {
+	setupSDKConfig()
+	os.Exit(m.Run())
+}
+
+// execute runs the command of the args with the node home.
+func execute(t *testing.T, home string, args ...string) error {
+	t.Helper()
+
+	_, err := executeOutput(t, home, args...)
+	return err
+}
+
+// executeOutput runs the command of the args with the node home and returns
+// its output. The client commands print to the standard output, it is
+// captured while the command runs.
+func executeOutput(t *testing.T, home string, args ...string) (string, error) {
+	t.Helper()
+
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	var out bytes.Buffer
+	done := make(chan error)
+	go func() {
+		_, err := io.Copy(&out, r)
+		done <- err
+	}()
+
+	rootCmd := NewRootCmd()
+	rootCmd.SetArgs(append(args, "--home", home))
+	rootCmd.SetOut(w)
+	rootCmd.SetErr(io.Discard)
+	err = svrcmd.Execute(rootCmd, "", home)
+
+	require.NoError(t, w.Close())
+	require.NoError(t, <-done)
+	require.NoError(t, r.Close())
+	return out.String(), err
+}
+
+// readGenesis returns the app state of the genesis file of the node home.
+func readGenesis(t *testing.T, home string) app.GenesisState {
+	t.Helper()
+
+	appGenesis, err := genutiltypes.AppGenesisFromFile(filepath.Join(home, "config", "genesis.json"))
+	require.NoError(t, err)
+	var genesis app.GenesisState
+	require.NoError(t, json.Unmarshal(appGenesis.AppState, &genesis))
+	return genesis
+}
+
+func TestGenesisCommands(t *testing.T) {
+	home := t.TempDir()
+	require.NoError(t, execute(t, home, "init", "test", "--chain-id", app.ChainID))
+	cdc := app.MakeEncodingConfig(t).Codec
+
+	// evm
+	precompiles := []string{evmtypes.P256PrecompileAddress, evmtypes.Bech32PrecompileAddress}
+	require.NoError(t, execute(t, home, append([]string{"genesis", "evm", "set-precompiles"}, precompiles...)...))
+	require.ErrorContains(t, execute(t, home, "genesis", "evm", "set-precompiles", "0x0000000000000000000000000000000000000999"), "is not available")
+
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	bytecodeFile := filepath.Join(t.TempDir(), "code.hex")
+	require.NoError(t, os.WriteFile(bytecodeFile, []byte("0x6001600155\n"), 0o600))
+	require.NoError(t, execute(t, home, "genesis", "evm", "predeploy", contract.Hex(), bytecodeFile, "--slot", "0x1=0x2"))
+	require.ErrorContains(t, execute(t, home, "genesis", "evm", "predeploy", contract.Hex(), "0x60"), "already deployed")
+	require.ErrorContains(t, execute(t, home, "genesis", "evm", "predeploy", "0x1000000000000000000000000000000000000002", "0xzz"), "neither a hex bytecode")
+	require.ErrorContains(t, execute(t, home, "genesis", "evm", "predeploy", "0x1000000000000000000000000000000000000002", "0x60", "--slot", "0x1"), "expected slot=value")
+
+	// erc20
+	erc20Address := "0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE"
+	require.NoError(t, execute(t, home, "genesis", "erc20", "add-token-pair", app.BaseDenom, erc20Address))
+	require.ErrorContains(t, execute(t, home, "genesis", "erc20", "add-token-pair", app.BaseDenom, erc20Address), "duplicated")
+	require.ErrorContains(t, execute(t, home, "genesis", "erc20", "add-token-pair", "ufoo", "--owner", "external"), "is required")
+	require.ErrorContains(t, execute(t, home, "genesis", "erc20", "add-token-pair", "ufoo", erc20Address, "--owner", "other"), "invalid --owner")
+
+	// bank
+	require.NoError(t, execute(t, home, "genesis", "bank", "add-denom-metadata", app.BaseDenom, app.DisplayDenom, "18"))
+	require.ErrorContains(t, execute(t, home, "genesis", "bank", "add-denom-metadata", app.BaseDenom, app.DisplayDenom, "18"), "already has metadata")
+	require.ErrorContains(t, execute(t, home, "genesis", "bank", "add-denom-metadata", "ufoo", "foo", "six"), "invalid exponent")
+	require.ErrorContains(t, execute(t, home, "genesis", "bank", "add-denom-metadata", "ufoo", "ufoo", "6"), "duplicate denomination unit")
+
+	// tokenfactory
+	creator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	require.NoError(t, execute(t, home, "genesis", "tokenfactory", "create-denom", creator, "ufoo", "--display", "foo"))
+	require.ErrorContains(t, execute(t, home, "genesis", "tokenfactory", "create-denom", creator, "ufoo"), "duplicate denom")
+
+	// feemarket
+	require.NoError(t, execute(t, home, "genesis", "feemarket", "set-params", "--no-base-fee", "--min-gas-price", "2"))
+	require.ErrorContains(t, execute(t, home, "genesis", "feemarket", "set-params", "--base-fee", "fee"), "invalid --base-fee")
+
+	genesis := readGenesis(t, home)
+
+	var evmGenState evmtypes.GenesisState
+	cdc.MustUnmarshalJSON(genesis[evmtypes.ModuleName], &evmGenState)
+	require.Equal(t, precompiles, evmGenState.Params.ActiveStaticPrecompiles)
+	require.Len(t, evmGenState.Accounts, 1)
+	require.Equal(t, contract.Hex(), evmGenState.Accounts[0].Address)
+	require.Equal(t, "6001600155", evmGenState.Accounts[0].Code)
+	require.Equal(t, evmtypes.Storage{evmtypes.NewState(common.BigToHash(common.Big1), common.BigToHash(common.Big2))}, evmGenState.Accounts[0].Storage)
+
+	var erc20GenState erc20types.GenesisState
+	cdc.MustUnmarshalJSON(genesis[erc20types.ModuleName], &erc20GenState)
+	require.Equal(t, []erc20types.TokenPair{erc20types.NewTokenPair(common.HexToAddress(erc20Address), app.BaseDenom, erc20types.OWNER_MODULE)}, erc20GenState.TokenPairs)
+	require.Equal(t, []string{erc20Address}, erc20GenState.Params.NativePrecompiles)
+
+	denom := "factory/" + creator + "/ufoo"
+	var tokenfactoryGenState tokenfactorytypes.GenesisState
+	cdc.MustUnmarshalJSON(genesis[tokenfactorytypes.ModuleName], &tokenfactoryGenState)
+	require.Len(t, tokenfactoryGenState.FactoryDenoms, 1)
+	require.Equal(t, denom, tokenfactoryGenState.FactoryDenoms[0].Denom)
+	require.Equal(t, creator, tokenfactoryGenState.FactoryDenoms[0].AuthorityMetadata.Admin)
+	var bankGenState banktypes.GenesisState
+	cdc.MustUnmarshalJSON(genesis[banktypes.ModuleName], &bankGenState)
+	require.Equal(t, []banktypes.Metadata{{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Aliases: []string{}}, {Denom: "foo", Exponent: 6, Aliases: []string{}}},
+		Base:       denom,
+		Display:    "foo",
+		Name:       "ufoo",
+		Symbol:     "UFOO",
+	}, {
+		DenomUnits: []*banktypes.DenomUnit{{Denom: app.BaseDenom, Aliases: []string{}}, {Denom: app.DisplayDenom, Exponent: 18, Aliases: []string{}}},
+		Base:       app.BaseDenom,
+		Display:    app.DisplayDenom,
+		Name:       app.DisplayDenom,
+		Symbol:     "FLORA",
+	}}, bankGenState.DenomMetadata)
+
+	var feemarketGenState feemarkettypes.GenesisState
+	cdc.MustUnmarshalJSON(genesis[feemarkettypes.ModuleName], &feemarketGenState)
+	require.True(t, feemarketGenState.Params.NoBaseFee)
+	require.Equal(t, sdkmath.LegacyNewDec(2), feemarketGenState.Params.MinGasPrice)
+
+	require.NoError(t, execute(t, home, "genesis", "validate"))
+}
+
+func TestValidateStreamedGenesis(t *testing.T) {
+	home := t.TempDir()
+	require.NoError(t, execute(t, home, "init", "test", "--chain-id", app.ChainID))
+
+	// the module state of the genesis, streamed next to it
+	genesisFile := filepath.Join(home, "config", "genesis.json")
+	appGenesis, err := genutiltypes.AppGenesisFromFile(genesisFile)
+	require.NoError(t, err)
+	stream := app.StreamedGenesis{Dir: "state", Format: app.StreamFormatNDJSON}
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config", "state", "modules"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config", "state", "evm"), 0o755))
+	for name, state := range readGenesis(t, home) {
+		require.NoError(t, os.WriteFile(filepath.Join(home, "config", "state", "modules", name+".json"), state, 0o600))
+		stream.Modules = append(stream.Modules, name)
+	}
+	for _, records := range []string{"codes", "accounts", "storage"} {
+		require.NoError(t, os.WriteFile(filepath.Join(home, "config", "state", "evm", records+".ndjson"), nil, 0o600))
+	}
+	appGenesis.AppState, err = json.Marshal(map[string]app.StreamedGenesis{app.StreamedGenesisKey: stream})
+	require.NoError(t, err)
+	require.NoError(t, appGenesis.SaveAs(genesisFile))
+
+	require.NoError(t, execute(t, home, "genesis", "validate"))
+
+	// the records are checked
+	code := `{"hash":"0x0000000000000000000000000000000000000000000000000000000000000001","code":"6001"}` + "\n"
+	require.NoError(t, os.WriteFile(filepath.Join(home, "config", "state", "evm", "codes.ndjson"), []byte(code), 0o600))
+	require.ErrorContains(t, execute(t, home, "genesis", "validate"), "doesn't match the code")
+
+	// as the module state
+	require.NoError(t, os.WriteFile(filepath.Join(home, "config", "state", "evm", "codes.ndjson"), nil, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(home, "config", "state", "modules", "bank.json"), []byte(`{"params":{},"supply":[{"denom":"petal","amount":"-1"}]}`), 0o600))
+	require.ErrorContains(t, execute(t, home, "genesis", "validate"), "error validating genesis file")
+}
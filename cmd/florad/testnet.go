@@ -0,0 +1,203 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/rootmulti"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/rollchains/flora/app"
+)
+
+const (
+	flagSkipConfirmation = "skip-confirmation"
+	flagEVMChainID       = "evm-chain-id"
+	flagAccountsToFund   = "accounts-to-fund"
+	flagFundCoins        = "fund-coins"
+	flagVotingPeriod     = "voting-period"
+)
+
+// inPlaceTestnetCmd wraps the in-place testnet command of the SDK, which
+// replaces the validator set of the CometBFT state by the validator key of the
+// node home, with the chain info of the testnet and the changes of the app
+// state made by newTestnetApp.
+func inPlaceTestnetCmd() *cobra.Command {
+	cmd := server.InPlaceTestnetCreator(newTestnetApp)
+	addModuleInitFlags(cmd)
+	cmd.Long += `
+The validator set of the state is replaced by a validator with the consensus key of the
+node home, operated by the operator address. The chain info of the node home is rewritten
+with the new chain id and its EVM chain id, so the testnet doesn't replay the transactions
+of the chain. The funded accounts get the fund coins, the gov voting period is shortened
+and the IBC clients are unfrozen and extended, as no relayer updates them.
+
+The command starts the node without the JSON-RPC server, stop it once the first block is
+committed and use the "start" command.
+`
+	cmd.Example = fmt.Sprintf("%s in-place-testnet florafork_9999-1 flora1... --accounts-to-fund 0x...,flora1...", version.AppName)
+
+	startTestnet := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		serverCtx := server.GetServerContextFromCmd(cmd)
+		home := serverCtx.Config.RootDir
+
+		// the app created by the SDK command can't return errors, the flags
+		// are checked before the state is modified
+		if _, err := parseAddress(args[1]); err != nil {
+			return err
+		}
+		if _, err := testnetAccounts(serverCtx.Viper); err != nil {
+			return err
+		}
+		if _, err := sdk.ParseCoinsNormalized(cast.ToString(serverCtx.Viper.Get(flagFundCoins))); err != nil {
+			return fmt.Errorf("invalid --%s: %w", flagFundCoins, err)
+		}
+
+		skipConfirmation, err := cmd.Flags().GetBool(flagSkipConfirmation)
+		if err != nil {
+			return err
+		}
+		if !skipConfirmation {
+			if !confirm(cmd.InOrStdin(), cmd.OutOrStdout(), "This operation will modify state in your data folder and cannot be undone. Do you want to continue? (y/n)") {
+				cmd.Println("Operation canceled.")
+				return nil
+			}
+			if err := cmd.Flags().Set(flagSkipConfirmation, "true"); err != nil {
+				return err
+			}
+		}
+
+		info, err := app.LoadChainInfo(home, "")
+		if err != nil {
+			return err
+		}
+		info.ChainID = args[0]
+		info.EVMChainID, err = cmd.Flags().GetUint64(flagEVMChainID)
+		if err != nil {
+			return err
+		}
+		if err := app.WriteChainInfo(home, info); err != nil {
+			return fmt.Errorf("chain info of %s: %w", args[0], err)
+		}
+
+		return startTestnet(cmd, args)
+	}
+
+	cmd.Flags().Uint64(flagEVMChainID, 0, "EVM chain id of the testnet, parsed from the chain id when not set")
+	cmd.Flags().StringSlice(flagAccountsToFund, nil, "Hex or bech32 addresses of the accounts to fund")
+	cmd.Flags().String(flagFundCoins, "", "Coins minted to each funded account, a million display units of the bond denom when not set")
+	cmd.Flags().Duration(flagVotingPeriod, app.DefaultTestnetVotingPeriod, "Gov voting period of the testnet")
+
+	return cmd
+}
+
+// newTestnetApp creates the app and turns its state into the state of the
+// testnet, see app.InitForTestnet.
+func newTestnetApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts servertypes.AppOptions) servertypes.Application {
+	home := cast.ToString(appOpts.Get(flags.FlagHome))
+
+	// the upgrade to trigger is loaded with its store upgrades
+	if name := cast.ToString(appOpts.Get(server.KeyTriggerTestnetUpgrade)); name != "" {
+		upgrade, err := findUpgrade(name)
+		if err != nil {
+			panic(err)
+		}
+		if err := writeUpgradeInfo(db, filepath.Join(home, "data"), rootmulti.GetLatestVersion(db), upgrade); err != nil {
+			panic(err)
+		}
+	}
+
+	chainApp, ok := newApp(logger, db, traceStore, appOpts).(*app.ChainApp)
+	if !ok {
+		panic("app created from newApp is not a ChainApp")
+	}
+
+	cfg, err := testnetConfig(appOpts)
+	if err != nil {
+		panic(err)
+	}
+	if err := chainApp.InitForTestnet(cfg); err != nil {
+		panic(fmt.Errorf("failed to initialize the testnet: %w", err))
+	}
+	return chainApp
+}
+
+// testnetConfig reads the testnet config from the app options set by the
+// in-place testnet command.
+func testnetConfig(appOpts servertypes.AppOptions) (app.TestnetConfig, error) {
+	consPubKey, ok := appOpts.Get(server.KeyUserPubKey).(cmtcrypto.PubKey)
+	if !ok {
+		return app.TestnetConfig{}, fmt.Errorf("%s is not set", server.KeyUserPubKey)
+	}
+	operator, err := parseAddress(cast.ToString(appOpts.Get(server.KeyNewOpAddr)))
+	if err != nil {
+		return app.TestnetConfig{}, err
+	}
+	accounts, err := testnetAccounts(appOpts)
+	if err != nil {
+		return app.TestnetConfig{}, err
+	}
+	coins, err := sdk.ParseCoinsNormalized(cast.ToString(appOpts.Get(flagFundCoins)))
+	if err != nil {
+		return app.TestnetConfig{}, err
+	}
+
+	return app.TestnetConfig{
+		ConsPubKey:   consPubKey,
+		Operator:     sdk.ValAddress(operator),
+		Accounts:     accounts,
+		FundCoins:    coins,
+		VotingPeriod: cast.ToDuration(appOpts.Get(flagVotingPeriod)),
+		Upgrade:      cast.ToString(appOpts.Get(server.KeyTriggerTestnetUpgrade)),
+	}, nil
+}
+
+func testnetAccounts(appOpts servertypes.AppOptions) ([]sdk.AccAddress, error) {
+	var accounts []sdk.AccAddress
+	for _, address := range cast.ToStringSlice(appOpts.Get(flagAccountsToFund)) {
+		account, err := parseAddress(address)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, account)
+	}
+	return accounts, nil
+}
+
+// parseAddress parses a hex address or a bech32 address, of an account or a
+// validator operator.
+func parseAddress(address string) (sdk.AccAddress, error) {
+	if common.IsHexAddress(address) {
+		return common.HexToAddress(address).Bytes(), nil
+	}
+
+	_, bz, err := bech32.DecodeAndConvert(address)
+	if err != nil || len(bz) == 0 {
+		return nil, fmt.Errorf("must be a hex or bech32 address: %s", address)
+	}
+	return bz, nil
+}
+
+func confirm(in io.Reader, out io.Writer, prompt string) bool {
+	fmt.Fprintln(out, prompt)
+	text, _ := bufio.NewReader(in).ReadString('\n')
+	response := strings.TrimSpace(strings.ToLower(text))
+	return response == "y" || response == "yes"
+}
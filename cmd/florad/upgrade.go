@@ -0,0 +1,245 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/rollchains/flora/app"
+	"github.com/rollchains/flora/app/upgrades"
+)
+
+const flagBlocks = "blocks"
+
+func upgradeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "upgrade",
+		Short:                      "Upgrade subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(simulateUpgradeCmd())
+
+	return cmd
+}
+
+// simulateUpgradeCmd runs an upgrade of app.Upgrades against a copy of the
+// application state of the node home.
+func simulateUpgradeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate [upgrade-name]",
+		Short: "Simulate an upgrade against the state of the node home",
+		Long: `Simulate an upgrade against the latest height of the state of the node home.
+
+The application state is copied to a temporary directory, the live data directory
+is left as is. The upgrade is scheduled at the next height with its store upgrades,
+its block runs the upgrade handler and the module migrations, the crisis invariants
+are asserted and empty blocks are produced after it. The changed keys of the module
+stores are printed per module, decoded when the module store is known.`,
+		Example: fmt.Sprintf("%s upgrade simulate v2 --home ~/.flora-copy", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			home := serverCtx.Config.RootDir
+
+			upgrade, err := findUpgrade(args[0])
+			if err != nil {
+				return err
+			}
+
+			blocks, err := cmd.Flags().GetInt(flagBlocks)
+			if err != nil {
+				return err
+			}
+			stores, err := cmd.Flags().GetStringSlice(flagStores)
+			if err != nil {
+				return err
+			}
+
+			// the simulation runs in a home of its own, with the config of the
+			// node and a copy of its application state
+			simHome, err := os.MkdirTemp("", "florad-upgrade-simulate")
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(simHome)
+
+			if err := os.Symlink(filepath.Join(home, "config"), filepath.Join(simHome, "config")); err != nil {
+				return err
+			}
+			cmd.PrintErrf("copying the application state of %s\n", home)
+			if err := copyDir(filepath.Join(home, "data", "application.db"), filepath.Join(simHome, "data", "application.db")); err != nil {
+				return err
+			}
+
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(simHome, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			height := rootmulti.GetLatestVersion(db)
+			if height == 0 {
+				return fmt.Errorf("no application state in %s", home)
+			}
+			if err := writeUpgradeInfo(db, filepath.Join(simHome, "data"), height, upgrade); err != nil {
+				return err
+			}
+
+			appOpts := serverCtx.Viper
+			appOpts.Set(flags.FlagHome, simHome)
+			appOpts.Set(server.FlagPruning, pruningtypes.PruningOptionNothing)
+
+			chainApp := app.NewChainApp(
+				serverCtx.Logger, db, nil, true,
+				appOpts,
+				app.NewEVMAppOptions(simHome),
+				server.DefaultBaseappOptions(appOpts)...,
+			)
+			simulation, err := chainApp.SimulateUpgrade(upgrade.UpgradeName, blocks)
+			if err != nil {
+				return err
+			}
+
+			return printUpgradeSimulation(cmd.OutOrStdout(), chainApp, height, simulation, stores)
+		},
+	}
+
+	cmd.Flags().Int(flagBlocks, 3, "Number of empty blocks produced after the upgrade block")
+	cmd.Flags().StringSlice(flagStores, nil, "Module stores whose changes are printed, all the stores when not set")
+
+	return cmd
+}
+
+// printUpgradeSimulation prints the outcome of the simulation and the decoded
+// state diff of the upgrade and the empty blocks following it.
+func printUpgradeSimulation(out io.Writer, chainApp *app.ChainApp, from int64, simulation app.UpgradeSimulation, stores []string) error {
+	fmt.Fprintf(out, "upgrade %s applied at height %d\n", simulation.Upgrade, simulation.Height)
+
+	if len(simulation.BrokenInvariants) == 0 {
+		fmt.Fprintln(out, "invariants: ok")
+	} else {
+		fmt.Fprintf(out, "invariants: %d broken\n", len(simulation.BrokenInvariants))
+		for _, res := range simulation.BrokenInvariants {
+			fmt.Fprintln(out, res)
+		}
+	}
+
+	fmt.Fprintln(out)
+	if err := printStateDiff(out, chainApp.WalkStoreDiffs, chainApp.DecodeStoreDiff, from, chainApp.LastBlockHeight(), stores); err != nil {
+		return err
+	}
+
+	if len(simulation.BrokenInvariants) > 0 {
+		return fmt.Errorf("upgrade %s breaks %d invariants", simulation.Upgrade, len(simulation.BrokenInvariants))
+	}
+	return nil
+}
+
+// findUpgrade returns the upgrade of app.Upgrades with the name.
+func findUpgrade(name string) (upgrades.Upgrade, error) {
+	idx := slices.IndexFunc(app.Upgrades, func(upgrade upgrades.Upgrade) bool { return upgrade.UpgradeName == name })
+	if idx < 0 {
+		return upgrades.Upgrade{}, fmt.Errorf("unknown upgrade %s", name)
+	}
+	return app.Upgrades[idx], nil
+}
+
+// writeUpgradeInfo writes the upgrade info of the upgrade at the height after
+// the last height of the state to the data directory. The upgrade info makes
+// the app load the store upgrades of the upgrade, as when a node halts for an
+// upgrade.
+func writeUpgradeInfo(db dbm.DB, dataDir string, height int64, upgrade upgrades.Upgrade) error {
+	if err := checkStoreUpgrades(db, height, upgrade.StoreUpgrades); err != nil {
+		return fmt.Errorf("upgrade %s: %w", upgrade.UpgradeName, err)
+	}
+	upgradeInfo, err := json.Marshal(upgradetypes.Plan{Name: upgrade.UpgradeName, Height: height + 1})
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dataDir, upgradetypes.UpgradeInfoFilename), upgradeInfo, 0o600)
+}
+
+// checkStoreUpgrades checks that the stores added by an upgrade are missing
+// from the state and the stores it deletes are in it.
+func checkStoreUpgrades(db dbm.DB, height int64, storeUpgrades storetypes.StoreUpgrades) error {
+	commitInfo, err := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics()).GetCommitInfo(height)
+	if err != nil {
+		return err
+	}
+
+	stores := make(map[string]bool, len(commitInfo.StoreInfos))
+	for _, info := range commitInfo.StoreInfos {
+		stores[info.Name] = true
+	}
+	for _, name := range storeUpgrades.Added {
+		if stores[name] {
+			return fmt.Errorf("the store %s it adds is already in the state at height %d", name, height)
+		}
+	}
+	for _, name := range storeUpgrades.Deleted {
+		if !stores[name] {
+			return fmt.Errorf("the store %s it deletes is not in the state at height %d", name, height)
+		}
+	}
+	return nil
+}
+
+// copyDir copies the regular files of a directory tree.
+func copyDir(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		switch {
+		case entry.IsDir():
+			return os.MkdirAll(target, 0o700)
+		case !entry.Type().IsRegular():
+			return nil
+		}
+
+		in, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		out, err := os.OpenFile(target, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(out, in); err != nil {
+			out.Close()
+			return err
+		}
+		return out.Close()
+	})
+}
@@ -0,0 +1,144 @@
+package eip1271
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// PubKeySize defines the size of the PubKey bytes
+	PubKeySize = common.AddressLength
+	// KeyType is the string constant for the EIP-1271 contract accounts
+	KeyType = "eip1271"
+	// PubKeyName defines the amino encoding name for the EIP-1271 public key
+	PubKeyName = "flora/PubKeyEIP1271"
+)
+
+// MagicValue is returned by isValidSignature for a valid signature.
+var MagicValue = [4]byte{0x16, 0x26, 0xba, 0x7e}
+
+var (
+	_ cryptotypes.PubKey   = &PubKey{}
+	_ codec.AminoMarshaler = &PubKey{}
+)
+
+// NewPubKey returns the public key of the contract account.
+func NewPubKey(contract common.Address) *PubKey {
+	return &PubKey{Key: contract.Bytes()}
+}
+
+// Contract returns the address of the contract.
+func (pubKey PubKey) Contract() common.Address {
+	return common.BytesToAddress(pubKey.Key)
+}
+
+// Address returns the account address of the contract.
+func (pubKey PubKey) Address() cmtcrypto.Address {
+	return cmtcrypto.Address(pubKey.Bytes())
+}
+
+// Bytes returns the contract address.
+func (pubKey PubKey) Bytes() []byte {
+	bz := make([]byte, len(pubKey.Key))
+	copy(bz, pubKey.Key)
+
+	return bz
+}
+
+// String implements the fmt.Stringer interface.
+func (pubKey PubKey) String() string {
+	return fmt.Sprintf("PubKeyEIP1271{%s}", pubKey.Contract())
+}
+
+// Type returns eip1271
+func (pubKey PubKey) Type() string {
+	return KeyType
+}
+
+// Equals returns true if the pubkey type is the same and their bytes are deeply equal.
+func (pubKey PubKey) Equals(other cryptotypes.PubKey) bool {
+	return pubKey.Type() == other.Type() && bytes.Equal(pubKey.Bytes(), other.Bytes())
+}
+
+// MarshalAmino overrides Amino binary marshaling.
+func (pubKey PubKey) MarshalAmino() ([]byte, error) {
+	return pubKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshaling.
+func (pubKey *PubKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PubKeySize {
+		return errorsmod.Wrapf(errortypes.ErrInvalidPubKey, "invalid pubkey size, expected %d, got %d", PubKeySize, len(bz))
+	}
+	pubKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshaling.
+func (pubKey PubKey) MarshalAminoJSON() ([]byte, error) {
+	return pubKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshaling.
+func (pubKey *PubKey) UnmarshalAminoJSON(bz []byte) error {
+	return pubKey.UnmarshalAmino(bz)
+}
+
+// VerifySignature always returns false, the signatures of a contract account
+// depend on the contract state and are verified by the ante handler calling
+// isValidSignature on the contract.
+func (pubKey PubKey) VerifySignature(_, _ []byte) bool {
+	return false
+}
+
+// contractABI is the EIP-1271 interface of the contract accounts.
+var contractABI = func() abi.ABI {
+	contractABI, err := abi.JSON(strings.NewReader(`[{
+		"type": "function",
+		"name": "isValidSignature",
+		"stateMutability": "view",
+		"inputs": [{"name": "hash", "type": "bytes32"}, {"name": "signature", "type": "bytes"}],
+		"outputs": [{"name": "magicValue", "type": "bytes4"}]
+	}]`))
+	if err != nil {
+		panic(err)
+	}
+	return contractABI
+}()
+
+// SignBytesHash returns the hash passed to isValidSignature for the sign
+// bytes of a tx.
+func SignBytesHash(signBytes []byte) common.Hash {
+	return crypto.Keccak256Hash(signBytes)
+}
+
+// IsValidSignatureInput returns the input of the isValidSignature call for
+// the sign bytes of a tx and the signature.
+func IsValidSignatureInput(signBytes, signature []byte) ([]byte, error) {
+	return contractABI.Pack("isValidSignature", SignBytesHash(signBytes), signature)
+}
+
+// IsMagicValue returns true if the output of an isValidSignature call is the
+// magic value.
+func IsMagicValue(output []byte) bool {
+	values, err := contractABI.Unpack("isValidSignature", output)
+	if err != nil || len(values) != 1 {
+		return false
+	}
+	magicValue, ok := values[0].([4]byte)
+	return ok && magicValue == MagicValue
+}
@@ -0,0 +1,19 @@
+package passkey
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// RegisterLegacyAminoCodec registers the passkey public key on the provided
+// LegacyAmino codec.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&PubKey{}, PubKeyName, nil)
+}
+
+// RegisterInterfaces registers the passkey public key as a PubKey
+// implementation.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &PubKey{})
+}
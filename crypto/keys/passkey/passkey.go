@@ -0,0 +1,206 @@
+package passkey
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// PubKeySize defines the size of the PubKey bytes
+	PubKeySize = 33
+	// KeyType is the string constant for the passkey algorithm
+	KeyType = "passkey"
+	// PubKeyName defines the amino encoding name for the passkey public key
+	PubKeyName = "flora/PubKeyPasskey"
+
+	// clientDataTypeGet is the client data type of the WebAuthn assertions.
+	clientDataTypeGet = "webauthn.get"
+	// flagUserPresent is the authenticator data flag set when the user was
+	// present, e.g. touched the authenticator.
+	flagUserPresent = 0x01
+	// authenticatorDataMinSize is the size of the RP ID hash, the flags and
+	// the signature counter.
+	authenticatorDataMinSize = 37
+)
+
+var (
+	_ cryptotypes.PubKey   = &PubKey{}
+	_ codec.AminoMarshaler = &PubKey{}
+
+	// p256HalfOrder is half the order of the secp256r1 curve, the bound of the
+	// low S signatures.
+	p256HalfOrder = new(big.Int).Rsh(elliptic.P256().Params().N, 1)
+)
+
+// NewPubKey returns the passkey public key of a secp256r1 public key.
+func NewPubKey(key *ecdsa.PublicKey) *PubKey {
+	return &PubKey{Key: elliptic.MarshalCompressed(elliptic.P256(), key.X, key.Y)}
+}
+
+// Address returns the account address of the key, the first 20 bytes of the
+// ADR-028 hash of the key so that it is also an EVM address.
+func (pubKey PubKey) Address() cmtcrypto.Address {
+	return cmtcrypto.Address(address.Hash(KeyType, pubKey.Key)[:20])
+}
+
+// Bytes returns the compressed public key.
+func (pubKey PubKey) Bytes() []byte {
+	bz := make([]byte, len(pubKey.Key))
+	copy(bz, pubKey.Key)
+
+	return bz
+}
+
+// String implements the fmt.Stringer interface.
+func (pubKey PubKey) String() string {
+	return fmt.Sprintf("PubKeyPasskey{%X}", pubKey.Key)
+}
+
+// Type returns passkey
+func (pubKey PubKey) Type() string {
+	return KeyType
+}
+
+// Equals returns true if the pubkey type is the same and their bytes are deeply equal.
+func (pubKey PubKey) Equals(other cryptotypes.PubKey) bool {
+	return pubKey.Type() == other.Type() && bytes.Equal(pubKey.Bytes(), other.Bytes())
+}
+
+// MarshalAmino overrides Amino binary marshaling.
+func (pubKey PubKey) MarshalAmino() ([]byte, error) {
+	return pubKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshaling.
+func (pubKey *PubKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PubKeySize {
+		return errorsmod.Wrapf(errortypes.ErrInvalidPubKey, "invalid pubkey size, expected %d, got %d", PubKeySize, len(bz))
+	}
+	pubKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshaling.
+func (pubKey PubKey) MarshalAminoJSON() ([]byte, error) {
+	return pubKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshaling.
+func (pubKey *PubKey) UnmarshalAminoJSON(bz []byte) error {
+	return pubKey.UnmarshalAmino(bz)
+}
+
+// VerifySignature always returns false, the assertions are scoped to the
+// relying party id of the chain params and are verified by the ante handler,
+// see Assertion.Verify.
+func (pubKey PubKey) VerifySignature(_, _ []byte) bool {
+	return false
+}
+
+// ecdsaKey returns the secp256r1 public key.
+func (pubKey PubKey) ecdsaKey() (*ecdsa.PublicKey, error) {
+	x, y := elliptic.UnmarshalCompressed(elliptic.P256(), pubKey.Key)
+	if x == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidPubKey, "invalid secp256r1 point")
+	}
+	return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
+}
+
+// clientData is the part of the WebAuthn client data checked by the chain,
+// the origin is left to the wallets as the chain is not tied to a website.
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+}
+
+// Challenge returns the WebAuthn challenge the passkey has to sign for the
+// message, the base64url encoded sha256 of the message.
+func Challenge(msg []byte) string {
+	hash := sha256.Sum256(msg)
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// Verify checks the client data and the authenticator data of the assertion,
+// created for the relying party id, and its signature by the passkey over the
+// message.
+func (a Assertion) Verify(pubKey PubKey, msg []byte, rpID string) error {
+	var data clientData
+	if err := json.Unmarshal(a.ClientDataJson, &data); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "invalid client data: %s", err)
+	}
+	if data.Type != clientDataTypeGet {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "invalid client data type %q", data.Type)
+	}
+	if data.Challenge != Challenge(msg) {
+		return errorsmod.Wrap(errortypes.ErrUnauthorized, "challenge does not match the sign bytes")
+	}
+
+	if len(a.AuthenticatorData) < authenticatorDataMinSize {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "authenticator data too short: %d bytes", len(a.AuthenticatorData))
+	}
+	if rpID == "" {
+		return errorsmod.Wrap(errortypes.ErrUnauthorized, "relying party id not configured")
+	}
+	if rpIDHash := sha256.Sum256([]byte(rpID)); !bytes.Equal(a.AuthenticatorData[:32], rpIDHash[:]) {
+		return errorsmod.Wrap(errortypes.ErrUnauthorized, "rp id hash does not match the relying party id")
+	}
+	if a.AuthenticatorData[32]&flagUserPresent == 0 {
+		return errorsmod.Wrap(errortypes.ErrUnauthorized, "user not present")
+	}
+
+	r, s, err := parseSignature(a.Signature)
+	if err != nil {
+		return err
+	}
+
+	key, err := pubKey.ecdsaKey()
+	if err != nil {
+		return err
+	}
+
+	clientDataHash := sha256.Sum256(a.ClientDataJson)
+	hash := sha256.Sum256(append(bytes.Clone(a.AuthenticatorData), clientDataHash[:]...))
+	if !ecdsa.Verify(key, hash[:], r, s) {
+		return errorsmod.Wrap(errortypes.ErrUnauthorized, "invalid signature")
+	}
+
+	return nil
+}
+
+// parseSignature parses the ASN.1 DER signature of the assertion. The
+// signatures with a high S are rejected, as (r, n-s) is another valid
+// signature of the same assertion and would change the hash of the tx. The
+// wallets normalize the signatures of the authenticators, which don't.
+func parseSignature(sig []byte) (r, s *big.Int, err error) {
+	var signature struct {
+		R, S *big.Int
+	}
+	rest, err := asn1.Unmarshal(sig, &signature)
+	if err != nil || len(rest) != 0 {
+		return nil, nil, errorsmod.Wrap(errortypes.ErrUnauthorized, "malformed signature")
+	}
+	if signature.R.Sign() <= 0 || signature.S.Sign() <= 0 {
+		return nil, nil, errorsmod.Wrap(errortypes.ErrUnauthorized, "malformed signature")
+	}
+	if signature.S.Cmp(p256HalfOrder) > 0 {
+		return nil, nil, errorsmod.Wrap(errortypes.ErrUnauthorized, "signature s value is not in the lower half of the curve order")
+	}
+	return signature.R, signature.S, nil
+}
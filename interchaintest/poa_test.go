@@ -0,0 +1,92 @@
+package e2e
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/evm/crypto/hd"
+	"github.com/strangelove-ventures/interchaintest/v8"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestPOA(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in short mode")
+	}
+
+	ctx := context.Background()
+	rep := testreporter.NewNopReporter()
+	eRep := rep.RelayerExecReporter(t)
+	client, network := interchaintest.DockerSetup(t)
+
+	// the AccMnemonic account administrates the validator set from genesis
+	bz, err := hd.EthSecp256k1.Derive()(AccMnemonic, "", "m/44'/60'/0'/0/0")
+	require.NoError(t, err)
+	adminAddr := sdk.MustBech32ifyAddressBytes(Bech32, hd.EthSecp256k1.Generate()(bz).PubKey().Address())
+
+	spec := DefaultChainSpec
+	spec.ModifyGenesis = cosmos.ModifyGenesis(append(DefaultGenesis,
+		cosmos.NewGenesisKV("app_state.poa.params.admins", []string{adminAddr}),
+	))
+
+	cf := interchaintest.NewBuiltinChainFactory(zaptest.NewLogger(t), []*interchaintest.ChainSpec{
+		&spec,
+	})
+
+	chains, err := cf.Chains(t.Name())
+	require.NoError(t, err)
+
+	chain := chains[0].(*cosmos.CosmosChain)
+
+	ic := interchaintest.NewInterchain().AddChain(chain)
+
+	require.NoError(t, ic.Build(ctx, eRep, interchaintest.InterchainBuildOptions{
+		TestName:         t.Name(),
+		Client:           client,
+		NetworkID:        network,
+		SkipPathCreation: false,
+	}))
+	t.Cleanup(func() {
+		_ = ic.Close()
+	})
+
+	admin, err := interchaintest.GetAndFundTestUserWithMnemonic(ctx, "admin", AccMnemonic, GenesisFundsAmount, chain)
+	require.NoError(t, err)
+	require.Equal(t, adminAddr, admin.FormattedAddress())
+
+	users := interchaintest.GetAndFundTestUsers(t, ctx, "default", GenesisFundsAmount, chain)
+	user := users[0]
+
+	valoper, err := chain.Validators[0].KeyBech32(ctx, "validator", "val")
+	require.NoError(t, err)
+
+	t.Run("staking delegations are rejected", func(t *testing.T) {
+		cmd := TxCommandBuilder(ctx, chain, []string{"tx", "staking", "delegate", valoper, "1" + Denom}, user.KeyName())
+		res, err := ExecuteTransaction(ctx, chain, cmd)
+		require.True(t, err != nil || res.Code != 0, "delegation should be rejected in poa mode")
+	})
+
+	t.Run("non admins can't set power", func(t *testing.T) {
+		cmd := TxCommandBuilder(ctx, chain, []string{"tx", "poa", "set-power", valoper, "1000"}, user.KeyName())
+		res, err := ExecuteTransaction(ctx, chain, cmd)
+		require.True(t, err != nil || res.Code != 0, "set-power should be rejected for non admins")
+	})
+
+	t.Run("admin sets validator power", func(t *testing.T) {
+		before, err := chain.StakingQueryValidator(ctx, valoper)
+		require.NoError(t, err)
+
+		cmd := TxCommandBuilder(ctx, chain, []string{"tx", "poa", "set-power", valoper, "1000000"}, admin.KeyName())
+		res, err := ExecuteTransaction(ctx, chain, cmd)
+		require.NoError(t, err)
+		require.EqualValues(t, 0, res.Code, res.RawLog)
+
+		after, err := chain.StakingQueryValidator(ctx, valoper)
+		require.NoError(t, err)
+		require.True(t, after.Tokens.GT(before.Tokens), "validator tokens did not increase")
+	})
+}
@@ -14,6 +14,7 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	ibcconntypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	tokenfactory "github.com/strangelove-ventures/tokenfactory/x/tokenfactory/types"
@@ -27,8 +28,10 @@ var (
 	VotingPeriod     = "15s"
 	MaxDepositPeriod = "10s"
 
-	Denom = "petal"
-	Name  = "flora"
+	Denom        = "petal"
+	DisplayDenom = "flora"
+	Decimals     = uint32(18)
+	Name         = "flora"
 
 	ChainID = "localchain_9000-1"
 	Binary  = "florad"
@@ -54,6 +57,14 @@ var (
 		cosmos.NewGenesisKV("app_state.tokenfactory.params.denom_creation_gas_consume", 1), // cost 1 gas to create a new denom
 		cosmos.NewGenesisKV("app_state.feemarket.params.no_base_fee", true),
 		cosmos.NewGenesisKV("app_state.feemarket.params.base_fee", "0.000000000000000000"),
+		// the bank metadata of the denom must match the chain info
+		cosmos.NewGenesisKV("app_state.bank.denom_metadata", []banktypes.Metadata{{
+			DenomUnits: []*banktypes.DenomUnit{{Denom: Denom, Exponent: 0}, {Denom: DisplayDenom, Exponent: Decimals}},
+			Base:       Denom,
+			Display:    DisplayDenom,
+			Name:       DisplayDenom,
+			Symbol:     "FLORA",
+		}}),
 		cosmos.NewGenesisKV("app_state.evm.params.evm_denom", Denom),
 		cosmos.NewGenesisKV("app_state.evm.params.active_static_precompiles", Precompiles),
 	}
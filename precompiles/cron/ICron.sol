@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev The ICron contract's address.
+address constant CRON_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000900;
+
+/// @dev The ICron contract's instance.
+ICron constant CRON_CONTRACT = ICron(CRON_PRECOMPILE_ADDRESS);
+
+/// @author Flora
+/// @title Cron Precompiled Contract
+/// @dev The interface through which contracts schedule calls into themselves.
+/// The calls are sent by the cron module account every `interval` blocks, and
+/// their gas is paid by the registering contract.
+/// @custom:address 0x0000000000000000000000000000000000000900
+interface ICron {
+    /// @dev Registers a job calling the caller contract with `data`. The min
+    /// bond of the cron module is deducted from the contract balance and
+    /// refunded when the job is cancelled.
+    /// @param data The calldata of the scheduled calls.
+    /// @param interval The number of blocks between two calls.
+    /// @param gasLimit The gas limit of a single call.
+    /// @return jobId The identifier of the job.
+    function registerJob(
+        bytes calldata data,
+        uint64 interval,
+        uint64 gasLimit
+    ) external returns (uint64 jobId);
+
+    /// @dev Cancels a job owned by the caller and refunds its bond.
+    /// @param jobId The identifier of the job.
+    /// @return success Whether the job was cancelled.
+    function cancelJob(uint64 jobId) external returns (bool success);
+
+    /// @dev Returns a scheduled job.
+    /// @param jobId The identifier of the job.
+    function getJob(
+        uint64 jobId
+    )
+        external
+        view
+        returns (
+            address owner,
+            address target,
+            bytes memory data,
+            uint64 interval,
+            uint64 gasLimit,
+            uint256 bond,
+            uint64 nextHeight,
+            uint32 consecutiveFailures
+        );
+}
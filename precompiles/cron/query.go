@@ -0,0 +1,52 @@
+package cron
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetJobMethod defines the ABI method name for the cron GetJob query.
+const GetJobMethod = "getJob"
+
+// GetJob returns a scheduled job.
+func (p Precompile) GetJob(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	id, ok := args[0].(uint64)
+	if !ok {
+		return nil, fmt.Errorf("invalid job id: %v", args[0])
+	}
+
+	job, err := p.cronKeeper.GetJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(job.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(
+		common.BytesToAddress(owner),
+		job.ContractAddress(),
+		job.Calldata,
+		job.Interval,
+		job.GasLimit,
+		job.Bond.BigInt(),
+		job.NextHeight,
+		job.ConsecutiveFailures,
+	)
+}
@@ -0,0 +1,101 @@
+package cron
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/x/vm/core/vm"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// RegisterJobMethod defines the ABI method name for the cron RegisterJob
+	// transaction.
+	RegisterJobMethod = "registerJob"
+	// CancelJobMethod defines the ABI method name for the cron CancelJob
+	// transaction.
+	CancelJobMethod = "cancelJob"
+)
+
+// RegisterJob schedules a call into the caller contract. The bond is taken from
+// the contract balance.
+func (p *Precompile) RegisterJob(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	data, ok := args[0].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("invalid calldata: %v", args[0])
+	}
+	interval, ok := args[1].(uint64)
+	if !ok {
+		return nil, fmt.Errorf("invalid interval: %v", args[1])
+	}
+	gasLimit, ok := args[2].(uint64)
+	if !ok {
+		return nil, fmt.Errorf("invalid gas limit: %v", args[2])
+	}
+
+	// jobs call back into the registering contract
+	caller := contract.CallerAddress
+	if caller == origin {
+		return nil, errors.New("jobs can only be registered by contracts")
+	}
+
+	job, err := p.cronKeeper.RegisterContractJob(ctx, caller, data, interval, gasLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	if job.Bond.IsPositive() {
+		// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB.
+		scaledAmt := evmtypes.ConvertAmountTo18DecimalsBigInt(job.Bond.BigInt())
+		p.SetBalanceChangeEntries(cmn.NewBalanceChangeEntry(caller, scaledAmt, cmn.Sub))
+	}
+
+	return method.Outputs.Pack(job.Id)
+}
+
+// CancelJob removes a job owned by the caller and refunds its bond.
+func (p *Precompile) CancelJob(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	id, ok := args[0].(uint64)
+	if !ok {
+		return nil, fmt.Errorf("invalid job id: %v", args[0])
+	}
+
+	caller := contract.CallerAddress
+	job, err := p.cronKeeper.CancelJob(ctx, sdk.AccAddress(caller.Bytes()), id)
+	if err != nil {
+		return nil, err
+	}
+
+	if job.Bond.IsPositive() {
+		// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB.
+		scaledAmt := evmtypes.ConvertAmountTo18DecimalsBigInt(job.Bond.BigInt())
+		p.SetBalanceChangeEntries(cmn.NewBalanceChangeEntry(caller, scaledAmt, cmn.Add))
+	}
+
+	return method.Outputs.Pack(true)
+}
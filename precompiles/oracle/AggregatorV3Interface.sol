@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @author Flora
+/// @title Oracle Price Feed Precompiled Contract
+/// @dev The Chainlink AggregatorV3Interface served by the price feed of every
+/// pair of the oracle module. The address of a feed is derived from the pair
+/// key and returned by the `feed` query of the module. Only the latest round is
+/// kept, and `latestRoundData` reverts once the price is older than the max
+/// staleness of the pair.
+interface AggregatorV3Interface {
+    /// @dev Returns the number of decimals of the answers.
+    function decimals() external view returns (uint8);
+
+    /// @dev Returns the key of the pair, e.g. ETH/USD.
+    function description() external view returns (string memory);
+
+    /// @dev Returns the version of the price feed.
+    function version() external view returns (uint256);
+
+    /// @dev Returns the data of a round. Reverts for rounds other than the
+    /// latest one.
+    /// @param _roundId The identifier of the round.
+    function getRoundData(
+        uint80 _roundId
+    )
+        external
+        view
+        returns (
+            uint80 roundId,
+            int256 answer,
+            uint256 startedAt,
+            uint256 updatedAt,
+            uint80 answeredInRound
+        );
+
+    /// @dev Returns the data of the latest round.
+    function latestRoundData()
+        external
+        view
+        returns (
+            uint80 roundId,
+            int256 answer,
+            uint256 startedAt,
+            uint256 updatedAt,
+            uint80 answeredInRound
+        );
+}
@@ -0,0 +1,134 @@
+package oracle
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/x/vm/core/vm"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	oraclekeeper "github.com/rollchains/flora/x/oracle/keeper"
+	oracletypes "github.com/rollchains/flora/x/oracle/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the price feed of an oracle pair, implementing the
+// Chainlink AggregatorV3Interface. It is instantiated on demand at the feed
+// address of the pair.
+type Precompile struct {
+	cmn.Precompile
+	oracleKeeper oraclekeeper.Keeper
+	key          string
+}
+
+// LoadABI loads the AggregatorV3Interface ABI from the embedded abi.json file.
+func LoadABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, "abi.json")
+}
+
+// NewPrecompile creates a new price feed Precompile instance for a pair as a
+// PrecompiledContract interface.
+func NewPrecompile(oracleKeeper oraclekeeper.Keeper, key string) (*Precompile, error) {
+	abi, err := LoadABI()
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  abi,
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+		},
+		oracleKeeper: oracleKeeper,
+		key:          key,
+	}
+
+	p.SetAddress(oracletypes.FeedAddress(key))
+
+	return p, nil
+}
+
+// RequiredGas calculates the precompiled contract's base gas rate.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method))
+}
+
+// Run executes the precompiled contract price feed methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, stateDB, snapshot, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	switch method.Name {
+	case DecimalsMethod:
+		bz, err = p.Decimals(ctx, method, args)
+	case DescriptionMethod:
+		bz, err = p.Description(ctx, method, args)
+	case VersionMethod:
+		bz, err = p.Version(ctx, method, args)
+	case GetRoundDataMethod:
+		bz, err = p.GetRoundData(ctx, method, args)
+	case LatestRoundDataMethod:
+		bz, err = p.LatestRoundData(ctx, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	if err := p.AddJournalEntries(stateDB, snapshot); err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+// All the price feed methods are queries.
+func (Precompile) IsTransaction(*abi.Method) bool {
+	return false
+}
+
+// Logger returns a precompile-specific logger.
+func (p Precompile) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("evm extension", "oracle", "pair", p.key)
+}
@@ -0,0 +1,115 @@
+package oracle
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	oracletypes "github.com/rollchains/flora/x/oracle/types"
+)
+
+const (
+	// DecimalsMethod defines the ABI method name for the decimals query.
+	DecimalsMethod = "decimals"
+	// DescriptionMethod defines the ABI method name for the description query.
+	DescriptionMethod = "description"
+	// VersionMethod defines the ABI method name for the version query.
+	VersionMethod = "version"
+	// GetRoundDataMethod defines the ABI method name for the getRoundData
+	// query.
+	GetRoundDataMethod = "getRoundData"
+	// LatestRoundDataMethod defines the ABI method name for the
+	// latestRoundData query.
+	LatestRoundDataMethod = "latestRoundData"
+
+	// FeedVersion is the version returned by the price feeds.
+	FeedVersion = 1
+)
+
+// Decimals returns the number of decimals of the answers.
+func (p Precompile) Decimals(ctx sdk.Context, method *abi.Method, _ []interface{}) ([]byte, error) {
+	pair, err := p.pair(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(uint8(pair.Decimals))
+}
+
+// Description returns the key of the pair.
+func (p Precompile) Description(_ sdk.Context, method *abi.Method, _ []interface{}) ([]byte, error) {
+	return method.Outputs.Pack(p.key)
+}
+
+// Version returns the version of the price feed.
+func (p Precompile) Version(_ sdk.Context, method *abi.Method, _ []interface{}) ([]byte, error) {
+	return method.Outputs.Pack(big.NewInt(FeedVersion))
+}
+
+// GetRoundData returns the data of a round, only the latest round is kept.
+func (p Precompile) GetRoundData(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	roundID, ok := args[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("invalid round id: %v", args[0])
+	}
+
+	pair, err := p.pair(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	median, err := p.oracleKeeper.GetMedian(ctx, p.key)
+	if err != nil {
+		return nil, err
+	}
+	if !roundID.IsUint64() || roundID.Uint64() != median.Round {
+		return nil, fmt.Errorf("no data present for round %s", roundID)
+	}
+
+	return packRound(method, pair, median)
+}
+
+// LatestRoundData returns the data of the latest round, reverting when the
+// price is stale.
+func (p Precompile) LatestRoundData(ctx sdk.Context, method *abi.Method, _ []interface{}) ([]byte, error) {
+	pair, median, err := p.oracleKeeper.GetPrice(ctx, p.key)
+	if err != nil {
+		return nil, err
+	}
+
+	return packRound(method, pair, median)
+}
+
+func (p Precompile) pair(ctx sdk.Context) (oracletypes.Pair, error) {
+	params, err := p.oracleKeeper.GetParams(ctx)
+	if err != nil {
+		return oracletypes.Pair{}, err
+	}
+
+	pair, found := params.GetPair(p.key)
+	if !found {
+		return oracletypes.Pair{}, oracletypes.ErrPairNotFound.Wrap(p.key)
+	}
+	return pair, nil
+}
+
+// packRound packs a round, the answer is the price scaled to the decimals of
+// the pair.
+func packRound(method *abi.Method, pair oracletypes.Pair, median oracletypes.Median) ([]byte, error) {
+	round := new(big.Int).SetUint64(median.Round)
+	answer := median.Value.MulInt(math.NewIntWithDecimal(1, int(pair.Decimals))).TruncateInt().BigInt()
+	updatedAt := big.NewInt(median.Timestamp.Unix())
+
+	return method.Outputs.Pack(round, answer, updatedAt, updatedAt, round)
+}
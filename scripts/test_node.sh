@@ -17,6 +17,8 @@ export KEYRING=${KEYRING:-"test"}
 export HOME_DIR=$(eval echo "${HOME_DIR:-"~/.flora"}")
 export BINARY=${BINARY:-florad}
 export DENOM=${DENOM:-petal}
+export DISPLAY_DENOM=${DISPLAY_DENOM:-flora}
+export DECIMALS=${DECIMALS:-18}
 
 export CLEAN=${CLEAN:-"false"}
 export RPC=${RPC:-"26657"}
@@ -89,12 +91,14 @@ from_scratch () {
   update_test_genesis '.app_state["gov"]["params"]["voting_period"]="30s"'
   update_test_genesis '.app_state["gov"]["params"]["expedited_voting_period"]="15s"'
 
+  # Bank
+  $BINARY genesis bank add-denom-metadata $DENOM $DISPLAY_DENOM $DECIMALS --home $HOME_DIR
+
+  # EVM
   update_test_genesis `printf '.app_state["evm"]["params"]["evm_denom"]="%s"' $DENOM`
-  update_test_genesis '.app_state["evm"]["params"]["active_static_precompiles"]=["0x0000000000000000000000000000000000000100","0x0000000000000000000000000000000000000400","0x0000000000000000000000000000000000000800","0x0000000000000000000000000000000000000801","0x0000000000000000000000000000000000000802","0x0000000000000000000000000000000000000803","0x0000000000000000000000000000000000000804","0x0000000000000000000000000000000000000805"]'
-  update_test_genesis '.app_state["erc20"]["params"]["native_precompiles"]=["0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE"]' # https://eips.ethereum.org/EIPS/eip-7528
-  update_test_genesis `printf '.app_state["erc20"]["token_pairs"]=[{contract_owner:1,erc20_address:"0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE",denom:"%s",enabled:true}]' $DENOM`
-  update_test_genesis '.app_state["feemarket"]["params"]["no_base_fee"]=true'
-  update_test_genesis '.app_state["feemarket"]["params"]["base_fee"]="0.000000000000000000"'
+  $BINARY genesis evm set-precompiles 0x0000000000000000000000000000000000000100 0x0000000000000000000000000000000000000400 0x0000000000000000000000000000000000000800 0x0000000000000000000000000000000000000801 0x0000000000000000000000000000000000000802 0x0000000000000000000000000000000000000803 0x0000000000000000000000000000000000000804 0x0000000000000000000000000000000000000805 --home $HOME_DIR
+  $BINARY genesis erc20 add-token-pair $DENOM 0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE --home $HOME_DIR # https://eips.ethereum.org/EIPS/eip-7528
+  $BINARY genesis feemarket set-params --no-base-fee --base-fee 0 --home $HOME_DIR
 
   # staking
   update_test_genesis `printf '.app_state["staking"]["params"]["bond_denom"]="%s"' $DENOM`
@@ -114,6 +118,9 @@ from_scratch () {
   update_test_genesis '.app_state["tokenfactory"]["params"]["denom_creation_fee"]=[]'
   update_test_genesis '.app_state["tokenfactory"]["params"]["denom_creation_gas_consume"]=100000'
 
+  # contractaccounts
+  update_test_genesis '.app_state["contractaccounts"]["params"]["passkey_rp_id"]="localhost"'
+
 
   BASE_GENESIS_ALLOCATIONS="100000000000000000000000000$DENOM,100000000test"
 
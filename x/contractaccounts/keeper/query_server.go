@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/rollchains/flora/x/contractaccounts/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier implements the module gRPC query service.
+type Querier struct {
+	Keeper
+}
+
+// NewQuerier returns an implementation of the module QueryServer interface.
+func NewQuerier(keeper Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Params implements types.QueryServer.
+func (q Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
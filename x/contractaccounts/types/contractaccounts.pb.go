@@ -0,0 +1,364 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: flora/contractaccounts/v1/contractaccounts.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the contractaccounts module.
+type Params struct {
+	// signature_gas_cap is the gas available to the isValidSignature call
+	// verifying the signature of a contract account, the gas used is consumed
+	// by the tx.
+	SignatureGasCap uint64 `protobuf:"varint,1,opt,name=signature_gas_cap,json=signatureGasCap,proto3" json:"signature_gas_cap,omitempty"`
+	// passkey_rp_id is the WebAuthn relying party id of the passkeys signing
+	// the txs, the domain of the wallets registering them. The passkeys can't
+	// sign when it is empty.
+	PasskeyRpId string `protobuf:"bytes,2,opt,name=passkey_rp_id,json=passkeyRpId,proto3" json:"passkey_rp_id,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aef1494f71da87a, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetSignatureGasCap() uint64 {
+	if m != nil {
+		return m.SignatureGasCap
+	}
+	return 0
+}
+
+func (m *Params) GetPasskeyRpId() string {
+	if m != nil {
+		return m.PasskeyRpId
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "flora.contractaccounts.v1.Params")
+}
+
+func init() {
+	proto.RegisterFile("flora/contractaccounts/v1/contractaccounts.proto", fileDescriptor_6aef1494f71da87a)
+}
+
+var fileDescriptor_6aef1494f71da87a = []byte{
+	// 236 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x48, 0xcb, 0xc9, 0x2f,
+	0x4a, 0xd4, 0x4f, 0xce, 0xcf, 0x2b, 0x29, 0x4a, 0x4c, 0x2e, 0x49, 0x4c, 0x4e, 0xce, 0x2f, 0xcd,
+	0x2b, 0x29, 0xd6, 0x2f, 0x33, 0xc4, 0x10, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x04,
+	0xeb, 0xd0, 0xc3, 0x90, 0x2d, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93,
+	0x10, 0xd5, 0x4a, 0x35, 0x5c, 0x6c, 0x01, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x42, 0x5a, 0x5c, 0x82,
+	0xc5, 0x99, 0xe9, 0x79, 0x89, 0x25, 0xa5, 0x45, 0xa9, 0xf1, 0xe9, 0x89, 0xc5, 0xf1, 0xc9, 0x89,
+	0x05, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x2c, 0x41, 0xfc, 0x70, 0x09, 0xf7, 0xc4, 0x62, 0xe7, 0xc4,
+	0x02, 0x21, 0x25, 0x2e, 0xde, 0x82, 0xc4, 0xe2, 0xe2, 0xec, 0xd4, 0xca, 0xf8, 0xa2, 0x82, 0xf8,
+	0xcc, 0x14, 0x09, 0x26, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x6e, 0xa8, 0x60, 0x50, 0x81, 0x67, 0x8a,
+	0x95, 0x52, 0xd7, 0xf3, 0x0d, 0x5a, 0xb2, 0x38, 0x9c, 0x0f, 0xb1, 0xd3, 0xc9, 0xef, 0xc4, 0x23,
+	0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2,
+	0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x4c, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4,
+	0x92, 0xf3, 0x73, 0xf5, 0x8b, 0xf2, 0x73, 0x72, 0x92, 0x33, 0x12, 0x33, 0xf3, 0x8a, 0xf5, 0x21,
+	0xc6, 0x55, 0x60, 0x1a, 0x58, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xf6, 0x94, 0x31, 0x20,
+	0x00, 0x00, 0xff, 0xff, 0x0d, 0x4c, 0xfc, 0x07, 0x36, 0x01, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PasskeyRpId) > 0 {
+		i -= len(m.PasskeyRpId)
+		copy(dAtA[i:], m.PasskeyRpId)
+		i = encodeVarintContractaccounts(dAtA, i, uint64(len(m.PasskeyRpId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SignatureGasCap != 0 {
+		i = encodeVarintContractaccounts(dAtA, i, uint64(m.SignatureGasCap))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintContractaccounts(dAtA []byte, offset int, v uint64) int {
+	offset -= sovContractaccounts(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignatureGasCap != 0 {
+		n += 1 + sovContractaccounts(uint64(m.SignatureGasCap))
+	}
+	l = len(m.PasskeyRpId)
+	if l > 0 {
+		n += 1 + l + sovContractaccounts(uint64(l))
+	}
+	return n
+}
+
+func sovContractaccounts(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozContractaccounts(x uint64) (n int) {
+	return sovContractaccounts(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowContractaccounts
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureGasCap", wireType)
+			}
+			m.SignatureGasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractaccounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignatureGasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PasskeyRpId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractaccounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContractaccounts
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractaccounts
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PasskeyRpId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipContractaccounts(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthContractaccounts
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipContractaccounts(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowContractaccounts
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowContractaccounts
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowContractaccounts
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthContractaccounts
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupContractaccounts
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthContractaccounts
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthContractaccounts        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowContractaccounts          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupContractaccounts = fmt.Errorf("proto: unexpected end of group")
+)
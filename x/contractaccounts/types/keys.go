@@ -0,0 +1,14 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "contractaccounts"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+// ParamsKey saves the current module params.
+var ParamsKey = collections.NewPrefix(0)
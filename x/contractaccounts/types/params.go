@@ -0,0 +1,35 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultSignatureGasCap is the default gas cap of the isValidSignature calls.
+const DefaultSignatureGasCap uint64 = 200_000
+
+// DefaultParams returns default module parameters, the passkeys can't sign
+// until the relying party id is set.
+func DefaultParams() Params {
+	return NewParams(DefaultSignatureGasCap, "")
+}
+
+// NewParams creates a new Params instance.
+func NewParams(signatureGasCap uint64, passkeyRPID string) Params {
+	return Params{
+		SignatureGasCap: signatureGasCap,
+		PasskeyRpId:     passkeyRPID,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if p.SignatureGasCap == 0 {
+		return fmt.Errorf("signature gas cap must be positive")
+	}
+	if strings.ContainsAny(p.PasskeyRpId, ":/ ") {
+		return fmt.Errorf("passkey rp id %s must be a domain", p.PasskeyRpId)
+	}
+
+	return nil
+}
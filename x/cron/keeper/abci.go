@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/rollchains/flora/x/cron/types"
+)
+
+// EndBlocker executes the due jobs, in order of their scheduled height and
+// identifier, as long as their gas limit fits in the remaining block gas
+// budget. The jobs which don't fit are deferred to the next block, where they
+// keep their place ahead of the jobs scheduled later, without holding back the
+// smaller jobs after them.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	height := uint64(sdkCtx.BlockHeight()) //nolint:gosec // G115
+	rng := new(collections.Range[collections.Pair[uint64, uint64]]).
+		EndInclusive(collections.Join(height, uint64(math.MaxUint64)))
+	iter, err := k.Schedule.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	due, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	budget := params.MaxBlockGas
+	for _, key := range due {
+		job, err := k.Jobs.Get(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+		if job.GasLimit > budget {
+			continue
+		}
+		budget -= job.GasLimit
+
+		if err := k.executeJob(sdkCtx, params, job); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// executeJob runs the job and reschedules it. A failed execution doesn't alter
+// the state besides the fee of the gas it used and the failure counter of the
+// job, which is removed once it reaches the max consecutive failures.
+func (k Keeper) executeJob(ctx sdk.Context, params types.Params, job types.Job) error {
+	if err := k.Schedule.Remove(ctx, collections.Join(job.NextHeight, job.Id)); err != nil {
+		return err
+	}
+
+	id := strconv.FormatUint(job.Id, 10)
+	gasUsed, fee, err := k.runJob(ctx, job)
+	if err != nil {
+		job.ConsecutiveFailures++
+		k.Logger(ctx).Debug("cron job failed", "id", job.Id, "err", err)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeJobFailed,
+			sdk.NewAttribute(types.AttributeKeyJobID, id),
+			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			sdk.NewAttribute(types.AttributeKeyFailures, strconv.FormatUint(uint64(job.ConsecutiveFailures), 10)),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		))
+
+		if job.ConsecutiveFailures >= params.MaxConsecutiveFailures {
+			return k.removeJob(ctx, job, types.RemovalReasonFailures)
+		}
+	} else {
+		job.ConsecutiveFailures = 0
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeJobExecuted,
+			sdk.NewAttribute(types.AttributeKeyJobID, id),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		))
+	}
+
+	job.NextHeight = uint64(ctx.BlockHeight()) + job.Interval //nolint:gosec // G115
+	return k.setJob(ctx, job)
+}
+
+// runJob calls the contract of the job from the module account in a cached
+// context, and charges the gas used to the fee payer. The cached writes are only
+// committed when both the call and the payment succeed. The gas used by a
+// failed call is charged too, only the writes of the call are discarded.
+func (k Keeper) runJob(ctx sdk.Context, job types.Job) (gasUsed uint64, fee sdk.Coins, err error) {
+	cacheCtx, write := ctx.CacheContext()
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job execution panicked: %v", r)
+		}
+	}()
+
+	moduleAcc := k.accountKeeper.GetModuleAccount(cacheCtx, types.ModuleName)
+	to := job.ContractAddress()
+	msg := ethtypes.NewMessage(
+		common.BytesToAddress(moduleAcc.GetAddress()),
+		&to,
+		moduleAcc.GetSequence(),
+		big.NewInt(0), // amount
+		job.GasLimit,
+		big.NewInt(0), // gasPrice
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		job.Calldata,
+		ethtypes.AccessList{},
+		false, // isFake
+	)
+
+	res, err := k.evmKeeper.ApplyMessage(cacheCtx, msg, evmtypes.NewNoOpTracer(), true)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var callErr error
+	if res.Failed() {
+		callErr = errors.New(res.VmError)
+		cacheCtx, write = ctx.CacheContext()
+	}
+
+	fee = k.executionFee(cacheCtx, res.GasUsed)
+	if err := k.chargeFee(cacheCtx, job, moduleAcc.GetAddress(), fee); err != nil {
+		return res.GasUsed, nil, errors.Join(callErr, err)
+	}
+
+	write()
+	return res.GasUsed, fee, callErr
+}
+
+// executionFee prices the gas used at the current base fee.
+func (k Keeper) executionFee(ctx sdk.Context, gasUsed uint64) sdk.Coins {
+	baseFee := k.feeMarketKeeper.GetBaseFee(ctx)
+	if baseFee.IsNil() || !baseFee.IsPositive() {
+		return sdk.Coins{}
+	}
+
+	amount := baseFee.MulInt64(int64(gasUsed)).Ceil().TruncateInt() //nolint:gosec // G115
+	return sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), amount))
+}
+
+// chargeFee sends the fee from the fee payer of the job to the fee collector.
+// Unless the contract pays for its own job, the fee is deducted from a fee
+// allowance granted by the fee payer to the module account.
+func (k Keeper) chargeFee(ctx sdk.Context, job types.Job, moduleAddr sdk.AccAddress, fee sdk.Coins) error {
+	if fee.IsZero() {
+		return nil
+	}
+
+	feePayer, err := sdk.AccAddressFromBech32(job.FeePayer)
+	if err != nil {
+		return err
+	}
+
+	if !feePayer.Equals(sdk.AccAddress(job.ContractAddress().Bytes())) {
+		if err := k.feegrantKeeper.UseGrantedFees(ctx, feePayer, moduleAddr, fee, nil); err != nil {
+			return types.ErrFeeNotPaid.Wrap(err.Error())
+		}
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, feePayer, authtypes.FeeCollectorName, fee); err != nil {
+		return types.ErrFeeNotPaid.Wrap(err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/rollchains/flora/x/cron/types"
+)
+
+// InitGenesis initializes the module state from a genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error {
+	if err := k.Params.Set(ctx, gs.Params); err != nil {
+		return err
+	}
+
+	for _, job := range gs.Jobs {
+		if err := k.setJob(ctx, job); err != nil {
+			return err
+		}
+	}
+
+	return k.NextJobID.Set(ctx, gs.NextJobId)
+}
+
+// ExportGenesis exports the module state to a genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := k.Jobs.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	jobs, err := iter.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	nextJobID, err := k.NextJobID.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(params, jobs, nextJobID), nil
+}
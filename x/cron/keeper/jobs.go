@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/rollchains/flora/x/cron/types"
+)
+
+// GetJob returns the job with the given identifier.
+func (k Keeper) GetJob(ctx context.Context, id uint64) (types.Job, error) {
+	job, err := k.Jobs.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Job{}, types.ErrJobNotFound.Wrapf("id %d", id)
+	}
+	return job, err
+}
+
+// AddJob schedules the job for its first execution in interval blocks and
+// returns its identifier. The bond of the job must already be held by the
+// module account.
+func (k Keeper) AddJob(ctx context.Context, job types.Job) (uint64, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if job.GasLimit > params.MaxJobGasLimit {
+		return 0, types.ErrGasLimitTooHigh.Wrapf("%d > %d", job.GasLimit, params.MaxJobGasLimit)
+	}
+	if err := job.Validate(); err != nil {
+		return 0, err
+	}
+
+	id, err := k.NextJobID.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	job.Id = id
+	job.NextHeight = uint64(sdkCtx.BlockHeight()) + job.Interval //nolint:gosec // G115
+	job.ConsecutiveFailures = 0
+	if err := k.setJob(ctx, job); err != nil {
+		return 0, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeJobAdded,
+		sdk.NewAttribute(types.AttributeKeyJobID, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.AttributeKeyContract, job.Contract),
+		sdk.NewAttribute(types.AttributeKeyOwner, job.Owner),
+	))
+
+	return id, nil
+}
+
+// RegisterContractJob schedules a call of the contract into itself. The
+// contract owns the job, pays for its executions and bonds the min bond of the
+// module params.
+func (k Keeper) RegisterContractJob(ctx context.Context, contract common.Address, calldata []byte, interval, gasLimit uint64) (types.Job, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.Job{}, err
+	}
+
+	owner := sdk.AccAddress(contract.Bytes())
+	if params.MinBond.IsPositive() {
+		bond := sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), params.MinBond))
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, bond); err != nil {
+			return types.Job{}, err
+		}
+	}
+
+	job := types.Job{
+		Owner:    owner.String(),
+		Contract: contract.Hex(),
+		Calldata: calldata,
+		Interval: interval,
+		GasLimit: gasLimit,
+		FeePayer: owner.String(),
+		Bond:     params.MinBond,
+	}
+	id, err := k.AddJob(ctx, job)
+	if err != nil {
+		return types.Job{}, err
+	}
+
+	return k.GetJob(ctx, id)
+}
+
+// CancelJob removes a job on behalf of its owner and refunds its bond.
+func (k Keeper) CancelJob(ctx context.Context, sender sdk.AccAddress, id uint64) (types.Job, error) {
+	job, err := k.GetJob(ctx, id)
+	if err != nil {
+		return types.Job{}, err
+	}
+	if job.Owner != sender.String() {
+		return types.Job{}, types.ErrNotJobOwner.Wrapf("job %d is owned by %s", id, job.Owner)
+	}
+
+	return job, k.removeJob(ctx, job, types.RemovalReasonCancelled)
+}
+
+// RemoveJob removes a job and refunds its bond.
+func (k Keeper) RemoveJob(ctx context.Context, id uint64) error {
+	job, err := k.GetJob(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return k.removeJob(ctx, job, types.RemovalReasonCancelled)
+}
+
+// removeJob deletes the job. The bond is refunded to the owner unless the job
+// is removed for failing, in which case it funds the community pool.
+func (k Keeper) removeJob(ctx context.Context, job types.Job, reason string) error {
+	if err := k.Schedule.Remove(ctx, collections.Join(job.NextHeight, job.Id)); err != nil {
+		return err
+	}
+	if err := k.Jobs.Remove(ctx, job.Id); err != nil {
+		return err
+	}
+
+	if job.Bond.IsPositive() {
+		bond := sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), job.Bond))
+		if reason == types.RemovalReasonFailures {
+			moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+			if err := k.distrKeeper.FundCommunityPool(ctx, bond, moduleAddr); err != nil {
+				return err
+			}
+		} else {
+			owner, err := sdk.AccAddressFromBech32(job.Owner)
+			if err != nil {
+				return err
+			}
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, bond); err != nil {
+				return err
+			}
+		}
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeJobRemoved,
+		sdk.NewAttribute(types.AttributeKeyJobID, strconv.FormatUint(job.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyReason, reason),
+	))
+
+	return nil
+}
+
+// setJob stores the job and indexes it at its next height.
+func (k Keeper) setJob(ctx context.Context, job types.Job) error {
+	if err := k.Jobs.Set(ctx, job.Id, job); err != nil {
+		return err
+	}
+	return k.Schedule.Set(ctx, collections.Join(job.NextHeight, job.Id))
+}
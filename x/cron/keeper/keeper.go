@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/rollchains/flora/x/cron/types"
+)
+
+// Keeper of the cron store.
+type Keeper struct {
+	cdc codec.BinaryCodec
+
+	accountKeeper   types.AccountKeeper
+	bankKeeper      types.BankKeeper
+	feegrantKeeper  types.FeegrantKeeper
+	distrKeeper     types.DistrKeeper
+	evmKeeper       types.EVMKeeper
+	feeMarketKeeper types.FeeMarketKeeper
+
+	// the address capable of executing the authority gated messages. Typically,
+	// this should be the x/gov module account.
+	authority string
+
+	Schema    collections.Schema
+	Params    collections.Item[types.Params]
+	Jobs      collections.Map[uint64, types.Job]
+	NextJobID collections.Sequence
+	// Schedule indexes the jobs by (next height, id).
+	Schedule collections.KeySet[collections.Pair[uint64, uint64]]
+}
+
+// NewKeeper creates a new cron Keeper instance.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService storetypes.KVStoreService,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	feegrantKeeper types.FeegrantKeeper,
+	distrKeeper types.DistrKeeper,
+	evmKeeper types.EVMKeeper,
+	feeMarketKeeper types.FeeMarketKeeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := Keeper{
+		cdc:             cdc,
+		accountKeeper:   accountKeeper,
+		bankKeeper:      bankKeeper,
+		feegrantKeeper:  feegrantKeeper,
+		distrKeeper:     distrKeeper,
+		evmKeeper:       evmKeeper,
+		feeMarketKeeper: feeMarketKeeper,
+		authority:       authority,
+		Params:          collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Jobs:            collections.NewMap(sb, types.JobsKey, "jobs", collections.Uint64Key, codec.CollValue[types.Job](cdc)),
+		NextJobID:       collections.NewSequence(sb, types.NextJobIDKey, "next_job_id"),
+		Schedule: collections.NewKeySet(
+			sb, types.ScheduleKey, "schedule",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key),
+		),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return sdk.UnwrapSDKContext(ctx).Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the module params, falling back to the defaults when they
+// have not been set yet.
+func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
+	params, err := k.Params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DefaultParams(), nil
+	}
+	return params, err
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/rollchains/flora/x/cron/types"
+)
+
+type msgServer struct {
+	k Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the module MsgServer interface.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{k: keeper}
+}
+
+// AddJob implements types.MsgServer.
+func (ms msgServer) AddJob(ctx context.Context, msg *types.MsgAddJob) (*types.MsgAddJobResponse, error) {
+	if ms.k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.k.authority, msg.Authority)
+	}
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	id, err := ms.k.AddJob(ctx, types.Job{
+		Owner:    msg.Authority,
+		Contract: msg.Contract,
+		Calldata: msg.Calldata,
+		Interval: msg.Interval,
+		GasLimit: msg.GasLimit,
+		FeePayer: msg.FeePayer,
+		Bond:     math.ZeroInt(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddJobResponse{Id: id}, nil
+}
+
+// RemoveJob implements types.MsgServer.
+func (ms msgServer) RemoveJob(ctx context.Context, msg *types.MsgRemoveJob) (*types.MsgRemoveJobResponse, error) {
+	if ms.k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.k.authority, msg.Authority)
+	}
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.RemoveJob(ctx, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveJobResponse{}, nil
+}
+
+// UpdateParams implements types.MsgServer.
+func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.k.authority, msg.Authority)
+	}
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/rollchains/flora/x/cron/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier implements the module gRPC query service.
+type Querier struct {
+	Keeper
+}
+
+// NewQuerier returns an implementation of the module QueryServer interface.
+func NewQuerier(keeper Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Params implements types.QueryServer.
+func (q Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Job implements types.QueryServer.
+func (q Querier) Job(ctx context.Context, req *types.QueryJobRequest) (*types.QueryJobResponse, error) {
+	job, err := q.GetJob(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryJobResponse{Job: job}, nil
+}
+
+// Jobs implements types.QueryServer.
+func (q Querier) Jobs(ctx context.Context, req *types.QueryJobsRequest) (*types.QueryJobsResponse, error) {
+	jobs, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.Jobs, req.Pagination,
+		func(_ uint64, job types.Job) (types.Job, error) {
+			return job, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryJobsResponse{Jobs: jobs, Pagination: pageRes}, nil
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the necessary interfaces and concrete types
+// on the provided LegacyAmino codec.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgAddJob{}, "flora/x/cron/MsgAddJob")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveJob{}, "flora/x/cron/MsgRemoveJob")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "flora/x/cron/MsgUpdateParams")
+	cdc.RegisterConcrete(&Params{}, "flora/x/cron/Params", nil)
+}
+
+// RegisterInterfaces registers the module interface types.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgAddJob{},
+		&MsgRemoveJob{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,1012 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: flora/cron/v1/cron.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the cron module.
+type Params struct {
+	// max_job_gas_limit is the highest gas limit a single job can be scheduled
+	// with.
+	MaxJobGasLimit uint64 `protobuf:"varint,1,opt,name=max_job_gas_limit,json=maxJobGasLimit,proto3" json:"max_job_gas_limit,omitempty"`
+	// max_block_gas is the total gas limit of the jobs executed in a block. Due
+	// jobs which don't fit are deferred to the next block.
+	MaxBlockGas uint64 `protobuf:"varint,2,opt,name=max_block_gas,json=maxBlockGas,proto3" json:"max_block_gas,omitempty"`
+	// min_bond is the amount of EVM coins a contract has to bond to register a
+	// job through the precompile.
+	MinBond cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=min_bond,json=minBond,proto3,customtype=cosmossdk.io/math.Int" json:"min_bond"`
+	// max_consecutive_failures is the number of consecutive failed executions
+	// after which a job is removed. The bond of a removed job is sent to the
+	// community pool.
+	MaxConsecutiveFailures uint32 `protobuf:"varint,4,opt,name=max_consecutive_failures,json=maxConsecutiveFailures,proto3" json:"max_consecutive_failures,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9d040b1683d30dd, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxJobGasLimit() uint64 {
+	if m != nil {
+		return m.MaxJobGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBlockGas() uint64 {
+	if m != nil {
+		return m.MaxBlockGas
+	}
+	return 0
+}
+
+func (m *Params) GetMaxConsecutiveFailures() uint32 {
+	if m != nil {
+		return m.MaxConsecutiveFailures
+	}
+	return 0
+}
+
+// Job defines a contract call executed every interval blocks.
+type Job struct {
+	// id is the unique identifier of the job.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the account which can cancel the job: the governance account for
+	// approved jobs, the registering contract for precompile jobs.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// contract is the hex address of the called contract.
+	Contract string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	// calldata is the input of the contract call.
+	Calldata []byte `protobuf:"bytes,4,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// interval is the number of blocks between two executions.
+	Interval uint64 `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	// gas_limit is the gas limit of a single execution.
+	GasLimit uint64 `protobuf:"varint,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// fee_payer is the account paying for the gas used by the executions.
+	FeePayer string `protobuf:"bytes,7,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	// bond is the amount of EVM coins bonded by the owner.
+	Bond cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=bond,proto3,customtype=cosmossdk.io/math.Int" json:"bond"`
+	// next_height is the height of the next execution.
+	NextHeight uint64 `protobuf:"varint,9,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+	// consecutive_failures is the number of failed executions since the last
+	// successful one.
+	ConsecutiveFailures uint32 `protobuf:"varint,10,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+}
+
+func (m *Job) Reset()         { *m = Job{} }
+func (m *Job) String() string { return proto.CompactTextString(m) }
+func (*Job) ProtoMessage()    {}
+func (*Job) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9d040b1683d30dd, []int{1}
+}
+func (m *Job) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Job) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Job.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Job) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Job.Merge(m, src)
+}
+func (m *Job) XXX_Size() int {
+	return m.Size()
+}
+func (m *Job) XXX_DiscardUnknown() {
+	xxx_messageInfo_Job.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Job proto.InternalMessageInfo
+
+func (m *Job) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Job) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Job) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *Job) GetCalldata() []byte {
+	if m != nil {
+		return m.Calldata
+	}
+	return nil
+}
+
+func (m *Job) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *Job) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *Job) GetFeePayer() string {
+	if m != nil {
+		return m.FeePayer
+	}
+	return ""
+}
+
+func (m *Job) GetNextHeight() uint64 {
+	if m != nil {
+		return m.NextHeight
+	}
+	return 0
+}
+
+func (m *Job) GetConsecutiveFailures() uint32 {
+	if m != nil {
+		return m.ConsecutiveFailures
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "flora.cron.v1.Params")
+	proto.RegisterType((*Job)(nil), "flora.cron.v1.Job")
+}
+
+func init() { proto.RegisterFile("flora/cron/v1/cron.proto", fileDescriptor_d9d040b1683d30dd) }
+
+var fileDescriptor_d9d040b1683d30dd = []byte{
+	// 524 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0x41, 0x6f, 0xd3, 0x3e,
+	0x1c, 0x6d, 0xda, 0xae, 0x6b, 0xbd, 0x7f, 0x27, 0xcd, 0xeb, 0x1f, 0x99, 0x22, 0xa5, 0x55, 0x4f,
+	0x65, 0xd2, 0x12, 0x2a, 0x84, 0x84, 0xb8, 0x11, 0x10, 0x63, 0x83, 0xc3, 0x14, 0x6e, 0x5c, 0x22,
+	0x27, 0x71, 0x53, 0xb3, 0xd8, 0xae, 0x6c, 0xb7, 0x64, 0x9f, 0x00, 0x89, 0x13, 0x1f, 0x83, 0xe3,
+	0x0e, 0xfb, 0x10, 0x3b, 0x4e, 0x3b, 0x21, 0x0e, 0x13, 0x6a, 0x0f, 0xfb, 0x0a, 0x1c, 0x51, 0xec,
+	0xd2, 0x09, 0x81, 0x84, 0xc4, 0x25, 0xc9, 0xef, 0xbd, 0x17, 0xf9, 0xbd, 0xdf, 0x33, 0x40, 0xe3,
+	0x5c, 0x48, 0xec, 0x27, 0x52, 0x70, 0x7f, 0x3e, 0x32, 0x6f, 0x6f, 0x2a, 0x85, 0x16, 0xb0, 0x6d,
+	0x18, 0xcf, 0x20, 0xf3, 0x51, 0xb7, 0x93, 0x89, 0x4c, 0x18, 0xc6, 0x2f, 0xbf, 0xac, 0xa8, 0x7b,
+	0x37, 0x11, 0x8a, 0x09, 0x15, 0x59, 0xc2, 0x0e, 0x2b, 0x6a, 0x07, 0x33, 0xca, 0x85, 0x6f, 0x9e,
+	0x16, 0x1a, 0x7c, 0x77, 0x40, 0xe3, 0x18, 0x4b, 0xcc, 0x14, 0xbc, 0x0f, 0x76, 0x18, 0x2e, 0xa2,
+	0x77, 0x22, 0x8e, 0x32, 0xac, 0xa2, 0x9c, 0x32, 0xaa, 0x91, 0xd3, 0x77, 0x86, 0xf5, 0x70, 0x9b,
+	0xe1, 0xe2, 0x48, 0xc4, 0x07, 0x58, 0xbd, 0x2e, 0x51, 0x38, 0x00, 0xed, 0x52, 0x1a, 0xe7, 0x22,
+	0x39, 0x29, 0xc5, 0xa8, 0x6a, 0x64, 0x5b, 0x0c, 0x17, 0x41, 0x89, 0x1d, 0x60, 0x05, 0x5f, 0x81,
+	0x26, 0xa3, 0x3c, 0x8a, 0x05, 0x4f, 0x51, 0xad, 0xef, 0x0c, 0x5b, 0xc1, 0x83, 0x8b, 0xeb, 0x5e,
+	0xe5, 0xeb, 0x75, 0xef, 0x7f, 0x6b, 0x4a, 0xa5, 0x27, 0x1e, 0x15, 0x3e, 0xc3, 0x7a, 0xe2, 0x1d,
+	0x72, 0x7d, 0x75, 0xbe, 0x0f, 0x56, 0x6e, 0x0f, 0xb9, 0xfe, 0x7c, 0x73, 0xb6, 0xe7, 0x84, 0x9b,
+	0x8c, 0xf2, 0x40, 0xf0, 0x14, 0x3e, 0x06, 0xa8, 0x3c, 0x30, 0x11, 0x5c, 0x91, 0x64, 0xa6, 0xe9,
+	0x9c, 0x44, 0x63, 0x4c, 0xf3, 0x99, 0x24, 0x0a, 0xd5, 0xfb, 0xce, 0xb0, 0x1d, 0xde, 0x61, 0xb8,
+	0x78, 0x76, 0x4b, 0xbf, 0x58, 0xb1, 0x4f, 0xd0, 0xc7, 0x9b, 0xb3, 0xbd, 0x5d, 0xbb, 0xd2, 0xc2,
+	0x2e, 0xd5, 0xe6, 0x1d, 0x7c, 0xa8, 0x81, 0xda, 0x91, 0x88, 0xe1, 0x36, 0xa8, 0xd2, 0x74, 0x15,
+	0xb4, 0x4a, 0x53, 0xe8, 0x81, 0x0d, 0xf1, 0x9e, 0x13, 0x69, 0x42, 0xb5, 0x02, 0x74, 0x75, 0xbe,
+	0xdf, 0x59, 0x19, 0x7b, 0x9a, 0xa6, 0x92, 0x28, 0xf5, 0x46, 0x4b, 0xca, 0xb3, 0xd0, 0xca, 0x60,
+	0x17, 0x34, 0x13, 0xc1, 0xb5, 0xc4, 0x89, 0xb6, 0x41, 0xc3, 0xf5, 0x6c, 0x38, 0x9c, 0xe7, 0x29,
+	0xd6, 0xd8, 0xf8, 0xfc, 0x2f, 0x5c, 0xcf, 0x25, 0x47, 0xb9, 0x26, 0x72, 0x8e, 0x73, 0xb4, 0x61,
+	0x4e, 0x5f, 0xcf, 0xf0, 0x1e, 0x68, 0xdd, 0x76, 0xd0, 0xb0, 0x64, 0xf6, 0x73, 0xfb, 0x8f, 0x40,
+	0x6b, 0x4c, 0x48, 0x34, 0xc5, 0xa7, 0x44, 0xa2, 0xcd, 0xbf, 0x98, 0x6c, 0x8e, 0x09, 0x39, 0x2e,
+	0x95, 0xf0, 0x39, 0xa8, 0x9b, 0x32, 0x9a, 0xff, 0x58, 0x86, 0xf9, 0x1b, 0xf6, 0xc0, 0x16, 0x27,
+	0x85, 0x8e, 0x26, 0x84, 0x66, 0x13, 0x8d, 0x5a, 0xc6, 0x1b, 0x28, 0xa1, 0x97, 0x06, 0x81, 0x23,
+	0xd0, 0xf9, 0x63, 0x4d, 0xc0, 0xd4, 0xb4, 0x9b, 0xfc, 0xde, 0x51, 0x10, 0x5c, 0x2c, 0x5c, 0xe7,
+	0x72, 0xe1, 0x3a, 0xdf, 0x16, 0xae, 0xf3, 0x69, 0xe9, 0x56, 0x2e, 0x97, 0x6e, 0xe5, 0xcb, 0xd2,
+	0xad, 0xbc, 0x1d, 0x66, 0x54, 0x4f, 0x66, 0xb1, 0x97, 0x08, 0xe6, 0x4b, 0x91, 0xe7, 0xc9, 0x04,
+	0x53, 0xae, 0xfc, 0x5f, 0xea, 0xd4, 0xa7, 0x53, 0xa2, 0xe2, 0x86, 0xb9, 0xcf, 0x0f, 0x7f, 0x04,
+	0x00, 0x00, 0xff, 0xff, 0x78, 0xd4, 0x52, 0xa8, 0x3e, 0x03, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxConsecutiveFailures != 0 {
+		i = encodeVarintCron(dAtA, i, uint64(m.MaxConsecutiveFailures))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MinBond.Size()
+		i -= size
+		if _, err := m.MinBond.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCron(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.MaxBlockGas != 0 {
+		i = encodeVarintCron(dAtA, i, uint64(m.MaxBlockGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxJobGasLimit != 0 {
+		i = encodeVarintCron(dAtA, i, uint64(m.MaxJobGasLimit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Job) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Job) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Job) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsecutiveFailures != 0 {
+		i = encodeVarintCron(dAtA, i, uint64(m.ConsecutiveFailures))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.NextHeight != 0 {
+		i = encodeVarintCron(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.Bond.Size()
+		i -= size
+		if _, err := m.Bond.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCron(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintCron(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintCron(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Interval != 0 {
+		i = encodeVarintCron(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Calldata) > 0 {
+		i -= len(m.Calldata)
+		copy(dAtA[i:], m.Calldata)
+		i = encodeVarintCron(dAtA, i, uint64(len(m.Calldata)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintCron(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintCron(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintCron(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCron(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCron(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxJobGasLimit != 0 {
+		n += 1 + sovCron(uint64(m.MaxJobGasLimit))
+	}
+	if m.MaxBlockGas != 0 {
+		n += 1 + sovCron(uint64(m.MaxBlockGas))
+	}
+	l = m.MinBond.Size()
+	n += 1 + l + sovCron(uint64(l))
+	if m.MaxConsecutiveFailures != 0 {
+		n += 1 + sovCron(uint64(m.MaxConsecutiveFailures))
+	}
+	return n
+}
+
+func (m *Job) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovCron(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCron(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovCron(uint64(l))
+	}
+	l = len(m.Calldata)
+	if l > 0 {
+		n += 1 + l + sovCron(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovCron(uint64(m.Interval))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovCron(uint64(m.GasLimit))
+	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovCron(uint64(l))
+	}
+	l = m.Bond.Size()
+	n += 1 + l + sovCron(uint64(l))
+	if m.NextHeight != 0 {
+		n += 1 + sovCron(uint64(m.NextHeight))
+	}
+	if m.ConsecutiveFailures != 0 {
+		n += 1 + sovCron(uint64(m.ConsecutiveFailures))
+	}
+	return n
+}
+
+func sovCron(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCron(x uint64) (n int) {
+	return sovCron(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCron
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxJobGasLimit", wireType)
+			}
+			m.MaxJobGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxJobGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockGas", wireType)
+			}
+			m.MaxBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBond", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCron
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCron
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsecutiveFailures", wireType)
+			}
+			m.MaxConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConsecutiveFailures |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCron(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCron
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Job) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCron
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Job: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Job: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCron
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCron
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCron
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCron
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCron
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCron
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCron
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCron
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCron
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCron
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
+			}
+			m.ConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveFailures |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCron(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCron
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCron(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCron
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCron
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCron
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCron
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCron        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCron          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCron = fmt.Errorf("proto: unexpected end of group")
+)
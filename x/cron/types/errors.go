@@ -0,0 +1,11 @@
+package types
+
+import "cosmossdk.io/errors"
+
+var (
+	ErrInvalidJob      = errors.Register(ModuleName, 2, "invalid job")
+	ErrJobNotFound     = errors.Register(ModuleName, 3, "job not found")
+	ErrGasLimitTooHigh = errors.Register(ModuleName, 4, "job gas limit is above the maximum")
+	ErrNotJobOwner     = errors.Register(ModuleName, 5, "sender is not the owner of the job")
+	ErrFeeNotPaid      = errors.Register(ModuleName, 6, "job execution fee could not be paid")
+)
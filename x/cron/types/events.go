@@ -0,0 +1,22 @@
+package types
+
+// cron module event types and attributes
+const (
+	EventTypeJobAdded    = "cron_job_added"
+	EventTypeJobRemoved  = "cron_job_removed"
+	EventTypeJobExecuted = "cron_job_executed"
+	EventTypeJobFailed   = "cron_job_failed"
+
+	AttributeKeyJobID    = "job_id"
+	AttributeKeyContract = "contract"
+	AttributeKeyOwner    = "owner"
+	AttributeKeyGasUsed  = "gas_used"
+	AttributeKeyFee      = "fee"
+	AttributeKeyError    = "error"
+	AttributeKeyFailures = "consecutive_failures"
+	AttributeKeyReason   = "reason"
+
+	// reasons of a job removal
+	RemovalReasonCancelled = "cancelled"
+	RemovalReasonFailures  = "failures"
+)
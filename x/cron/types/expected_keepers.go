@@ -0,0 +1,49 @@
+package types
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/core"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/evm/x/vm/core/vm"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// AccountKeeper defines the expected account keeper.
+type AccountKeeper interface {
+	GetModuleAddress(moduleName string) sdk.AccAddress
+	GetModuleAccount(ctx context.Context, moduleName string) sdk.ModuleAccountI
+}
+
+// BankKeeper defines the expected bank keeper used to hold the bonds and to
+// collect the execution fees.
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// FeegrantKeeper defines the expected feegrant keeper. Jobs paid by a third
+// party consume a fee allowance granted to the cron module account.
+type FeegrantKeeper interface {
+	UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
+}
+
+// DistrKeeper defines the expected distribution keeper receiving the forfeited
+// bonds.
+type DistrKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// EVMKeeper defines the expected EVM keeper executing the jobs.
+type EVMKeeper interface {
+	ApplyMessage(ctx sdk.Context, msg core.Message, tracer vm.EVMLogger, commit bool) (*evmtypes.MsgEthereumTxResponse, error)
+}
+
+// FeeMarketKeeper defines the expected fee market keeper pricing the gas used
+// by the jobs.
+type FeeMarketKeeper interface {
+	GetBaseFee(ctx sdk.Context) math.LegacyDec
+}
@@ -0,0 +1,42 @@
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, jobs []Job, nextJobID uint64) *GenesisState {
+	return &GenesisState{
+		Params:    params,
+		Jobs:      jobs,
+		NextJobId: nextJobID,
+	}
+}
+
+// DefaultGenesis returns the default genesis state.
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(DefaultParams(), []Job{}, 1)
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[uint64]bool, len(gs.Jobs))
+	for _, job := range gs.Jobs {
+		if seen[job.Id] {
+			return fmt.Errorf("duplicate job id %d", job.Id)
+		}
+		seen[job.Id] = true
+
+		if job.Id >= gs.NextJobId {
+			return fmt.Errorf("job id %d must be lower than the next job id %d", job.Id, gs.NextJobId)
+		}
+		if err := job.Validate(); err != nil {
+			return fmt.Errorf("invalid job %d: %w", job.Id, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate performs a stateless check of the job.
+func (j Job) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(j.Owner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(j.FeePayer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid fee payer address: %s", err)
+	}
+	if !common.IsHexAddress(j.Contract) {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid contract address: %s", j.Contract)
+	}
+	if j.Interval == 0 {
+		return ErrInvalidJob.Wrap("interval must be positive")
+	}
+	if j.GasLimit == 0 {
+		return ErrInvalidJob.Wrap("gas limit must be positive")
+	}
+	if j.Bond.IsNil() || j.Bond.IsNegative() {
+		return ErrInvalidJob.Wrapf("bond cannot be negative: %s", j.Bond)
+	}
+
+	return nil
+}
+
+// ContractAddress returns the hex address of the called contract.
+func (j Job) ContractAddress() common.Address {
+	return common.HexToAddress(j.Contract)
+}
@@ -0,0 +1,25 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "cron"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	// ParamsKey saves the current module params.
+	ParamsKey = collections.NewPrefix(0)
+
+	// JobsKey saves the jobs by identifier.
+	JobsKey = collections.NewPrefix(1)
+
+	// NextJobIDKey saves the identifier given to the next job.
+	NextJobIDKey = collections.NewPrefix(2)
+
+	// ScheduleKey indexes the jobs by their next execution height.
+	ScheduleKey = collections.NewPrefix(3)
+)
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgAddJob{}
+	_ sdk.Msg = &MsgRemoveJob{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// Validate performs a stateless check of the MsgAddJob.
+func (msg MsgAddJob) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.FeePayer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid fee payer address: %s", err)
+	}
+	if !common.IsHexAddress(msg.Contract) {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid contract address: %s", msg.Contract)
+	}
+	if msg.Interval == 0 {
+		return ErrInvalidJob.Wrap("interval must be positive")
+	}
+	if msg.GasLimit == 0 {
+		return ErrInvalidJob.Wrap("gas limit must be positive")
+	}
+
+	return nil
+}
+
+// Validate performs a stateless check of the MsgRemoveJob.
+func (msg MsgRemoveJob) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	return nil
+}
+
+// Validate performs a stateless check of the MsgUpdateParams.
+func (msg MsgUpdateParams) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	return msg.Params.Validate()
+}
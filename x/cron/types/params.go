@@ -0,0 +1,54 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+const (
+	// DefaultMaxJobGasLimit is the default gas limit cap of a single job.
+	DefaultMaxJobGasLimit uint64 = 1_000_000
+	// DefaultMaxBlockGas is the default gas budget of the jobs in a block.
+	DefaultMaxBlockGas uint64 = 10_000_000
+	// DefaultMaxConsecutiveFailures is the default number of failed executions
+	// after which a job is removed.
+	DefaultMaxConsecutiveFailures uint32 = 5
+)
+
+// DefaultMinBond is the default bond of precompile jobs: 100 tokens with 18 decimals.
+var DefaultMinBond = math.NewIntWithDecimal(100, 18)
+
+// DefaultParams returns default module parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultMaxJobGasLimit, DefaultMaxBlockGas, DefaultMinBond, DefaultMaxConsecutiveFailures)
+}
+
+// NewParams creates a new Params instance.
+func NewParams(maxJobGasLimit, maxBlockGas uint64, minBond math.Int, maxConsecutiveFailures uint32) Params {
+	return Params{
+		MaxJobGasLimit:         maxJobGasLimit,
+		MaxBlockGas:            maxBlockGas,
+		MinBond:                minBond,
+		MaxConsecutiveFailures: maxConsecutiveFailures,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if p.MaxJobGasLimit == 0 {
+		return errors.New("max job gas limit must be positive")
+	}
+	if p.MaxBlockGas < p.MaxJobGasLimit {
+		return fmt.Errorf("max block gas must be at least the max job gas limit: %d < %d", p.MaxBlockGas, p.MaxJobGasLimit)
+	}
+	if p.MinBond.IsNil() || p.MinBond.IsNegative() {
+		return fmt.Errorf("min bond cannot be negative: %s", p.MinBond)
+	}
+	if p.MaxConsecutiveFailures == 0 {
+		return errors.New("max consecutive failures must be positive")
+	}
+
+	return nil
+}
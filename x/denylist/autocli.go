@@ -0,0 +1,53 @@
+package denylist
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "flora.denylist.v1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current denylist parameters",
+				},
+				{
+					RpcMethod: "Addresses",
+					Use:       "addresses",
+					Short:     "Query the denied addresses",
+				},
+				{
+					RpcMethod:      "Denied",
+					Use:            "denied [address]",
+					Short:          "Query whether a bech32 or hex address is denied",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "flora.denylist.v1.Msg",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "AddAddresses",
+					Use:            "add-addresses [address]...",
+					Short:          "Deny bech32 or hex addresses",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "addresses", Varargs: true}},
+				},
+				{
+					RpcMethod:      "RemoveAddresses",
+					Use:            "remove-addresses [address]...",
+					Short:          "Remove addresses from the denylist",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "addresses", Varargs: true}},
+				},
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,148 @@
+package denylist
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/rollchains/flora/x/denylist/keeper"
+	"github.com/rollchains/flora/x/denylist/types"
+)
+
+var (
+	_ porttypes.IBCModule             = IBCMiddleware{}
+	_ porttypes.UpgradableModule      = IBCMiddleware{}
+	_ porttypes.PacketDataUnmarshaler = IBCMiddleware{}
+	_ porttypes.ICS4Wrapper           = ICS4Wrapper{}
+)
+
+// IBCMiddleware rejects the inbound ICS20 transfers from or to denied
+// addresses with an error acknowledgement, refunding the sender on the
+// counterparty chain.
+type IBCMiddleware struct {
+	porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware wrapping the transfer app.
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{IBCModule: app, keeper: k}
+}
+
+// OnRecvPacket implements porttypes.IBCModule.
+func (im IBCMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	if err := checkTransfer(ctx, im.keeper, packet.GetData()); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+}
+
+// OnChanUpgradeInit implements porttypes.UpgradableModule.
+func (im IBCMiddleware) OnChanUpgradeInit(ctx sdk.Context, portID, channelID string, proposedOrder channeltypes.Order, proposedConnectionHops []string, proposedVersion string) (string, error) {
+	cbs, err := im.upgradableModule()
+	if err != nil {
+		return "", err
+	}
+	return cbs.OnChanUpgradeInit(ctx, portID, channelID, proposedOrder, proposedConnectionHops, proposedVersion)
+}
+
+// OnChanUpgradeTry implements porttypes.UpgradableModule.
+func (im IBCMiddleware) OnChanUpgradeTry(ctx sdk.Context, portID, channelID string, proposedOrder channeltypes.Order, proposedConnectionHops []string, counterpartyVersion string) (string, error) {
+	cbs, err := im.upgradableModule()
+	if err != nil {
+		return "", err
+	}
+	return cbs.OnChanUpgradeTry(ctx, portID, channelID, proposedOrder, proposedConnectionHops, counterpartyVersion)
+}
+
+// OnChanUpgradeAck implements porttypes.UpgradableModule.
+func (im IBCMiddleware) OnChanUpgradeAck(ctx sdk.Context, portID, channelID, counterpartyVersion string) error {
+	cbs, err := im.upgradableModule()
+	if err != nil {
+		return err
+	}
+	return cbs.OnChanUpgradeAck(ctx, portID, channelID, counterpartyVersion)
+}
+
+// OnChanUpgradeOpen implements porttypes.UpgradableModule.
+func (im IBCMiddleware) OnChanUpgradeOpen(ctx sdk.Context, portID, channelID string, proposedOrder channeltypes.Order, proposedConnectionHops []string, proposedVersion string) {
+	cbs, err := im.upgradableModule()
+	if err != nil {
+		panic(err)
+	}
+	cbs.OnChanUpgradeOpen(ctx, portID, channelID, proposedOrder, proposedConnectionHops, proposedVersion)
+}
+
+// UnmarshalPacketData implements porttypes.PacketDataUnmarshaler.
+func (im IBCMiddleware) UnmarshalPacketData(bz []byte) (interface{}, error) {
+	unmarshaler, ok := im.IBCModule.(porttypes.PacketDataUnmarshaler)
+	if !ok {
+		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "underlying app does not implement %T", (*porttypes.PacketDataUnmarshaler)(nil))
+	}
+	return unmarshaler.UnmarshalPacketData(bz)
+}
+
+func (im IBCMiddleware) upgradableModule() (porttypes.UpgradableModule, error) {
+	cbs, ok := im.IBCModule.(porttypes.UpgradableModule)
+	if !ok {
+		return nil, errorsmod.Wrap(porttypes.ErrInvalidRoute, "upgrade route not found to module in application callstack")
+	}
+	return cbs, nil
+}
+
+// ICS4Wrapper rejects the outbound ICS20 transfers from or to denied
+// addresses, before the tokens are escrowed or burned.
+type ICS4Wrapper struct {
+	porttypes.ICS4Wrapper
+	keeper keeper.Keeper
+}
+
+// NewICS4Wrapper creates a new ICS4Wrapper wrapping the ics4Wrapper used by
+// the transfer keeper.
+func NewICS4Wrapper(ics4Wrapper porttypes.ICS4Wrapper, k keeper.Keeper) ICS4Wrapper {
+	return ICS4Wrapper{ICS4Wrapper: ics4Wrapper, keeper: k}
+}
+
+// SendPacket implements porttypes.ICS4Wrapper.
+func (w ICS4Wrapper) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	if err := checkTransfer(ctx, w.keeper, data); err != nil {
+		return 0, err
+	}
+	return w.ICS4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+}
+
+// checkTransfer returns an error if the sender or the receiver of the ICS20
+// packet data is denied. Data that is not an ICS20 packet is left to the
+// transfer app.
+func checkTransfer(ctx sdk.Context, k keeper.Keeper, data []byte) error {
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(data, &packetData); err != nil {
+		return nil
+	}
+
+	for _, address := range []string{packetData.Sender, packetData.Receiver} {
+		addr, err := types.ParseAddress(address)
+		if err != nil {
+			continue
+		}
+		if err := k.CheckAddress(ctx, types.PathICS20, addr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/rollchains/flora/x/denylist/types"
+)
+
+// InitGenesis initializes the module state from a genesis state. It runs after
+// the EVM genesis, as the denylist is applied to the EVM params.
+func (k Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error {
+	for _, a := range gs.Addresses {
+		addr, err := types.ParseAddress(a)
+		if err != nil {
+			return err
+		}
+		if err := k.Denied.Set(ctx, addr); err != nil {
+			return err
+		}
+	}
+
+	if err := k.SetParams(ctx, gs.Params); err != nil {
+		return err
+	}
+
+	return k.syncAccessControl(ctx)
+}
+
+// ExportGenesis exports the module state to a genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := k.Denied.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, err
+	}
+	addresses := make([]string, len(keys))
+	for i, addr := range keys {
+		addresses[i] = sdk.AccAddress(addr).String()
+	}
+
+	return types.NewGenesisState(params, addresses), nil
+}
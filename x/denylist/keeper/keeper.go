@@ -0,0 +1,177 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/rollchains/flora/x/denylist/types"
+)
+
+// Keeper of the denylist store.
+type Keeper struct {
+	cdc           codec.BinaryCodec
+	accountKeeper types.AccountKeeper
+	evmKeeper     types.EVMKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically,
+	// this should be the x/gov module account.
+	authority string
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	Denied collections.KeySet[[]byte]
+}
+
+// NewKeeper creates a new denylist Keeper instance.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService storetypes.KVStoreService,
+	accountKeeper types.AccountKeeper,
+	evmKeeper types.EVMKeeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := Keeper{
+		cdc:           cdc,
+		accountKeeper: accountKeeper,
+		evmKeeper:     evmKeeper,
+		authority:     authority,
+		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Denied:        collections.NewKeySet(sb, types.DeniedKey, "denied", collections.BytesKey),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return sdk.UnwrapSDKContext(ctx).Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the module params, falling back to the defaults when they
+// have not been set yet.
+func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
+	params, err := k.Params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DefaultParams(), nil
+	}
+	return params, err
+}
+
+// SetParams sets the module params.
+func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
+	return k.Params.Set(ctx, params)
+}
+
+// IsDenied returns true if the address is denied.
+func (k Keeper) IsDenied(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	return k.Denied.Has(ctx, addr)
+}
+
+// CheckAddress returns an error if the address is denied, emitting an event
+// reporting the transfer blocked on the path.
+func (k Keeper) CheckAddress(ctx context.Context, path string, addr sdk.AccAddress) error {
+	denied, err := k.IsDenied(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if !denied {
+		return nil
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBlocked,
+		sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+		sdk.NewAttribute(types.AttributeKeyPath, path),
+	))
+
+	return types.ErrAddressDenied.Wrapf("%s on %s", addr, path)
+}
+
+// AddAddress denies an address.
+func (k Keeper) AddAddress(ctx context.Context, addr sdk.AccAddress, sender string) error {
+	if err := k.Denied.Set(ctx, addr); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeAddAddress,
+		sdk.NewAttribute(types.AttributeKeySender, sender),
+		sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+	))
+
+	return k.syncAccessControl(ctx)
+}
+
+// RemoveAddress removes an address from the denylist.
+func (k Keeper) RemoveAddress(ctx context.Context, addr sdk.AccAddress, sender string) error {
+	has, err := k.Denied.Has(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return types.ErrAddressNotFound.Wrap(addr.String())
+	}
+
+	if err := k.Denied.Remove(ctx, addr); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRemoveAddress,
+		sdk.NewAttribute(types.AttributeKeySender, sender),
+		sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+	))
+
+	return k.syncAccessControl(ctx)
+}
+
+// syncAccessControl applies the denylist to the call access control of the
+// EVM params, which is checked by the EVM on every CALL, CALLCODE,
+// DELEGATECALL and STATICCALL against the tx signer and the caller. The
+// recipients are checked by the dynamic precompiles lookup of the app.
+//
+// The module owns the call access control, changes made to it through the
+// EVM params are overwritten on the next update of the denylist.
+func (k Keeper) syncAccessControl(ctx context.Context) error {
+	iter, err := k.Denied.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	denied, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	call := evmtypes.AccessControlType{AccessType: evmtypes.AccessTypePermissionless, AccessControlList: []string{}}
+	for _, addr := range denied {
+		call.AccessControlList = append(call.AccessControlList, common.BytesToAddress(addr).Hex())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	evmParams := k.evmKeeper.GetParams(sdkCtx)
+	evmParams.AccessControl.Call = call
+	return k.evmKeeper.SetParams(sdkCtx, evmParams)
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/rollchains/flora/x/denylist/types"
+)
+
+type msgServer struct {
+	k Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the module MsgServer interface.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{k: keeper}
+}
+
+// UpdateParams implements types.MsgServer.
+func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.k.authority, msg.Authority)
+	}
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// AddAddresses implements types.MsgServer.
+func (ms msgServer) AddAddresses(ctx context.Context, msg *types.MsgAddAddresses) (*types.MsgAddAddressesResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.requireAdmin(ctx, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	for _, a := range msg.Addresses {
+		addr, err := types.ParseAddress(a)
+		if err != nil {
+			return nil, err
+		}
+		if err := ms.k.AddAddress(ctx, addr, msg.Sender); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgAddAddressesResponse{}, nil
+}
+
+// RemoveAddresses implements types.MsgServer.
+func (ms msgServer) RemoveAddresses(ctx context.Context, msg *types.MsgRemoveAddresses) (*types.MsgRemoveAddressesResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.requireAdmin(ctx, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	for _, a := range msg.Addresses {
+		addr, err := types.ParseAddress(a)
+		if err != nil {
+			return nil, err
+		}
+		if err := ms.k.RemoveAddress(ctx, addr, msg.Sender); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgRemoveAddressesResponse{}, nil
+}
+
+// requireAdmin returns an error unless the sender is the governance account
+// or one of the module admins.
+func (ms msgServer) requireAdmin(ctx context.Context, sender string) error {
+	if sender == ms.k.authority {
+		return nil
+	}
+
+	params, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if !params.IsAdmin(sender) {
+		return errorsmod.Wrap(types.ErrNotAnAdmin, sender)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/rollchains/flora/x/denylist/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier implements the module gRPC query service.
+type Querier struct {
+	Keeper
+}
+
+// NewQuerier returns an implementation of the module QueryServer interface.
+func NewQuerier(keeper Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Params implements types.QueryServer.
+func (q Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Addresses implements types.QueryServer.
+func (q Querier) Addresses(ctx context.Context, req *types.QueryAddressesRequest) (*types.QueryAddressesResponse, error) {
+	addresses, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.Denied, req.Pagination,
+		func(addr []byte, _ collections.NoValue) (string, error) {
+			return sdk.AccAddress(addr).String(), nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAddressesResponse{Addresses: addresses, Pagination: pageRes}, nil
+}
+
+// Denied implements types.QueryServer.
+func (q Querier) Denied(ctx context.Context, req *types.QueryDeniedRequest) (*types.QueryDeniedResponse, error) {
+	addr, err := types.ParseAddress(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	denied, err := q.IsDenied(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDeniedResponse{Denied: denied}, nil
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/rollchains/flora/x/denylist/types"
+)
+
+// SendRestriction is the bank send restriction blocking the transfers from
+// and to denied addresses.
+//
+// The payouts of module accounts to denied addresses are let through, as they
+// are made by the modules on their own, e.g. deposit refunds and unbondings
+// in the end blocker, and failing them would halt the chain. The module
+// account transfers entering the chain, ICS20 and ERC-20 conversions, are
+// checked by the IBC middleware and the ante handler instead.
+func (k Keeper) SendRestriction(ctx context.Context, from, to sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+	if err := k.CheckAddress(ctx, types.PathBank, from); err != nil {
+		return to, err
+	}
+
+	denied, err := k.IsDenied(ctx, to)
+	if err != nil || !denied {
+		return to, err
+	}
+	if _, ok := k.accountKeeper.GetAccount(ctx, from).(sdk.ModuleAccountI); ok {
+		return to, nil
+	}
+
+	return to, k.CheckAddress(ctx, types.PathBank, to)
+}
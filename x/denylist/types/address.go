@@ -0,0 +1,22 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// ParseAddress parses a hex address or a bech32 address of any prefix, so
+// that the addresses of counterparty chains can be denied for ICS20 too.
+func ParseAddress(address string) (sdk.AccAddress, error) {
+	if common.IsHexAddress(address) {
+		return common.HexToAddress(address).Bytes(), nil
+	}
+
+	_, bz, err := bech32.DecodeAndConvert(address)
+	if err != nil || len(bz) == 0 {
+		return nil, ErrInvalidAddress.Wrapf("must be a hex or bech32 address: %s", address)
+	}
+	return bz, nil
+}
@@ -0,0 +1,10 @@
+package types
+
+import "cosmossdk.io/errors"
+
+var (
+	ErrNotAnAdmin      = errors.Register(ModuleName, 2, "sender is not an admin")
+	ErrAddressDenied   = errors.Register(ModuleName, 3, "address is denied")
+	ErrInvalidAddress  = errors.Register(ModuleName, 4, "invalid address")
+	ErrAddressNotFound = errors.Register(ModuleName, 5, "address is not denied")
+)
@@ -0,0 +1,21 @@
+package types
+
+// denylist module event types and attributes
+const (
+	EventTypeAddAddress    = "denylist_add"
+	EventTypeRemoveAddress = "denylist_remove"
+	EventTypeBlocked       = "denylist_blocked"
+
+	AttributeKeySender  = "sender"
+	AttributeKeyAddress = "address"
+	AttributeKeyPath    = "path"
+)
+
+// Paths on which a transfer can be blocked, reported in the blocked events.
+const (
+	PathBank  = "bank"
+	PathAnte  = "ante"
+	PathEVM   = "evm"
+	PathERC20 = "erc20"
+	PathICS20 = "ics20"
+)
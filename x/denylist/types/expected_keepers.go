@@ -0,0 +1,21 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// AccountKeeper defines the expected account keeper, used to let the module
+// accounts pay out to denied addresses.
+type AccountKeeper interface {
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+}
+
+// EVMKeeper defines the expected EVM keeper, whose call access control
+// rejects the calls made by denied contracts.
+type EVMKeeper interface {
+	GetParams(ctx sdk.Context) evmtypes.Params
+	SetParams(ctx sdk.Context, params evmtypes.Params) error
+}
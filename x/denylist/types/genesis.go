@@ -0,0 +1,38 @@
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, addresses []string) *GenesisState {
+	return &GenesisState{
+		Params:    params,
+		Addresses: addresses,
+	}
+}
+
+// DefaultGenesis returns the default genesis state.
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil)
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.Addresses))
+	for _, a := range gs.Addresses {
+		addr, err := ParseAddress(a)
+		if err != nil {
+			return err
+		}
+		if seen[string(addr)] {
+			return fmt.Errorf("duplicate address %s", a)
+		}
+		seen[string(addr)] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgAddAddresses{}
+	_ sdk.Msg = &MsgRemoveAddresses{}
+)
+
+// Validate performs a stateless check of the MsgUpdateParams.
+func (msg MsgUpdateParams) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	return msg.Params.Validate()
+}
+
+// Validate performs a stateless check of the MsgAddAddresses.
+func (msg MsgAddAddresses) Validate() error {
+	return validateAddresses(msg.Sender, msg.Addresses)
+}
+
+// Validate performs a stateless check of the MsgRemoveAddresses.
+func (msg MsgRemoveAddresses) Validate() error {
+	return validateAddresses(msg.Sender, msg.Addresses)
+}
+
+func validateAddresses(sender string, addresses []string) error {
+	if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	if len(addresses) == 0 {
+		return ErrInvalidAddress.Wrap("no addresses")
+	}
+	for _, address := range addresses {
+		if _, err := ParseAddress(address); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultParams returns default module parameters, no admins are set.
+func DefaultParams() Params {
+	return NewParams([]string{})
+}
+
+// NewParams creates a new Params instance.
+func NewParams(admins []string) Params {
+	return Params{
+		Admins: admins,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	seen := make(map[string]bool, len(p.Admins))
+	for _, admin := range p.Admins {
+		if _, err := sdk.AccAddressFromBech32(admin); err != nil {
+			return fmt.Errorf("invalid admin address %s: %w", admin, err)
+		}
+		if seen[admin] {
+			return fmt.Errorf("duplicate admin address %s", admin)
+		}
+		seen[admin] = true
+	}
+
+	return nil
+}
+
+// IsAdmin returns true if the address is one of the module admins.
+func (p Params) IsAdmin(addr string) bool {
+	for _, admin := range p.Admins {
+		if admin == addr {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,64 @@
+package deploypolicy
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "flora.deploypolicy.v1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current deploypolicy parameters",
+				},
+				{
+					RpcMethod: "Deployers",
+					Use:       "deployers",
+					Short:     "Query the allowlisted contract deployers",
+				},
+				{
+					RpcMethod: "CodeHashes",
+					Use:       "code-hashes",
+					Short:     "Query the approved creation code hashes",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "flora.deploypolicy.v1.Msg",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "AddDeployers",
+					Use:            "add-deployers [address]...",
+					Short:          "Allowlist contract deployers",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "deployers", Varargs: true}},
+				},
+				{
+					RpcMethod:      "RemoveDeployers",
+					Use:            "remove-deployers [address]...",
+					Short:          "Remove contract deployers from the allowlist",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "deployers", Varargs: true}},
+				},
+				{
+					RpcMethod:      "ApproveCode",
+					Use:            "approve-code [code-hash]...",
+					Short:          "Approve the creation code with the given keccak256 hashes",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "code_hashes", Varargs: true}},
+				},
+				{
+					RpcMethod:      "RevokeCode",
+					Use:            "revoke-code [code-hash]...",
+					Short:          "Revoke the approval of creation code",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "code_hashes", Varargs: true}},
+				},
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rollchains/flora/x/deploypolicy/types"
+)
+
+// InitGenesis initializes the module state from a genesis state. It runs after
+// the EVM genesis, as the policy is applied to the EVM params.
+func (k Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error {
+	for _, d := range gs.Deployers {
+		deployer, err := types.ParseDeployer(d)
+		if err != nil {
+			return err
+		}
+		if err := k.Deployers.Set(ctx, deployer.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	for _, c := range gs.CodeHashes {
+		codeHash, err := types.ParseCodeHash(c)
+		if err != nil {
+			return err
+		}
+		if err := k.CodeHashes.Set(ctx, codeHash.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	return k.SetParams(ctx, gs.Params)
+}
+
+// ExportGenesis exports the module state to a genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := k.Deployers.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	deployerKeys, err := iter.Keys()
+	if err != nil {
+		return nil, err
+	}
+	deployers := make([]string, len(deployerKeys))
+	for i, deployer := range deployerKeys {
+		deployers[i] = common.BytesToAddress(deployer).Hex()
+	}
+
+	codeHashIter, err := k.CodeHashes.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	codeHashKeys, err := codeHashIter.Keys()
+	if err != nil {
+		return nil, err
+	}
+	codeHashes := make([]string, len(codeHashKeys))
+	for i, codeHash := range codeHashKeys {
+		codeHashes[i] = common.BytesToHash(codeHash).Hex()
+	}
+
+	return types.NewGenesisState(params, deployers, codeHashes), nil
+}
@@ -0,0 +1,235 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/rollchains/flora/x/deploypolicy/types"
+)
+
+// Keeper of the deploypolicy store.
+type Keeper struct {
+	cdc       codec.BinaryCodec
+	evmKeeper types.EVMKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically,
+	// this should be the x/gov module account.
+	authority string
+
+	Schema     collections.Schema
+	Params     collections.Item[types.Params]
+	Deployers  collections.KeySet[[]byte]
+	CodeHashes collections.KeySet[[]byte]
+}
+
+// NewKeeper creates a new deploypolicy Keeper instance.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService storetypes.KVStoreService,
+	evmKeeper types.EVMKeeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := Keeper{
+		cdc:        cdc,
+		evmKeeper:  evmKeeper,
+		authority:  authority,
+		Params:     collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Deployers:  collections.NewKeySet(sb, types.DeployersKey, "deployers", collections.BytesKey),
+		CodeHashes: collections.NewKeySet(sb, types.CodeHashesKey, "code_hashes", collections.BytesKey),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return sdk.UnwrapSDKContext(ctx).Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the module params, falling back to the defaults when they
+// have not been set yet.
+func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
+	params, err := k.Params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DefaultParams(), nil
+	}
+	return params, err
+}
+
+// SetParams sets the module params and applies the mode to the EVM.
+func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
+	if err := k.Params.Set(ctx, params); err != nil {
+		return err
+	}
+	return k.syncAccessControl(ctx)
+}
+
+// CheckDeploy returns an error if the deployer is not allowed to deploy the
+// creation code.
+func (k Keeper) CheckDeploy(ctx context.Context, deployer common.Address, initCode []byte) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if params.Mode == types.ModeOpen {
+		return nil
+	}
+
+	allowed, err := k.Deployers.Has(ctx, deployer.Bytes())
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return types.ErrDeployerNotAllowed.Wrap(deployer.Hex())
+	}
+
+	if params.Mode == types.ModeCodeHash {
+		codeHash := types.CodeHash(initCode)
+		approved, err := k.CodeHashes.Has(ctx, codeHash.Bytes())
+		if err != nil {
+			return err
+		}
+		if !approved {
+			return types.ErrCodeNotApproved.Wrap(codeHash.Hex())
+		}
+	}
+
+	return nil
+}
+
+// AddDeployer allowlists a deployer.
+func (k Keeper) AddDeployer(ctx context.Context, deployer common.Address, sender string) error {
+	if err := k.Deployers.Set(ctx, deployer.Bytes()); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeAddDeployer,
+		sdk.NewAttribute(types.AttributeKeySender, sender),
+		sdk.NewAttribute(types.AttributeKeyDeployer, deployer.Hex()),
+	))
+
+	return k.syncAccessControl(ctx)
+}
+
+// RemoveDeployer removes a deployer from the allowlist.
+func (k Keeper) RemoveDeployer(ctx context.Context, deployer common.Address, sender string) error {
+	has, err := k.Deployers.Has(ctx, deployer.Bytes())
+	if err != nil {
+		return err
+	}
+	if !has {
+		return types.ErrInvalidDeployer.Wrapf("%s is not allowlisted", deployer)
+	}
+
+	if err := k.Deployers.Remove(ctx, deployer.Bytes()); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRemoveDeployer,
+		sdk.NewAttribute(types.AttributeKeySender, sender),
+		sdk.NewAttribute(types.AttributeKeyDeployer, deployer.Hex()),
+	))
+
+	return k.syncAccessControl(ctx)
+}
+
+// ApproveCodeHash approves the creation code with the given hash.
+func (k Keeper) ApproveCodeHash(ctx context.Context, codeHash common.Hash, sender string) error {
+	if err := k.CodeHashes.Set(ctx, codeHash.Bytes()); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeApproveCodeHash,
+		sdk.NewAttribute(types.AttributeKeySender, sender),
+		sdk.NewAttribute(types.AttributeKeyCodeHash, codeHash.Hex()),
+	))
+
+	return nil
+}
+
+// RevokeCodeHash revokes the approval of the creation code with the given
+// hash.
+func (k Keeper) RevokeCodeHash(ctx context.Context, codeHash common.Hash, sender string) error {
+	has, err := k.CodeHashes.Has(ctx, codeHash.Bytes())
+	if err != nil {
+		return err
+	}
+	if !has {
+		return types.ErrInvalidCodeHash.Wrapf("%s is not approved", codeHash)
+	}
+
+	if err := k.CodeHashes.Remove(ctx, codeHash.Bytes()); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRevokeCodeHash,
+		sdk.NewAttribute(types.AttributeKeySender, sender),
+		sdk.NewAttribute(types.AttributeKeyCodeHash, codeHash.Hex()),
+	))
+
+	return nil
+}
+
+// syncAccessControl applies the policy to the create access control of the
+// EVM params, which is checked by the EVM on every CREATE and CREATE2,
+// including the ones from inside contracts. The access control only knows the
+// tx signer and the creating contract, not the creation code, which is why the
+// code hash mode is rejected by the params validation.
+//
+// The module owns the create access control, changes made to it through the
+// EVM params are overwritten on the next update of the policy.
+func (k Keeper) syncAccessControl(ctx context.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	create := evmtypes.AccessControlType{AccessType: evmtypes.AccessTypePermissionless, AccessControlList: []string{}}
+	if params.Mode != types.ModeOpen {
+		iter, err := k.Deployers.Iterate(ctx, nil)
+		if err != nil {
+			return err
+		}
+		deployers, err := iter.Keys()
+		if err != nil {
+			return err
+		}
+
+		create.AccessType = evmtypes.AccessTypePermissioned
+		for _, deployer := range deployers {
+			create.AccessControlList = append(create.AccessControlList, common.BytesToAddress(deployer).Hex())
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	evmParams := k.evmKeeper.GetParams(sdkCtx)
+	evmParams.AccessControl.Create = create
+	return k.evmKeeper.SetParams(sdkCtx, evmParams)
+}
@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/rollchains/flora/x/deploypolicy/types"
+)
+
+type msgServer struct {
+	k Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the module MsgServer interface.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{k: keeper}
+}
+
+// UpdateParams implements types.MsgServer.
+func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.k.authority, msg.Authority)
+	}
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// AddDeployers implements types.MsgServer.
+func (ms msgServer) AddDeployers(ctx context.Context, msg *types.MsgAddDeployers) (*types.MsgAddDeployersResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.requireAdmin(ctx, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	for _, d := range msg.Deployers {
+		deployer, err := types.ParseDeployer(d)
+		if err != nil {
+			return nil, err
+		}
+		if err := ms.k.AddDeployer(ctx, deployer, msg.Sender); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgAddDeployersResponse{}, nil
+}
+
+// RemoveDeployers implements types.MsgServer.
+func (ms msgServer) RemoveDeployers(ctx context.Context, msg *types.MsgRemoveDeployers) (*types.MsgRemoveDeployersResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.requireAdmin(ctx, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	for _, d := range msg.Deployers {
+		deployer, err := types.ParseDeployer(d)
+		if err != nil {
+			return nil, err
+		}
+		if err := ms.k.RemoveDeployer(ctx, deployer, msg.Sender); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgRemoveDeployersResponse{}, nil
+}
+
+// ApproveCode implements types.MsgServer.
+func (ms msgServer) ApproveCode(ctx context.Context, msg *types.MsgApproveCode) (*types.MsgApproveCodeResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.requireAdmin(ctx, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	for _, c := range msg.CodeHashes {
+		codeHash, err := types.ParseCodeHash(c)
+		if err != nil {
+			return nil, err
+		}
+		if err := ms.k.ApproveCodeHash(ctx, codeHash, msg.Sender); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgApproveCodeResponse{}, nil
+}
+
+// RevokeCode implements types.MsgServer.
+func (ms msgServer) RevokeCode(ctx context.Context, msg *types.MsgRevokeCode) (*types.MsgRevokeCodeResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.requireAdmin(ctx, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	for _, c := range msg.CodeHashes {
+		codeHash, err := types.ParseCodeHash(c)
+		if err != nil {
+			return nil, err
+		}
+		if err := ms.k.RevokeCodeHash(ctx, codeHash, msg.Sender); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgRevokeCodeResponse{}, nil
+}
+
+// requireAdmin returns an error unless the sender is the governance account
+// or one of the module admins.
+func (ms msgServer) requireAdmin(ctx context.Context, sender string) error {
+	if sender == ms.k.authority {
+		return nil
+	}
+
+	params, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if !params.IsAdmin(sender) {
+		return errorsmod.Wrap(types.ErrNotAnAdmin, sender)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/rollchains/flora/x/deploypolicy/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier implements the module gRPC query service.
+type Querier struct {
+	Keeper
+}
+
+// NewQuerier returns an implementation of the module QueryServer interface.
+func NewQuerier(keeper Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Params implements types.QueryServer.
+func (q Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Deployers implements types.QueryServer.
+func (q Querier) Deployers(ctx context.Context, req *types.QueryDeployersRequest) (*types.QueryDeployersResponse, error) {
+	deployers, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.Deployers, req.Pagination,
+		func(deployer []byte, _ collections.NoValue) (string, error) {
+			return common.BytesToAddress(deployer).Hex(), nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDeployersResponse{Deployers: deployers, Pagination: pageRes}, nil
+}
+
+// CodeHashes implements types.QueryServer.
+func (q Querier) CodeHashes(ctx context.Context, req *types.QueryCodeHashesRequest) (*types.QueryCodeHashesResponse, error) {
+	codeHashes, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.CodeHashes, req.Pagination,
+		func(codeHash []byte, _ collections.NoValue) (string, error) {
+			return common.BytesToHash(codeHash).Hex(), nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCodeHashesResponse{CodeHashes: codeHashes, Pagination: pageRes}, nil
+}
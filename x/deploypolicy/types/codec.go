@@ -0,0 +1,33 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the necessary interfaces and concrete types
+// on the provided LegacyAmino codec.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "flora/x/deploypolicy/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgAddDeployers{}, "flora/x/deploypolicy/MsgAddDeployers")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveDeployers{}, "flora/x/deploypolicy/MsgRemoveDeployers")
+	legacy.RegisterAminoMsg(cdc, &MsgApproveCode{}, "flora/x/deploypolicy/MsgApproveCode")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeCode{}, "flora/x/deploypolicy/MsgRevokeCode")
+}
+
+// RegisterInterfaces registers the module interface types.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgUpdateParams{},
+		&MsgAddDeployers{},
+		&MsgRemoveDeployers{},
+		&MsgApproveCode{},
+		&MsgRevokeCode{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
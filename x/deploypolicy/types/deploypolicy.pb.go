@@ -0,0 +1,412 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: flora/deploypolicy/v1/deploypolicy.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Mode defines who may deploy contracts.
+type Mode int32
+
+const (
+	// MODE_OPEN lets anyone deploy contracts.
+	ModeOpen Mode = 0
+	// MODE_ALLOWLIST restricts the deployments to the allowlisted deployers.
+	ModeAllowlist Mode = 1
+	// MODE_CODE_HASH restricts the deployments to the allowlisted deployers and
+	// the approved creation code. It is rejected until the creation code of the
+	// CREATE and CREATE2 from inside contracts can be checked: the EVM create
+	// hooks don't see it, so the allowlisted signers could deploy any code
+	// through a factory.
+	ModeCodeHash Mode = 2
+)
+
+var Mode_name = map[int32]string{
+	0: "MODE_OPEN",
+	1: "MODE_ALLOWLIST",
+	2: "MODE_CODE_HASH",
+}
+
+var Mode_value = map[string]int32{
+	"MODE_OPEN":      0,
+	"MODE_ALLOWLIST": 1,
+	"MODE_CODE_HASH": 2,
+}
+
+func (x Mode) String() string {
+	return proto.EnumName(Mode_name, int32(x))
+}
+
+func (Mode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3ff9750b110eeea0, []int{0}
+}
+
+// Params defines the parameters for the deploypolicy module.
+type Params struct {
+	// mode defines who may deploy contracts.
+	Mode Mode `protobuf:"varint,1,opt,name=mode,proto3,enum=flora.deploypolicy.v1.Mode" json:"mode,omitempty"`
+	// admins are the accounts allowed to manage the deployers and code hashes
+	// besides governance, e.g. group policies.
+	Admins []string `protobuf:"bytes,2,rep,name=admins,proto3" json:"admins,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ff9750b110eeea0, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMode() Mode {
+	if m != nil {
+		return m.Mode
+	}
+	return ModeOpen
+}
+
+func (m *Params) GetAdmins() []string {
+	if m != nil {
+		return m.Admins
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("flora.deploypolicy.v1.Mode", Mode_name, Mode_value)
+	proto.RegisterType((*Params)(nil), "flora.deploypolicy.v1.Params")
+}
+
+func init() {
+	proto.RegisterFile("flora/deploypolicy/v1/deploypolicy.proto", fileDescriptor_3ff9750b110eeea0)
+}
+
+var fileDescriptor_3ff9750b110eeea0 = []byte{
+	// 362 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x48, 0xcb, 0xc9, 0x2f,
+	0x4a, 0xd4, 0x4f, 0x49, 0x2d, 0xc8, 0xc9, 0xaf, 0x2c, 0xc8, 0xcf, 0xc9, 0x4c, 0xae, 0xd4, 0x2f,
+	0x33, 0x44, 0xe1, 0xeb, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x89, 0x82, 0x55, 0xea, 0xa1, 0xc8,
+	0x94, 0x19, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x55, 0xe8, 0x83, 0x58, 0x10, 0xc5, 0x52,
+	0x92, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xf1, 0x10, 0x09, 0x08, 0x07, 0x2a, 0x25, 0x98, 0x98,
+	0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21, 0x42, 0x4a, 0xbd, 0x8c, 0x5c, 0x6c, 0x01, 0x89, 0x45,
+	0x89, 0xb9, 0xc5, 0x42, 0xfa, 0x5c, 0x2c, 0xb9, 0xf9, 0x29, 0xa9, 0x12, 0x8c, 0x0a, 0x8c, 0x1a,
+	0x7c, 0x46, 0xd2, 0x7a, 0x58, 0x2d, 0xd5, 0xf3, 0xcd, 0x4f, 0x49, 0x0d, 0x02, 0x2b, 0x14, 0x32,
+	0xe0, 0x62, 0x4b, 0x4c, 0xc9, 0xcd, 0xcc, 0x2b, 0x96, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x74, 0x92,
+	0xb8, 0xb4, 0x45, 0x57, 0x04, 0x6a, 0xa1, 0x63, 0x4a, 0x4a, 0x51, 0x6a, 0x71, 0x71, 0x70, 0x49,
+	0x51, 0x66, 0x5e, 0x7a, 0x10, 0x54, 0x9d, 0x95, 0x42, 0xd7, 0xf3, 0x0d, 0x5a, 0xd2, 0x10, 0x7f,
+	0x57, 0xa0, 0xfa, 0x1c, 0xe2, 0x08, 0xad, 0x32, 0x2e, 0x16, 0x90, 0x0d, 0x42, 0xd2, 0x5c, 0x9c,
+	0xbe, 0xfe, 0x2e, 0xae, 0xf1, 0xfe, 0x01, 0xae, 0x7e, 0x02, 0x0c, 0x52, 0x3c, 0x5d, 0x73, 0x15,
+	0x38, 0x40, 0x12, 0xfe, 0x05, 0xa9, 0x79, 0x42, 0xaa, 0x5c, 0x7c, 0x60, 0x49, 0x47, 0x1f, 0x1f,
+	0xff, 0x70, 0x1f, 0xcf, 0xe0, 0x10, 0x01, 0x46, 0x29, 0xc1, 0xae, 0xb9, 0x0a, 0xbc, 0x20, 0x15,
+	0x8e, 0x39, 0x39, 0xf9, 0xe5, 0x39, 0x99, 0xc5, 0x25, 0x42, 0x2a, 0x50, 0x65, 0xce, 0x20, 0xc2,
+	0xc3, 0x31, 0xd8, 0x43, 0x80, 0x49, 0x4a, 0xa0, 0x6b, 0xae, 0x02, 0x0f, 0x48, 0x99, 0x73, 0x7e,
+	0x4a, 0xaa, 0x47, 0x62, 0x71, 0x86, 0x14, 0x4b, 0xc7, 0x62, 0x39, 0x06, 0x27, 0xaf, 0x13, 0x8f,
+	0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b,
+	0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x48, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2,
+	0x4b, 0xce, 0xcf, 0xd5, 0x2f, 0xca, 0xcf, 0xc9, 0x49, 0xce, 0x48, 0xcc, 0xcc, 0x2b, 0xd6, 0xc7,
+	0xea, 0x89, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0xd0, 0x1a, 0x03, 0x02, 0x00, 0x00,
+	0xff, 0xff, 0x23, 0xf3, 0xe5, 0xf5, 0xe1, 0x01, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Admins) > 0 {
+		for iNdEx := len(m.Admins) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Admins[iNdEx])
+			copy(dAtA[i:], m.Admins[iNdEx])
+			i = encodeVarintDeploypolicy(dAtA, i, uint64(len(m.Admins[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Mode != 0 {
+		i = encodeVarintDeploypolicy(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDeploypolicy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDeploypolicy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		n += 1 + sovDeploypolicy(uint64(m.Mode))
+	}
+	if len(m.Admins) > 0 {
+		for _, s := range m.Admins {
+			l = len(s)
+			n += 1 + l + sovDeploypolicy(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovDeploypolicy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDeploypolicy(x uint64) (n int) {
+	return sovDeploypolicy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDeploypolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeploypolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= Mode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admins", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeploypolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDeploypolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeploypolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admins = append(m.Admins, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDeploypolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDeploypolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDeploypolicy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDeploypolicy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDeploypolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDeploypolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDeploypolicy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDeploypolicy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDeploypolicy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDeploypolicy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDeploypolicy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDeploypolicy = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,11 @@
+package types
+
+import "cosmossdk.io/errors"
+
+var (
+	ErrNotAnAdmin         = errors.Register(ModuleName, 2, "sender is not an admin")
+	ErrDeployerNotAllowed = errors.Register(ModuleName, 3, "deployer not allowlisted")
+	ErrCodeNotApproved    = errors.Register(ModuleName, 4, "creation code not approved")
+	ErrInvalidDeployer    = errors.Register(ModuleName, 5, "invalid deployer")
+	ErrInvalidCodeHash    = errors.Register(ModuleName, 6, "invalid code hash")
+)
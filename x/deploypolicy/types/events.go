@@ -0,0 +1,13 @@
+package types
+
+// deploypolicy module event types and attributes
+const (
+	EventTypeAddDeployer     = "add_deployer"
+	EventTypeRemoveDeployer  = "remove_deployer"
+	EventTypeApproveCodeHash = "approve_code_hash"
+	EventTypeRevokeCodeHash  = "revoke_code_hash"
+
+	AttributeKeySender   = "sender"
+	AttributeKeyDeployer = "deployer"
+	AttributeKeyCodeHash = "code_hash"
+)
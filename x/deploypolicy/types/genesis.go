@@ -0,0 +1,51 @@
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, deployers, codeHashes []string) *GenesisState {
+	return &GenesisState{
+		Params:     params,
+		Deployers:  deployers,
+		CodeHashes: codeHashes,
+	}
+}
+
+// DefaultGenesis returns the default genesis state.
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil, nil)
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	deployers := make(map[string]bool, len(gs.Deployers))
+	for _, d := range gs.Deployers {
+		deployer, err := ParseDeployer(d)
+		if err != nil {
+			return err
+		}
+		if deployers[deployer.Hex()] {
+			return fmt.Errorf("duplicate deployer %s", deployer)
+		}
+		deployers[deployer.Hex()] = true
+	}
+
+	codeHashes := make(map[string]bool, len(gs.CodeHashes))
+	for _, c := range gs.CodeHashes {
+		codeHash, err := ParseCodeHash(c)
+		if err != nil {
+			return err
+		}
+		if codeHashes[codeHash.Hex()] {
+			return fmt.Errorf("duplicate code hash %s", codeHash)
+		}
+		codeHashes[codeHash.Hex()] = true
+	}
+
+	return nil
+}
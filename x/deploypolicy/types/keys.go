@@ -0,0 +1,20 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "deploypolicy"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	// ParamsKey saves the current module params.
+	ParamsKey = collections.NewPrefix(0)
+	// DeployersKey saves the allowlisted deployers.
+	DeployersKey = collections.NewPrefix(1)
+	// CodeHashesKey saves the approved code hashes.
+	CodeHashesKey = collections.NewPrefix(2)
+)
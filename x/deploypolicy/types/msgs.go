@@ -0,0 +1,72 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgAddDeployers{}
+	_ sdk.Msg = &MsgRemoveDeployers{}
+	_ sdk.Msg = &MsgApproveCode{}
+	_ sdk.Msg = &MsgRevokeCode{}
+)
+
+// Validate performs a stateless check of the MsgUpdateParams.
+func (msg MsgUpdateParams) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	return msg.Params.Validate()
+}
+
+// Validate performs a stateless check of the MsgAddDeployers.
+func (msg MsgAddDeployers) Validate() error {
+	return validateDeployers(msg.Sender, msg.Deployers)
+}
+
+// Validate performs a stateless check of the MsgRemoveDeployers.
+func (msg MsgRemoveDeployers) Validate() error {
+	return validateDeployers(msg.Sender, msg.Deployers)
+}
+
+// Validate performs a stateless check of the MsgApproveCode.
+func (msg MsgApproveCode) Validate() error {
+	return validateCodeHashes(msg.Sender, msg.CodeHashes)
+}
+
+// Validate performs a stateless check of the MsgRevokeCode.
+func (msg MsgRevokeCode) Validate() error {
+	return validateCodeHashes(msg.Sender, msg.CodeHashes)
+}
+
+func validateDeployers(sender string, deployers []string) error {
+	if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	if len(deployers) == 0 {
+		return ErrInvalidDeployer.Wrap("no deployers")
+	}
+	for _, deployer := range deployers {
+		if _, err := ParseDeployer(deployer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateCodeHashes(sender string, codeHashes []string) error {
+	if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	if len(codeHashes) == 0 {
+		return ErrInvalidCodeHash.Wrap("no code hashes")
+	}
+	for _, codeHash := range codeHashes {
+		if _, err := ParseCodeHash(codeHash); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultParams returns default module parameters. Anyone may deploy
+// contracts and no admins are set.
+func DefaultParams() Params {
+	return NewParams(ModeOpen, []string{})
+}
+
+// NewParams creates a new Params instance.
+func NewParams(mode Mode, admins []string) Params {
+	return Params{
+		Mode:   mode,
+		Admins: admins,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if _, ok := Mode_name[int32(p.Mode)]; !ok {
+		return fmt.Errorf("invalid mode %d", p.Mode)
+	}
+	if p.Mode == ModeCodeHash {
+		return fmt.Errorf("mode %s can't be enforced on the deployments from inside contracts", p.Mode)
+	}
+
+	seen := make(map[string]bool, len(p.Admins))
+	for _, admin := range p.Admins {
+		if _, err := sdk.AccAddressFromBech32(admin); err != nil {
+			return fmt.Errorf("invalid admin address %s: %w", admin, err)
+		}
+		if seen[admin] {
+			return fmt.Errorf("duplicate admin address %s", admin)
+		}
+		seen[admin] = true
+	}
+
+	return nil
+}
+
+// IsAdmin returns true if the address is one of the module admins.
+func (p Params) IsAdmin(addr string) bool {
+	for _, admin := range p.Admins {
+		if admin == addr {
+			return true
+		}
+	}
+	return false
+}
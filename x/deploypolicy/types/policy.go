@@ -0,0 +1,30 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ParseDeployer parses the hex address of a deployer.
+func ParseDeployer(deployer string) (common.Address, error) {
+	if !common.IsHexAddress(deployer) {
+		return common.Address{}, ErrInvalidDeployer.Wrap(deployer)
+	}
+	return common.HexToAddress(deployer), nil
+}
+
+// ParseCodeHash parses a hex encoded code hash.
+func ParseCodeHash(codeHash string) (common.Hash, error) {
+	bz, err := hexutil.Decode(codeHash)
+	if err != nil || len(bz) != common.HashLength {
+		return common.Hash{}, ErrInvalidCodeHash.Wrapf("must be %d hex encoded bytes: %s", common.HashLength, codeHash)
+	}
+	return common.BytesToHash(bz), nil
+}
+
+// CodeHash returns the hash identifying creation code in the approved code
+// hashes, constructor arguments included.
+func CodeHash(initCode []byte) common.Hash {
+	return crypto.Keccak256Hash(initCode)
+}
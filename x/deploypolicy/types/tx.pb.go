@@ -0,0 +1,2166 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: flora/deploypolicy/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the parameters to update. All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee24c19be070c49, []int{0}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the Msg/UpdateParams response type.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee24c19be070c49, []int{1}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgAddDeployers allowlists deployers.
+type MsgAddDeployers struct {
+	// sender is the governance account or an admin of the module.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// deployers are the hex addresses of the deployers, either accounts or
+	// factory contracts.
+	Deployers []string `protobuf:"bytes,2,rep,name=deployers,proto3" json:"deployers,omitempty"`
+}
+
+func (m *MsgAddDeployers) Reset()         { *m = MsgAddDeployers{} }
+func (m *MsgAddDeployers) String() string { return proto.CompactTextString(m) }
+func (*MsgAddDeployers) ProtoMessage()    {}
+func (*MsgAddDeployers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee24c19be070c49, []int{2}
+}
+func (m *MsgAddDeployers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddDeployers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddDeployers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddDeployers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddDeployers.Merge(m, src)
+}
+func (m *MsgAddDeployers) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddDeployers) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddDeployers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddDeployers proto.InternalMessageInfo
+
+func (m *MsgAddDeployers) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgAddDeployers) GetDeployers() []string {
+	if m != nil {
+		return m.Deployers
+	}
+	return nil
+}
+
+// MsgAddDeployersResponse defines the Msg/AddDeployers response type.
+type MsgAddDeployersResponse struct {
+}
+
+func (m *MsgAddDeployersResponse) Reset()         { *m = MsgAddDeployersResponse{} }
+func (m *MsgAddDeployersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddDeployersResponse) ProtoMessage()    {}
+func (*MsgAddDeployersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee24c19be070c49, []int{3}
+}
+func (m *MsgAddDeployersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddDeployersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddDeployersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddDeployersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddDeployersResponse.Merge(m, src)
+}
+func (m *MsgAddDeployersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddDeployersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddDeployersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddDeployersResponse proto.InternalMessageInfo
+
+// MsgRemoveDeployers removes deployers from the allowlist.
+type MsgRemoveDeployers struct {
+	// sender is the governance account or an admin of the module.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// deployers are the hex addresses of the deployers.
+	Deployers []string `protobuf:"bytes,2,rep,name=deployers,proto3" json:"deployers,omitempty"`
+}
+
+func (m *MsgRemoveDeployers) Reset()         { *m = MsgRemoveDeployers{} }
+func (m *MsgRemoveDeployers) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveDeployers) ProtoMessage()    {}
+func (*MsgRemoveDeployers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee24c19be070c49, []int{4}
+}
+func (m *MsgRemoveDeployers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveDeployers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveDeployers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveDeployers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveDeployers.Merge(m, src)
+}
+func (m *MsgRemoveDeployers) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveDeployers) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveDeployers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveDeployers proto.InternalMessageInfo
+
+func (m *MsgRemoveDeployers) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRemoveDeployers) GetDeployers() []string {
+	if m != nil {
+		return m.Deployers
+	}
+	return nil
+}
+
+// MsgRemoveDeployersResponse defines the Msg/RemoveDeployers response type.
+type MsgRemoveDeployersResponse struct {
+}
+
+func (m *MsgRemoveDeployersResponse) Reset()         { *m = MsgRemoveDeployersResponse{} }
+func (m *MsgRemoveDeployersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveDeployersResponse) ProtoMessage()    {}
+func (*MsgRemoveDeployersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee24c19be070c49, []int{5}
+}
+func (m *MsgRemoveDeployersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveDeployersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveDeployersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveDeployersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveDeployersResponse.Merge(m, src)
+}
+func (m *MsgRemoveDeployersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveDeployersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveDeployersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveDeployersResponse proto.InternalMessageInfo
+
+// MsgApproveCode approves creation code.
+type MsgApproveCode struct {
+	// sender is the governance account or an admin of the module.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// code_hashes are the hex encoded keccak256 hashes of the creation code,
+	// constructor arguments included.
+	CodeHashes []string `protobuf:"bytes,2,rep,name=code_hashes,json=codeHashes,proto3" json:"code_hashes,omitempty"`
+}
+
+func (m *MsgApproveCode) Reset()         { *m = MsgApproveCode{} }
+func (m *MsgApproveCode) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveCode) ProtoMessage()    {}
+func (*MsgApproveCode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee24c19be070c49, []int{6}
+}
+func (m *MsgApproveCode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveCode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveCode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveCode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveCode.Merge(m, src)
+}
+func (m *MsgApproveCode) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveCode) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveCode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveCode proto.InternalMessageInfo
+
+func (m *MsgApproveCode) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgApproveCode) GetCodeHashes() []string {
+	if m != nil {
+		return m.CodeHashes
+	}
+	return nil
+}
+
+// MsgApproveCodeResponse defines the Msg/ApproveCode response type.
+type MsgApproveCodeResponse struct {
+}
+
+func (m *MsgApproveCodeResponse) Reset()         { *m = MsgApproveCodeResponse{} }
+func (m *MsgApproveCodeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveCodeResponse) ProtoMessage()    {}
+func (*MsgApproveCodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee24c19be070c49, []int{7}
+}
+func (m *MsgApproveCodeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveCodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveCodeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveCodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveCodeResponse.Merge(m, src)
+}
+func (m *MsgApproveCodeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveCodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveCodeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveCodeResponse proto.InternalMessageInfo
+
+// MsgRevokeCode revokes the approval of creation code.
+type MsgRevokeCode struct {
+	// sender is the governance account or an admin of the module.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// code_hashes are the hex encoded keccak256 hashes of the creation code.
+	CodeHashes []string `protobuf:"bytes,2,rep,name=code_hashes,json=codeHashes,proto3" json:"code_hashes,omitempty"`
+}
+
+func (m *MsgRevokeCode) Reset()         { *m = MsgRevokeCode{} }
+func (m *MsgRevokeCode) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeCode) ProtoMessage()    {}
+func (*MsgRevokeCode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee24c19be070c49, []int{8}
+}
+func (m *MsgRevokeCode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeCode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeCode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeCode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeCode.Merge(m, src)
+}
+func (m *MsgRevokeCode) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeCode) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeCode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeCode proto.InternalMessageInfo
+
+func (m *MsgRevokeCode) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRevokeCode) GetCodeHashes() []string {
+	if m != nil {
+		return m.CodeHashes
+	}
+	return nil
+}
+
+// MsgRevokeCodeResponse defines the Msg/RevokeCode response type.
+type MsgRevokeCodeResponse struct {
+}
+
+func (m *MsgRevokeCodeResponse) Reset()         { *m = MsgRevokeCodeResponse{} }
+func (m *MsgRevokeCodeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeCodeResponse) ProtoMessage()    {}
+func (*MsgRevokeCodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee24c19be070c49, []int{9}
+}
+func (m *MsgRevokeCodeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeCodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeCodeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeCodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeCodeResponse.Merge(m, src)
+}
+func (m *MsgRevokeCodeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeCodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeCodeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeCodeResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "flora.deploypolicy.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "flora.deploypolicy.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgAddDeployers)(nil), "flora.deploypolicy.v1.MsgAddDeployers")
+	proto.RegisterType((*MsgAddDeployersResponse)(nil), "flora.deploypolicy.v1.MsgAddDeployersResponse")
+	proto.RegisterType((*MsgRemoveDeployers)(nil), "flora.deploypolicy.v1.MsgRemoveDeployers")
+	proto.RegisterType((*MsgRemoveDeployersResponse)(nil), "flora.deploypolicy.v1.MsgRemoveDeployersResponse")
+	proto.RegisterType((*MsgApproveCode)(nil), "flora.deploypolicy.v1.MsgApproveCode")
+	proto.RegisterType((*MsgApproveCodeResponse)(nil), "flora.deploypolicy.v1.MsgApproveCodeResponse")
+	proto.RegisterType((*MsgRevokeCode)(nil), "flora.deploypolicy.v1.MsgRevokeCode")
+	proto.RegisterType((*MsgRevokeCodeResponse)(nil), "flora.deploypolicy.v1.MsgRevokeCodeResponse")
+}
+
+func init() { proto.RegisterFile("flora/deploypolicy/v1/tx.proto", fileDescriptor_fee24c19be070c49) }
+
+var fileDescriptor_fee24c19be070c49 = []byte{
+	// 597 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x3f, 0x6f, 0xd3, 0x50,
+	0x14, 0xc5, 0xf3, 0x5a, 0x88, 0x94, 0x1b, 0xa0, 0xc2, 0x6a, 0x49, 0x6a, 0x15, 0x37, 0x32, 0x05,
+	0x42, 0x44, 0xed, 0x26, 0x45, 0x54, 0xca, 0x44, 0x03, 0x03, 0x42, 0x8a, 0x84, 0x82, 0x58, 0x58,
+	0x8a, 0x6b, 0xbf, 0x3a, 0x16, 0x71, 0xae, 0xe5, 0xe7, 0x46, 0xcd, 0x86, 0x18, 0x99, 0x18, 0x10,
+	0xb0, 0xb0, 0x33, 0x66, 0xe0, 0x23, 0x30, 0x74, 0xac, 0x98, 0x98, 0x10, 0x4a, 0x86, 0x7c, 0x0d,
+	0xe4, 0x7f, 0x89, 0x6d, 0x12, 0x13, 0x55, 0xea, 0x12, 0xc5, 0xe7, 0x1e, 0xdf, 0xf3, 0xbb, 0x4f,
+	0xf7, 0x25, 0x20, 0x1c, 0x75, 0xd0, 0x56, 0x64, 0x8d, 0x5a, 0x1d, 0xec, 0x5b, 0xd8, 0x31, 0xd4,
+	0xbe, 0xdc, 0xab, 0xca, 0xce, 0x89, 0x64, 0xd9, 0xe8, 0x20, 0xb7, 0xe6, 0xd5, 0xa5, 0x68, 0x5d,
+	0xea, 0x55, 0xf9, 0x55, 0x1d, 0x75, 0xf4, 0x1c, 0xb2, 0xfb, 0xcd, 0x37, 0xf3, 0xeb, 0x2a, 0x32,
+	0x13, 0xd9, 0x81, 0x5f, 0xf0, 0x1f, 0x82, 0x52, 0xc1, 0x7f, 0x92, 0x4d, 0xa6, 0xbb, 0xfd, 0x4d,
+	0xa6, 0x07, 0x85, 0xeb, 0x8a, 0x69, 0x74, 0x51, 0xf6, 0x3e, 0x03, 0xa9, 0x3c, 0x9b, 0x29, 0xc6,
+	0xe0, 0x39, 0xc5, 0x1f, 0x04, 0x56, 0x9a, 0x4c, 0x7f, 0x69, 0x69, 0x8a, 0x43, 0x9f, 0x2b, 0xb6,
+	0x62, 0x32, 0xee, 0x21, 0xe4, 0x94, 0x63, 0xa7, 0x8d, 0xb6, 0xe1, 0xf4, 0x8b, 0xa4, 0x44, 0xca,
+	0xb9, 0x46, 0xf1, 0xe7, 0xf7, 0xed, 0xd5, 0x00, 0x67, 0x5f, 0xd3, 0x6c, 0xca, 0xd8, 0x0b, 0xc7,
+	0x36, 0xba, 0x7a, 0x6b, 0x6a, 0xe5, 0x1e, 0x41, 0xd6, 0xf2, 0x3a, 0x14, 0x97, 0x4a, 0xa4, 0x9c,
+	0xaf, 0xdd, 0x94, 0x66, 0x8e, 0x2e, 0xf9, 0x31, 0x8d, 0xdc, 0xe9, 0xef, 0xcd, 0xcc, 0xb7, 0xf1,
+	0xa0, 0x42, 0x5a, 0xc1, 0x7b, 0xf5, 0xbd, 0x77, 0xe3, 0x41, 0x65, 0xda, 0xf1, 0xfd, 0x78, 0x50,
+	0xd9, 0xf2, 0x47, 0x39, 0x89, 0x0f, 0x93, 0x40, 0x16, 0xd7, 0xa1, 0x90, 0x90, 0x5a, 0x94, 0x59,
+	0xd8, 0x65, 0x54, 0xfc, 0xec, 0x4f, 0xb8, 0xaf, 0x69, 0x4f, 0xbc, 0x0e, 0xd4, 0x66, 0xdc, 0x0e,
+	0x64, 0x19, 0xed, 0x6a, 0xd4, 0xfe, 0xef, 0x78, 0x81, 0x8f, 0xdb, 0x80, 0x9c, 0x16, 0xbe, 0x5e,
+	0x5c, 0x2a, 0x2d, 0x97, 0x73, 0xad, 0xa9, 0x50, 0x7f, 0xe0, 0x72, 0x07, 0xd6, 0x54, 0xe8, 0x28,
+	0x45, 0x00, 0x1d, 0x95, 0x26, 0xd0, 0x5f, 0x09, 0x70, 0x4d, 0xa6, 0xb7, 0xa8, 0x89, 0x3d, 0x7a,
+	0x71, 0xdc, 0x7b, 0x09, 0xee, 0xbb, 0xf3, 0xb8, 0x13, 0x20, 0xe2, 0x06, 0xf0, 0xff, 0xaa, 0x13,
+	0xfa, 0x2f, 0x04, 0xae, 0xb9, 0x93, 0x59, 0x96, 0x8d, 0x3d, 0xfa, 0x18, 0x35, 0x7a, 0x0e, 0xf2,
+	0x4d, 0xc8, 0xab, 0xa8, 0xd1, 0x83, 0xb6, 0xc2, 0xda, 0x34, 0x64, 0x07, 0x57, 0x7a, 0xea, 0x29,
+	0xf5, 0xdd, 0x04, 0xfc, 0xad, 0xb9, 0x87, 0x3e, 0xe5, 0x10, 0x8b, 0x70, 0x23, 0xae, 0x4c, 0xa0,
+	0x3f, 0x11, 0xb8, 0xea, 0xcd, 0xd4, 0xc3, 0x37, 0x17, 0xc6, 0x5c, 0x4b, 0x30, 0x8b, 0xf3, 0x0f,
+	0x3c, 0xc4, 0x10, 0x0b, 0xb0, 0x16, 0x13, 0x42, 0xe2, 0xda, 0xc7, 0x4b, 0xb0, 0xdc, 0x64, 0x3a,
+	0x77, 0x04, 0x57, 0x62, 0xf7, 0xf7, 0xce, 0x9c, 0x7b, 0x97, 0xb8, 0x21, 0xbc, 0xb4, 0x98, 0x2f,
+	0xcc, 0x73, 0x73, 0x62, 0xb7, 0x28, 0x25, 0x27, 0xea, 0x4b, 0xcb, 0x99, 0xb5, 0xfc, 0x1c, 0xc2,
+	0x4a, 0x72, 0xf1, 0xef, 0xcd, 0x6f, 0x91, 0xb0, 0xf2, 0xd5, 0x85, 0xad, 0x93, 0x40, 0x15, 0xf2,
+	0xd1, 0x5d, 0xbd, 0x9d, 0xc2, 0x3b, 0xb5, 0xf1, 0xdb, 0x0b, 0xd9, 0x26, 0x21, 0xaf, 0x01, 0x22,
+	0xbb, 0xb5, 0x95, 0x46, 0x19, 0xba, 0xf8, 0xfb, 0x8b, 0xb8, 0xc2, 0x04, 0xfe, 0xf2, 0x5b, 0xf7,
+	0xc7, 0xb4, 0xf1, 0xec, 0x74, 0x28, 0x90, 0xb3, 0xa1, 0x40, 0xfe, 0x0c, 0x05, 0xf2, 0x61, 0x24,
+	0x64, 0xce, 0x46, 0x42, 0xe6, 0xd7, 0x48, 0xc8, 0xbc, 0xda, 0xd1, 0x0d, 0xa7, 0x7d, 0x7c, 0x28,
+	0xa9, 0x68, 0xca, 0x36, 0x76, 0x3a, 0x6a, 0x5b, 0x31, 0xba, 0x4c, 0x9e, 0xb9, 0x83, 0x4e, 0xdf,
+	0xa2, 0xec, 0x30, 0xeb, 0xfd, 0x4b, 0xec, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x41, 0xd7, 0xe6,
+	0x5c, 0xe5, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a governance operation for updating the module
+	// parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// AddDeployers allowlists deployers. The sender must be the governance
+	// account or an admin of the module.
+	AddDeployers(ctx context.Context, in *MsgAddDeployers, opts ...grpc.CallOption) (*MsgAddDeployersResponse, error)
+	// RemoveDeployers removes deployers from the allowlist. The sender must be
+	// the governance account or an admin of the module.
+	RemoveDeployers(ctx context.Context, in *MsgRemoveDeployers, opts ...grpc.CallOption) (*MsgRemoveDeployersResponse, error)
+	// ApproveCode approves creation code. The sender must be the governance
+	// account or an admin of the module.
+	ApproveCode(ctx context.Context, in *MsgApproveCode, opts ...grpc.CallOption) (*MsgApproveCodeResponse, error)
+	// RevokeCode revokes the approval of creation code. The sender must be the
+	// governance account or an admin of the module.
+	RevokeCode(ctx context.Context, in *MsgRevokeCode, opts ...grpc.CallOption) (*MsgRevokeCodeResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/flora.deploypolicy.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddDeployers(ctx context.Context, in *MsgAddDeployers, opts ...grpc.CallOption) (*MsgAddDeployersResponse, error) {
+	out := new(MsgAddDeployersResponse)
+	err := c.cc.Invoke(ctx, "/flora.deploypolicy.v1.Msg/AddDeployers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveDeployers(ctx context.Context, in *MsgRemoveDeployers, opts ...grpc.CallOption) (*MsgRemoveDeployersResponse, error) {
+	out := new(MsgRemoveDeployersResponse)
+	err := c.cc.Invoke(ctx, "/flora.deploypolicy.v1.Msg/RemoveDeployers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveCode(ctx context.Context, in *MsgApproveCode, opts ...grpc.CallOption) (*MsgApproveCodeResponse, error) {
+	out := new(MsgApproveCodeResponse)
+	err := c.cc.Invoke(ctx, "/flora.deploypolicy.v1.Msg/ApproveCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeCode(ctx context.Context, in *MsgRevokeCode, opts ...grpc.CallOption) (*MsgRevokeCodeResponse, error) {
+	out := new(MsgRevokeCodeResponse)
+	err := c.cc.Invoke(ctx, "/flora.deploypolicy.v1.Msg/RevokeCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a governance operation for updating the module
+	// parameters.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// AddDeployers allowlists deployers. The sender must be the governance
+	// account or an admin of the module.
+	AddDeployers(context.Context, *MsgAddDeployers) (*MsgAddDeployersResponse, error)
+	// RemoveDeployers removes deployers from the allowlist. The sender must be
+	// the governance account or an admin of the module.
+	RemoveDeployers(context.Context, *MsgRemoveDeployers) (*MsgRemoveDeployersResponse, error)
+	// ApproveCode approves creation code. The sender must be the governance
+	// account or an admin of the module.
+	ApproveCode(context.Context, *MsgApproveCode) (*MsgApproveCodeResponse, error)
+	// RevokeCode revokes the approval of creation code. The sender must be the
+	// governance account or an admin of the module.
+	RevokeCode(context.Context, *MsgRevokeCode) (*MsgRevokeCodeResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) AddDeployers(ctx context.Context, req *MsgAddDeployers) (*MsgAddDeployersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDeployers not implemented")
+}
+func (*UnimplementedMsgServer) RemoveDeployers(ctx context.Context, req *MsgRemoveDeployers) (*MsgRemoveDeployersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDeployers not implemented")
+}
+func (*UnimplementedMsgServer) ApproveCode(ctx context.Context, req *MsgApproveCode) (*MsgApproveCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveCode not implemented")
+}
+func (*UnimplementedMsgServer) RevokeCode(ctx context.Context, req *MsgRevokeCode) (*MsgRevokeCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCode not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flora.deploypolicy.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddDeployers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddDeployers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddDeployers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flora.deploypolicy.v1.Msg/AddDeployers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddDeployers(ctx, req.(*MsgAddDeployers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveDeployers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveDeployers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveDeployers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flora.deploypolicy.v1.Msg/RemoveDeployers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveDeployers(ctx, req.(*MsgRemoveDeployers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveCode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flora.deploypolicy.v1.Msg/ApproveCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveCode(ctx, req.(*MsgApproveCode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeCode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flora.deploypolicy.v1.Msg/RevokeCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeCode(ctx, req.(*MsgRevokeCode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "flora.deploypolicy.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "AddDeployers",
+			Handler:    _Msg_AddDeployers_Handler,
+		},
+		{
+			MethodName: "RemoveDeployers",
+			Handler:    _Msg_RemoveDeployers_Handler,
+		},
+		{
+			MethodName: "ApproveCode",
+			Handler:    _Msg_ApproveCode_Handler,
+		},
+		{
+			MethodName: "RevokeCode",
+			Handler:    _Msg_RevokeCode_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "flora/deploypolicy/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddDeployers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddDeployers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddDeployers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deployers) > 0 {
+		for iNdEx := len(m.Deployers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Deployers[iNdEx])
+			copy(dAtA[i:], m.Deployers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Deployers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddDeployersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddDeployersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddDeployersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveDeployers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveDeployers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveDeployers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deployers) > 0 {
+		for iNdEx := len(m.Deployers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Deployers[iNdEx])
+			copy(dAtA[i:], m.Deployers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Deployers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveDeployersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveDeployersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveDeployersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveCode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveCode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveCode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeHashes) > 0 {
+		for iNdEx := len(m.CodeHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CodeHashes[iNdEx])
+			copy(dAtA[i:], m.CodeHashes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.CodeHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveCodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveCodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeCode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeCode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeCode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeHashes) > 0 {
+		for iNdEx := len(m.CodeHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CodeHashes[iNdEx])
+			copy(dAtA[i:], m.CodeHashes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.CodeHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeCodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeCodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddDeployers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Deployers) > 0 {
+		for _, s := range m.Deployers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddDeployersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveDeployers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Deployers) > 0 {
+		for _, s := range m.Deployers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRemoveDeployersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgApproveCode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CodeHashes) > 0 {
+		for _, s := range m.CodeHashes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgApproveCodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeCode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CodeHashes) > 0 {
+		for _, s := range m.CodeHashes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRevokeCodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddDeployers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddDeployers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddDeployers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deployers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deployers = append(m.Deployers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddDeployersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddDeployersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddDeployersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveDeployers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveDeployers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveDeployers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deployers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deployers = append(m.Deployers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveDeployersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveDeployersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveDeployersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveCode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveCode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveCode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHashes = append(m.CodeHashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveCodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveCodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeCode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeCode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeCode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHashes = append(m.CodeHashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeCodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeCodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,44 @@
+package evmcircuit
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "flora.evmcircuit.v1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Breakers",
+					Use:       "breakers",
+					Short:     "Query the tripped EVM circuit breakers",
+				},
+				{
+					RpcMethod:      "Tripped",
+					Use:            "tripped [address] [selector]",
+					Short:          "Query whether the calls to an address, or to one of its functions, are stopped",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "selector", Optional: true}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "flora.evmcircuit.v1.Msg",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "TripBreaker",
+					Use:            "trip [address] [selector]",
+					Short:          "Stop the EVM calls to a contract or precompile, or to one of its functions",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "selector", Optional: true}},
+				},
+				{
+					RpcMethod:      "ResetBreaker",
+					Use:            "reset [address] [selector]",
+					Short:          "Resume the EVM calls stopped by a breaker",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "selector", Optional: true}},
+				},
+			},
+		},
+	}
+}
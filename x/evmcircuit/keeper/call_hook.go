@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/evm/x/vm/core/vm"
+	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+var (
+	_ evmtypes.Erc20Keeper   = callHook{}
+	_ vm.PrecompiledContract = guardedContract{}
+)
+
+// the EVM methods running the call frames
+var (
+	callCodeFunc     = funcName((*vm.EVM).CallCode)
+	delegateCallFunc = funcName((*vm.EVM).DelegateCall)
+	callFunc         = funcName((*vm.EVM).Call)
+	staticCallFunc   = funcName((*vm.EVM).StaticCall)
+)
+
+// callHook enforces the breakers on every call frame of the EVM.
+//
+// The opcode hooks of the EVM keeper are fixed and don't see the input of the
+// calls, but the keeper looks up the dynamic precompile of the recipient of
+// every CALL, CALLCODE, DELEGATECALL and STATICCALL before running it. The
+// hook wraps this lookup: it rejects the calls to the addresses whose breaker
+// is tripped, guards the dynamic precompiles and runs the CALL and STATICCALL
+// frames of the contracts with tripped function breakers through
+// guardedContract.
+//
+// The CALLCODE and DELEGATECALL frames of these contracts aren't intercepted:
+// they run the code against the state of the caller, which the function
+// breakers of the contract don't guard.
+type callHook struct {
+	evmtypes.Erc20Keeper
+	keeper Keeper
+}
+
+// CallHook wraps the dynamic precompiles of the EVM keeper to enforce the
+// breakers on every call frame, including the calls from other contracts.
+func (k Keeper) CallHook(precompiles evmtypes.Erc20Keeper) evmtypes.Erc20Keeper {
+	return callHook{Erc20Keeper: precompiles, keeper: k}
+}
+
+// GetERC20PrecompileInstance implements evmtypes.Erc20Keeper.
+func (h callHook) GetERC20PrecompileInstance(ctx sdk.Context, address common.Address) (vm.PrecompiledContract, bool, error) {
+	if err := h.keeper.CheckCall(ctx, address, nil); err != nil {
+		return nil, false, err
+	}
+
+	precompile, found, err := h.Erc20Keeper.GetERC20PrecompileInstance(ctx, address)
+	if err != nil {
+		return nil, false, err
+	}
+	if found {
+		return h.keeper.GuardPrecompile(precompile), true, nil
+	}
+
+	hasBreakers, err := h.keeper.HasBreakers(ctx, address)
+	if err != nil || !hasBreakers || inCallerContext() {
+		return nil, false, err
+	}
+	return guardedContract{address: address, keeper: h.keeper}, true, nil
+}
+
+// inCallerContext reports whether the frame being looked up is a CALLCODE or a
+// DELEGATECALL. The keeper doesn't pass the kind of call to the lookup, so it
+// is read from the EVM method on the call stack.
+func inCallerContext() bool {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	for {
+		frame, more := frames.Next()
+		switch frame.Function {
+		case callCodeFunc, delegateCallFunc:
+			return true
+		case callFunc, staticCallFunc:
+			return false
+		}
+		if !more {
+			return false
+		}
+	}
+}
+
+// funcName returns the name of a function as reported by the call stack.
+func funcName(f any) string {
+	return runtime.FuncForPC(reflect.ValueOf(f).Pointer()).Name()
+}
+
+// guardedContract runs the code of a contract once the breaker of the called
+// function is checked. The EVM runs it as a precompile for the CALL and
+// STATICCALL frames only, which are told apart by the read only flag.
+type guardedContract struct {
+	address common.Address
+	keeper  Keeper
+}
+
+// Address implements vm.PrecompiledContract.
+func (c guardedContract) Address() common.Address {
+	return c.address
+}
+
+// RequiredGas implements vm.PrecompiledContract, the gas is used by the code
+// of the contract.
+func (c guardedContract) RequiredGas([]byte) uint64 {
+	return 0
+}
+
+// Run implements vm.PrecompiledContract.
+func (c guardedContract) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	stateDB, ok := evm.StateDB.(*statedb.StateDB)
+	if !ok {
+		return nil, fmt.Errorf("invalid state db type %T", evm.StateDB)
+	}
+
+	c.dropPrecompile(evm)
+	if err := c.keeper.CheckCall(stateDB.GetContext(), c.address, contract.Input); err != nil {
+		return nil, err
+	}
+
+	code := evm.StateDB.GetCode(c.address)
+	if len(code) == 0 {
+		return nil, nil
+	}
+
+	frame := vm.NewContract(vm.AccountRef(contract.Caller()), vm.AccountRef(c.address), contract.Value(), contract.Gas)
+	frame.SetCallCode(&c.address, evm.StateDB.GetCodeHash(c.address), code)
+	ret, err := evm.Interpreter().Run(frame, contract.Input, readOnly)
+	contract.Gas = frame.Gas
+	return ret, err
+}
+
+// dropPrecompile removes the contract from the precompiles of the EVM. The
+// keeper only swaps them on the lookups finding a precompile, so the contract
+// would otherwise be run as one by the next CALLCODE or DELEGATECALL frames.
+func (c guardedContract) dropPrecompile(evm *vm.EVM) {
+	precompiles := make(map[common.Address]vm.PrecompiledContract)
+	var addresses []common.Address
+	for _, address := range evm.ActivePrecompiles(evm.ChainConfig().Rules(evm.Context.BlockNumber, true)) {
+		if precompile, found := evm.Precompile(address); found && address != c.address {
+			precompiles[address] = precompile
+			addresses = append(addresses, address)
+		}
+	}
+	evm.WithPrecompiles(precompiles, addresses)
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	"github.com/rollchains/flora/x/evmcircuit/types"
+)
+
+// InitGenesis initializes the module state from a genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error {
+	for _, b := range gs.Breakers {
+		address, selector, err := types.ParseBreaker(b.Address, b.Selector)
+		if err != nil {
+			return err
+		}
+		breaker := types.NewBreaker(address, selector, b.TrippedBy)
+		if err := k.Breakers.Set(ctx, collections.Join(address.Bytes(), selector), breaker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportGenesis exports the module state to a genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	iter, err := k.Breakers.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	breakers, err := iter.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(breakers), nil
+}
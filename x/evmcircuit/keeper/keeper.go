@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	circuitkeeper "cosmossdk.io/x/circuit/keeper"
+	circuittypes "cosmossdk.io/x/circuit/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/rollchains/flora/x/evmcircuit/types"
+)
+
+// EthereumTxTypeURL is the type URL of the EVM transactions. The accounts
+// allowed to trip the circuit breaker of the EVM transactions by the circuit
+// module can trip and reset the breakers of single contracts and precompiles.
+var EthereumTxTypeURL = sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})
+
+// Keeper of the evmcircuit store.
+type Keeper struct {
+	cdc           codec.BinaryCodec
+	circuitKeeper *circuitkeeper.Keeper
+
+	Schema   collections.Schema
+	Breakers collections.Map[collections.Pair[[]byte, []byte], types.Breaker]
+}
+
+// NewKeeper creates a new evmcircuit Keeper instance.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService storetypes.KVStoreService,
+	circuitKeeper *circuitkeeper.Keeper,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := Keeper{
+		cdc:           cdc,
+		circuitKeeper: circuitKeeper,
+		Breakers: collections.NewMap(sb, types.BreakersKey, "breakers",
+			collections.PairKeyCodec(collections.BytesKey, collections.BytesKey), codec.CollValue[types.Breaker](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return sdk.UnwrapSDKContext(ctx).Logger().With("module", "x/"+types.ModuleName)
+}
+
+// Authorize checks that an account may trip and reset breakers: the circuit
+// module authority, its super admins and the accounts allowed to trip the
+// circuit breaker of the EVM transactions.
+func (k Keeper) Authorize(ctx context.Context, account sdk.AccAddress) error {
+	if bytes.Equal(account, k.circuitKeeper.GetAuthority()) {
+		return nil
+	}
+
+	perms, err := k.circuitKeeper.Permissions.Get(ctx, account)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	switch perms.Level {
+	case circuittypes.Permissions_LEVEL_SUPER_ADMIN, circuittypes.Permissions_LEVEL_ALL_MSGS:
+		return nil
+	case circuittypes.Permissions_LEVEL_SOME_MSGS:
+		for _, url := range perms.LimitTypeUrls {
+			if url == EthereumTxTypeURL {
+				return nil
+			}
+		}
+	}
+
+	return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account does not have permission to trip circuit breaker for message %s", EthereumTxTypeURL)
+}
+
+// Trip stops the calls to an address, or to one of its functions when the
+// selector is set.
+func (k Keeper) Trip(ctx context.Context, address common.Address, selector []byte, trippedBy string) error {
+	key := collections.Join(address.Bytes(), selector)
+	has, err := k.Breakers.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	breaker := types.NewBreaker(address, selector, trippedBy)
+	if has {
+		return types.ErrInvalidBreaker.Wrapf("%s already tripped", breaker.ID())
+	}
+
+	if err := k.Breakers.Set(ctx, key, breaker); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTripBreaker,
+		sdk.NewAttribute(types.AttributeKeyAuthority, trippedBy),
+		sdk.NewAttribute(types.AttributeKeyAddress, breaker.Address),
+		sdk.NewAttribute(types.AttributeKeySelector, breaker.Selector),
+	))
+
+	return nil
+}
+
+// Reset resumes the calls stopped by a breaker.
+func (k Keeper) Reset(ctx context.Context, address common.Address, selector []byte, resetBy string) error {
+	key := collections.Join(address.Bytes(), selector)
+	breaker, err := k.Breakers.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ErrBreakerNotTripped.Wrap(types.NewBreaker(address, selector, "").ID())
+	} else if err != nil {
+		return err
+	}
+
+	if err := k.Breakers.Remove(ctx, key); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeResetBreaker,
+		sdk.NewAttribute(types.AttributeKeyAuthority, resetBy),
+		sdk.NewAttribute(types.AttributeKeyAddress, breaker.Address),
+		sdk.NewAttribute(types.AttributeKeySelector, breaker.Selector),
+	))
+
+	return nil
+}
+
+// IsTripped returns true if the calls to an address with the given input are
+// stopped, either by the breaker of the address or by the breaker of the
+// function selected by the input.
+func (k Keeper) IsTripped(ctx context.Context, address common.Address, input []byte) (bool, error) {
+	tripped, err := k.Breakers.Has(ctx, collections.Join(address.Bytes(), []byte{}))
+	if err != nil || tripped || len(input) < types.SelectorLength {
+		return tripped, err
+	}
+
+	return k.Breakers.Has(ctx, collections.Join(address.Bytes(), input[:types.SelectorLength]))
+}
+
+// HasBreakers returns true if a breaker of an address, or of one of its
+// functions, is tripped.
+func (k Keeper) HasBreakers(ctx context.Context, address common.Address) (bool, error) {
+	iter, err := k.Breakers.Iterate(ctx, collections.NewPrefixedPairRange[[]byte, []byte](address.Bytes()))
+	if err != nil {
+		return false, err
+	}
+	defer iter.Close()
+
+	return iter.Valid(), nil
+}
+
+// CheckCall returns an error if the calls to an address with the given input
+// are stopped.
+func (k Keeper) CheckCall(ctx context.Context, address common.Address, input []byte) error {
+	tripped, err := k.IsTripped(ctx, address, input)
+	if err != nil {
+		return err
+	}
+	if tripped {
+		if len(input) >= types.SelectorLength {
+			return types.ErrBreakerTripped.Wrapf("call to %s with selector %x", address, input[:types.SelectorLength])
+		}
+		return types.ErrBreakerTripped.Wrapf("call to %s", address)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/rollchains/flora/x/evmcircuit/types"
+)
+
+type msgServer struct {
+	k Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the module MsgServer interface.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{k: keeper}
+}
+
+// TripBreaker implements types.MsgServer.
+func (ms msgServer) TripBreaker(ctx context.Context, msg *types.MsgTripBreaker) (*types.MsgTripBreakerResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.Authorize(ctx, sdk.MustAccAddressFromBech32(msg.Authority)); err != nil {
+		return nil, err
+	}
+
+	address, selector, err := types.ParseBreaker(msg.Address, msg.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.Trip(ctx, address, selector, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTripBreakerResponse{}, nil
+}
+
+// ResetBreaker implements types.MsgServer.
+func (ms msgServer) ResetBreaker(ctx context.Context, msg *types.MsgResetBreaker) (*types.MsgResetBreakerResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.Authorize(ctx, sdk.MustAccAddressFromBech32(msg.Authority)); err != nil {
+		return nil, err
+	}
+
+	address, selector, err := types.ParseBreaker(msg.Address, msg.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.Reset(ctx, address, selector, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResetBreakerResponse{}, nil
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/evm/x/vm/core/vm"
+	"github.com/cosmos/evm/x/vm/statedb"
+)
+
+var _ vm.PrecompiledContract = guardedPrecompile{}
+
+// guardedPrecompile fails the calls to a precompile while its breaker, or
+// the breaker of the called function, is tripped.
+type guardedPrecompile struct {
+	vm.PrecompiledContract
+	keeper Keeper
+}
+
+// GuardPrecompile wraps a precompile to enforce its breakers on every call,
+// including the calls from other contracts.
+func (k Keeper) GuardPrecompile(precompile vm.PrecompiledContract) vm.PrecompiledContract {
+	return guardedPrecompile{PrecompiledContract: precompile, keeper: k}
+}
+
+// Run implements vm.PrecompiledContract.
+func (p guardedPrecompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	stateDB, ok := evm.StateDB.(*statedb.StateDB)
+	if !ok {
+		return nil, fmt.Errorf("invalid state db type %T", evm.StateDB)
+	}
+
+	if err := p.keeper.CheckCall(stateDB.GetContext(), p.Address(), contract.Input); err != nil {
+		return nil, err
+	}
+
+	return p.PrecompiledContract.Run(evm, contract, readOnly)
+}
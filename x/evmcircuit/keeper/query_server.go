@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/rollchains/flora/x/evmcircuit/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier implements the module gRPC query service.
+type Querier struct {
+	Keeper
+}
+
+// NewQuerier returns an implementation of the module QueryServer interface.
+func NewQuerier(keeper Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Breakers implements types.QueryServer.
+func (q Querier) Breakers(ctx context.Context, req *types.QueryBreakersRequest) (*types.QueryBreakersResponse, error) {
+	breakers, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.Breakers, req.Pagination,
+		func(_ collections.Pair[[]byte, []byte], breaker types.Breaker) (types.Breaker, error) {
+			return breaker, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBreakersResponse{Breakers: breakers, Pagination: pageRes}, nil
+}
+
+// Tripped implements types.QueryServer.
+func (q Querier) Tripped(ctx context.Context, req *types.QueryTrippedRequest) (*types.QueryTrippedResponse, error) {
+	address, selector, err := types.ParseBreaker(req.Address, req.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	tripped, err := q.IsTripped(ctx, address, selector)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTrippedResponse{Tripped: tripped}, nil
+}
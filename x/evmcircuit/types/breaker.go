@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// SelectorLength is the length of a function selector.
+const SelectorLength = 4
+
+// ParseBreaker decodes the hex address and optional hex selector of a
+// breaker.
+func ParseBreaker(address, selector string) (common.Address, []byte, error) {
+	if !common.IsHexAddress(address) {
+		return common.Address{}, nil, ErrInvalidBreaker.Wrapf("invalid address: %s", address)
+	}
+
+	if selector == "" {
+		return common.HexToAddress(address), nil, nil
+	}
+
+	bz, err := hexutil.Decode(selector)
+	if err != nil || len(bz) != SelectorLength {
+		return common.Address{}, nil, ErrInvalidBreaker.Wrapf("selector must be %d hex encoded bytes: %s", SelectorLength, selector)
+	}
+
+	return common.HexToAddress(address), bz, nil
+}
+
+// NewBreaker returns a breaker with the address and selector in their
+// canonical encoding.
+func NewBreaker(address common.Address, selector []byte, trippedBy string) Breaker {
+	b := Breaker{Address: address.Hex(), TrippedBy: trippedBy}
+	if len(selector) > 0 {
+		b.Selector = hexutil.Encode(selector)
+	}
+	return b
+}
+
+// ID returns the address and selector of the breaker.
+func (b Breaker) ID() string {
+	if b.Selector == "" {
+		return b.Address
+	}
+	return fmt.Sprintf("%s:%s", b.Address, b.Selector)
+}
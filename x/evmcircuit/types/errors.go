@@ -0,0 +1,9 @@
+package types
+
+import "cosmossdk.io/errors"
+
+var (
+	ErrInvalidBreaker    = errors.Register(ModuleName, 2, "invalid breaker")
+	ErrBreakerTripped    = errors.Register(ModuleName, 3, "circuit breaker tripped")
+	ErrBreakerNotTripped = errors.Register(ModuleName, 4, "circuit breaker not tripped")
+)
@@ -0,0 +1,11 @@
+package types
+
+// evmcircuit module event types and attributes
+const (
+	EventTypeTripBreaker  = "evm_trip_circuit_breaker"
+	EventTypeResetBreaker = "evm_reset_circuit_breaker"
+
+	AttributeKeyAuthority = "authority"
+	AttributeKeyAddress   = "address"
+	AttributeKeySelector  = "selector"
+)
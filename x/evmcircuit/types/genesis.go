@@ -0,0 +1,35 @@
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(breakers []Breaker) *GenesisState {
+	return &GenesisState{
+		Breakers: breakers,
+	}
+}
+
+// DefaultGenesis returns the default genesis state.
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(nil)
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool, len(gs.Breakers))
+	for _, b := range gs.Breakers {
+		address, selector, err := ParseBreaker(b.Address, b.Selector)
+		if err != nil {
+			return err
+		}
+
+		canonical := NewBreaker(address, selector, b.TrippedBy).ID()
+		if seen[canonical] {
+			return fmt.Errorf("duplicate breaker %s", canonical)
+		}
+		seen[canonical] = true
+	}
+
+	return nil
+}
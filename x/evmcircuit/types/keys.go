@@ -0,0 +1,15 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "evmcircuit"
+
+	// StoreKey defines the primary module store key, it differs from the module
+	// name as the store keys must not prefix each other, e.g. "evm".
+	StoreKey = "breakers"
+)
+
+// BreakersKey saves the tripped breakers by address and selector.
+var BreakersKey = collections.NewPrefix(0)
@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgTripBreaker{}
+	_ sdk.Msg = &MsgResetBreaker{}
+)
+
+// Validate performs a stateless check of the MsgTripBreaker.
+func (msg MsgTripBreaker) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	_, _, err := ParseBreaker(msg.Address, msg.Selector)
+	return err
+}
+
+// Validate performs a stateless check of the MsgResetBreaker.
+func (msg MsgResetBreaker) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	_, _, err := ParseBreaker(msg.Address, msg.Selector)
+	return err
+}
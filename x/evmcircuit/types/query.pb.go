@@ -0,0 +1,1105 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: flora/evmcircuit/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryBreakersRequest is the request type for the Query/Breakers RPC method.
+type QueryBreakersRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBreakersRequest) Reset()         { *m = QueryBreakersRequest{} }
+func (m *QueryBreakersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBreakersRequest) ProtoMessage()    {}
+func (*QueryBreakersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef0b2a0b897bcab7, []int{0}
+}
+func (m *QueryBreakersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBreakersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBreakersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBreakersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBreakersRequest.Merge(m, src)
+}
+func (m *QueryBreakersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBreakersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBreakersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBreakersRequest proto.InternalMessageInfo
+
+func (m *QueryBreakersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBreakersResponse is the response type for the Query/Breakers RPC
+// method.
+type QueryBreakersResponse struct {
+	Breakers   []Breaker           `protobuf:"bytes,1,rep,name=breakers,proto3" json:"breakers"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBreakersResponse) Reset()         { *m = QueryBreakersResponse{} }
+func (m *QueryBreakersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBreakersResponse) ProtoMessage()    {}
+func (*QueryBreakersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef0b2a0b897bcab7, []int{1}
+}
+func (m *QueryBreakersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBreakersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBreakersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBreakersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBreakersResponse.Merge(m, src)
+}
+func (m *QueryBreakersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBreakersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBreakersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBreakersResponse proto.InternalMessageInfo
+
+func (m *QueryBreakersResponse) GetBreakers() []Breaker {
+	if m != nil {
+		return m.Breakers
+	}
+	return nil
+}
+
+func (m *QueryBreakersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTrippedRequest is the request type for the Query/Tripped RPC method.
+type QueryTrippedRequest struct {
+	// address is the hex address of the contract or precompile.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// selector is the hex encoded 4 bytes function selector, only the breakers
+	// of the whole address are checked when empty.
+	Selector string `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"`
+}
+
+func (m *QueryTrippedRequest) Reset()         { *m = QueryTrippedRequest{} }
+func (m *QueryTrippedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTrippedRequest) ProtoMessage()    {}
+func (*QueryTrippedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef0b2a0b897bcab7, []int{2}
+}
+func (m *QueryTrippedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTrippedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTrippedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTrippedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTrippedRequest.Merge(m, src)
+}
+func (m *QueryTrippedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTrippedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTrippedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTrippedRequest proto.InternalMessageInfo
+
+func (m *QueryTrippedRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryTrippedRequest) GetSelector() string {
+	if m != nil {
+		return m.Selector
+	}
+	return ""
+}
+
+// QueryTrippedResponse is the response type for the Query/Tripped RPC method.
+type QueryTrippedResponse struct {
+	Tripped bool `protobuf:"varint,1,opt,name=tripped,proto3" json:"tripped,omitempty"`
+}
+
+func (m *QueryTrippedResponse) Reset()         { *m = QueryTrippedResponse{} }
+func (m *QueryTrippedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTrippedResponse) ProtoMessage()    {}
+func (*QueryTrippedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef0b2a0b897bcab7, []int{3}
+}
+func (m *QueryTrippedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTrippedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTrippedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTrippedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTrippedResponse.Merge(m, src)
+}
+func (m *QueryTrippedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTrippedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTrippedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTrippedResponse proto.InternalMessageInfo
+
+func (m *QueryTrippedResponse) GetTripped() bool {
+	if m != nil {
+		return m.Tripped
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*QueryBreakersRequest)(nil), "flora.evmcircuit.v1.QueryBreakersRequest")
+	proto.RegisterType((*QueryBreakersResponse)(nil), "flora.evmcircuit.v1.QueryBreakersResponse")
+	proto.RegisterType((*QueryTrippedRequest)(nil), "flora.evmcircuit.v1.QueryTrippedRequest")
+	proto.RegisterType((*QueryTrippedResponse)(nil), "flora.evmcircuit.v1.QueryTrippedResponse")
+}
+
+func init() { proto.RegisterFile("flora/evmcircuit/v1/query.proto", fileDescriptor_ef0b2a0b897bcab7) }
+
+var fileDescriptor_ef0b2a0b897bcab7 = []byte{
+	// 475 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x8a, 0x13, 0x31,
+	0x1c, 0xc6, 0x9b, 0x8a, 0xb6, 0xcd, 0x9e, 0xcc, 0xae, 0x50, 0x86, 0x3a, 0x5d, 0xca, 0xaa, 0xdd,
+	0x1e, 0x12, 0x5b, 0xdf, 0xa0, 0x82, 0x0a, 0x5e, 0x74, 0xf0, 0xe4, 0x41, 0xc8, 0x4c, 0xe3, 0x6c,
+	0x70, 0x3a, 0xff, 0xd9, 0x24, 0x1d, 0xdc, 0xab, 0x78, 0xf0, 0x28, 0x78, 0xf2, 0x01, 0x04, 0x8f,
+	0x3e, 0xc6, 0x1e, 0x17, 0xbc, 0x78, 0x12, 0x69, 0x05, 0x5f, 0x43, 0x9a, 0xc9, 0xcc, 0xb6, 0x32,
+	0xb2, 0xbd, 0x94, 0x26, 0xf9, 0xfe, 0xdf, 0xf7, 0xcb, 0x37, 0xc1, 0xfd, 0xd7, 0x09, 0x28, 0xce,
+	0x44, 0x3e, 0x8f, 0xa4, 0x8a, 0x16, 0xd2, 0xb0, 0x7c, 0xcc, 0x4e, 0x17, 0x42, 0x9d, 0xd1, 0x4c,
+	0x81, 0x01, 0xb2, 0x6f, 0x05, 0xf4, 0x52, 0x40, 0xf3, 0xb1, 0x77, 0x10, 0x43, 0x0c, 0xf6, 0x9c,
+	0xad, 0xff, 0x15, 0x52, 0xaf, 0x17, 0x03, 0xc4, 0x89, 0x60, 0x3c, 0x93, 0x8c, 0xa7, 0x29, 0x18,
+	0x6e, 0x24, 0xa4, 0xda, 0x9d, 0xde, 0xe4, 0x73, 0x99, 0x02, 0xb3, 0xbf, 0x6e, 0x6b, 0x14, 0x81,
+	0x9e, 0x83, 0x66, 0x21, 0xd7, 0xa2, 0x08, 0x65, 0xf9, 0x38, 0x14, 0x86, 0x8f, 0x59, 0xc6, 0x63,
+	0x99, 0xda, 0x79, 0xa7, 0x3d, 0xaa, 0x03, 0xdd, 0xa0, 0xb2, 0xaa, 0xc1, 0x2b, 0x7c, 0xf0, 0x7c,
+	0xed, 0x33, 0x55, 0x82, 0xbf, 0x11, 0x4a, 0x07, 0xe2, 0x74, 0x21, 0xb4, 0x21, 0x8f, 0x30, 0xbe,
+	0x74, 0xec, 0xa2, 0x43, 0x34, 0xdc, 0x9b, 0xdc, 0xa5, 0x45, 0x3c, 0x5d, 0xc7, 0xd3, 0xe2, 0xce,
+	0x2e, 0x9e, 0x3e, 0xe3, 0xb1, 0x70, 0xb3, 0xc1, 0xc6, 0xe4, 0xe0, 0x0b, 0xc2, 0xb7, 0xfe, 0x09,
+	0xd0, 0x19, 0xa4, 0x5a, 0x90, 0x87, 0xb8, 0x1d, 0xba, 0xbd, 0x2e, 0x3a, 0xbc, 0x36, 0xdc, 0x9b,
+	0xf4, 0x68, 0x4d, 0x75, 0xd4, 0x0d, 0x4e, 0x3b, 0xe7, 0x3f, 0xfb, 0x8d, 0xaf, 0x7f, 0xbe, 0x8d,
+	0x50, 0x50, 0x0d, 0x92, 0xc7, 0x5b, 0x98, 0x4d, 0x8b, 0x79, 0xef, 0x4a, 0xcc, 0x82, 0x60, 0x8b,
+	0xf3, 0x29, 0xde, 0xb7, 0x98, 0x2f, 0x94, 0xcc, 0x32, 0x31, 0x2b, 0x6b, 0xe8, 0xe2, 0x16, 0x9f,
+	0xcd, 0x94, 0xd0, 0xda, 0x76, 0xd0, 0x09, 0xca, 0x25, 0xf1, 0x70, 0x5b, 0x8b, 0x44, 0x44, 0x06,
+	0x94, 0xcd, 0xed, 0x04, 0xd5, 0x7a, 0x70, 0xdf, 0x95, 0x5a, 0x99, 0xb9, 0x2b, 0x77, 0x71, 0xcb,
+	0x14, 0x5b, 0xd6, 0xad, 0x1d, 0x94, 0xcb, 0xc9, 0xe7, 0x26, 0xbe, 0x6e, 0x47, 0xc8, 0x07, 0x84,
+	0xdb, 0x65, 0x57, 0xe4, 0xb8, 0xb6, 0x91, 0xba, 0x0f, 0xe6, 0x8d, 0x76, 0x91, 0x16, 0x1c, 0x83,
+	0x3b, 0xef, 0xbe, 0xff, 0xfe, 0xd4, 0xec, 0x93, 0xdb, 0xac, 0xee, 0x8d, 0x54, 0xe5, 0xbe, 0x47,
+	0xb8, 0xe5, 0xae, 0x40, 0x86, 0xff, 0xb7, 0xdf, 0xae, 0xcc, 0x3b, 0xde, 0x41, 0xe9, 0x38, 0x8e,
+	0x2c, 0x87, 0x4f, 0x7a, 0xb5, 0x1c, 0xae, 0x9b, 0xe9, 0x93, 0xf3, 0xa5, 0x8f, 0x2e, 0x96, 0x3e,
+	0xfa, 0xb5, 0xf4, 0xd1, 0xc7, 0x95, 0xdf, 0xb8, 0x58, 0xf9, 0x8d, 0x1f, 0x2b, 0xbf, 0xf1, 0x92,
+	0xc6, 0xd2, 0x9c, 0x2c, 0x42, 0x1a, 0xc1, 0x9c, 0x29, 0x48, 0x92, 0xe8, 0x84, 0xcb, 0x54, 0x3b,
+	0xb3, 0xb7, 0x9b, 0x76, 0xe6, 0x2c, 0x13, 0x3a, 0xbc, 0x61, 0xdf, 0xfc, 0x83, 0xbf, 0x01, 0x00,
+	0x00, 0xff, 0xff, 0x94, 0x10, 0x14, 0x32, 0xc4, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Breakers queries all the tripped breakers.
+	Breakers(ctx context.Context, in *QueryBreakersRequest, opts ...grpc.CallOption) (*QueryBreakersResponse, error)
+	// Tripped queries whether the calls to a function of an address are
+	// stopped.
+	Tripped(ctx context.Context, in *QueryTrippedRequest, opts ...grpc.CallOption) (*QueryTrippedResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Breakers(ctx context.Context, in *QueryBreakersRequest, opts ...grpc.CallOption) (*QueryBreakersResponse, error) {
+	out := new(QueryBreakersResponse)
+	err := c.cc.Invoke(ctx, "/flora.evmcircuit.v1.Query/Breakers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Tripped(ctx context.Context, in *QueryTrippedRequest, opts ...grpc.CallOption) (*QueryTrippedResponse, error) {
+	out := new(QueryTrippedResponse)
+	err := c.cc.Invoke(ctx, "/flora.evmcircuit.v1.Query/Tripped", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Breakers queries all the tripped breakers.
+	Breakers(context.Context, *QueryBreakersRequest) (*QueryBreakersResponse, error)
+	// Tripped queries whether the calls to a function of an address are
+	// stopped.
+	Tripped(context.Context, *QueryTrippedRequest) (*QueryTrippedResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Breakers(ctx context.Context, req *QueryBreakersRequest) (*QueryBreakersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Breakers not implemented")
+}
+func (*UnimplementedQueryServer) Tripped(ctx context.Context, req *QueryTrippedRequest) (*QueryTrippedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tripped not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Breakers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBreakersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Breakers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flora.evmcircuit.v1.Query/Breakers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Breakers(ctx, req.(*QueryBreakersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Tripped_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTrippedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Tripped(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flora.evmcircuit.v1.Query/Tripped",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Tripped(ctx, req.(*QueryTrippedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "flora.evmcircuit.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Breakers",
+			Handler:    _Query_Breakers_Handler,
+		},
+		{
+			MethodName: "Tripped",
+			Handler:    _Query_Tripped_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "flora/evmcircuit/v1/query.proto",
+}
+
+func (m *QueryBreakersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBreakersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBreakersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBreakersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBreakersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBreakersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Breakers) > 0 {
+		for iNdEx := len(m.Breakers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Breakers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTrippedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTrippedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTrippedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Selector) > 0 {
+		i -= len(m.Selector)
+		copy(dAtA[i:], m.Selector)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Selector)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTrippedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTrippedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTrippedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tripped {
+		i--
+		if m.Tripped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryBreakersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBreakersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Breakers) > 0 {
+		for _, e := range m.Breakers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTrippedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Selector)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTrippedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tripped {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryBreakersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBreakersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBreakersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBreakersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBreakersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBreakersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Breakers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Breakers = append(m.Breakers, Breaker{})
+			if err := m.Breakers[len(m.Breakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTrippedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTrippedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTrippedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTrippedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTrippedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTrippedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tripped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tripped = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/rollchains/flora/x/inflation/types"
+)
+
+// BeginBlocker sends the community pool and ecosystem shares of the coins
+// minted by x/mint in this block out of the fee collector, before x/distribution
+// allocates the rest to the validators.
+func (k Keeper) BeginBlocker(ctx context.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	minter, err := k.mintKeeper.Minter.Get(ctx)
+	if err != nil {
+		return err
+	}
+	mintParams, err := k.mintKeeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	minted := minter.BlockProvision(mintParams)
+	if !minted.IsPositive() {
+		return nil
+	}
+
+	feeCollector := k.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	communityPool := shareOf(minted, params.CommunityPoolShare)
+	if !communityPool.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, communityPool, feeCollector); err != nil {
+			return err
+		}
+	}
+
+	ecosystem := shareOf(minted, params.EcosystemShare)
+	if !ecosystem.IsZero() {
+		ecosystemAddr, err := sdk.AccAddressFromBech32(params.EcosystemAddress)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoins(ctx, feeCollector, ecosystemAddr, ecosystem); err != nil {
+			return err
+		}
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDistributeProvisions,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, minted.String()),
+		sdk.NewAttribute(types.AttributeKeyCommunityPool, communityPool.String()),
+		sdk.NewAttribute(types.AttributeKeyEcosystem, ecosystem.String()),
+	))
+
+	return nil
+}
+
+func shareOf(coin sdk.Coin, share math.LegacyDec) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(coin.Denom, math.LegacyNewDecFromInt(coin.Amount).Mul(share).TruncateInt()))
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/rollchains/flora/x/inflation/types"
+)
+
+// InitGenesis initializes the module state from a genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error {
+	if err := k.Params.Set(ctx, gs.Params); err != nil {
+		return err
+	}
+
+	startHeight := gs.StartHeight
+	if startHeight == 0 {
+		startHeight = uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) //nolint:gosec // G115
+	}
+
+	return k.StartHeight.Set(ctx, startHeight)
+}
+
+// ExportGenesis exports the module state to a genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	startHeight, err := k.StartHeight.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(params, startHeight), nil
+}
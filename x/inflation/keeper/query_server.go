@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/rollchains/flora/x/inflation/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier implements the module gRPC query service.
+type Querier struct {
+	Keeper
+}
+
+// NewQuerier returns an implementation of the module QueryServer interface.
+func NewQuerier(keeper Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Params implements types.QueryServer.
+func (q Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// CurrentEpoch implements types.QueryServer.
+func (q Querier) CurrentEpoch(ctx context.Context, _ *types.QueryCurrentEpochRequest) (*types.QueryCurrentEpochResponse, error) {
+	params, err := q.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) //nolint:gosec // G115
+	epoch, startHeight, _, err := q.Epoch(ctx, params, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCurrentEpochResponse{
+		Epoch:           epoch,
+		StartHeight:     startHeight,
+		EpochProvisions: params.EpochProvisions(epoch),
+		BlockProvision:  params.BlockProvision(epoch),
+	}, nil
+}
+
+// ProjectedSupply implements types.QueryServer.
+func (q Querier) ProjectedSupply(ctx context.Context, req *types.QueryProjectedSupplyRequest) (*types.QueryProjectedSupplyResponse, error) {
+	params, err := q.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	supply, err := q.Keeper.ProjectedSupply(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	epoch, _, _, err := q.Epoch(ctx, params, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryProjectedSupplyResponse{Supply: supply, Epoch: epoch}, nil
+}
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/rollchains/flora/x/inflation/types"
+)
+
+// maxProjectedEpochs bounds the number of epochs walked by a projection.
+const maxProjectedEpochs = 10_000
+
+// Epoch returns the epoch of the given height and its first height. The heights
+// before the start height are part of no epoch.
+func (k Keeper) Epoch(ctx context.Context, params types.Params, height uint64) (epoch, startHeight uint64, ok bool, err error) {
+	start, err := k.StartHeight.Get(ctx)
+	if err != nil {
+		return 0, 0, false, err
+	}
+	if height < start {
+		return 0, 0, false, nil
+	}
+
+	epoch = (height - start) / params.EpochBlocks
+	return epoch, start + epoch*params.EpochBlocks, true, nil
+}
+
+// BlockProvision returns the amount to mint at the given height for the given
+// staking token supply, capped by the max supply.
+func (k Keeper) BlockProvision(ctx context.Context, params types.Params, height uint64, supply math.Int) (math.Int, error) {
+	epoch, _, ok, err := k.Epoch(ctx, params, height)
+	if err != nil || !ok {
+		return math.ZeroInt(), err
+	}
+
+	remaining := params.MaxSupply.Sub(supply)
+	if !remaining.IsPositive() {
+		return math.ZeroInt(), nil
+	}
+
+	return math.MinInt(params.BlockProvision(epoch), remaining), nil
+}
+
+// InflationCalculationFn implements the x/mint InflationCalculationFn. It
+// returns the inflation rate which makes x/mint mint the block provision of the
+// schedule.
+func (k Keeper) InflationCalculationFn(ctx context.Context, _ minttypes.Minter, mintParams minttypes.Params, _ math.LegacyDec) math.LegacyDec {
+	inflation, err := k.inflation(ctx, mintParams)
+	if err != nil {
+		k.Logger(ctx).Error("failed to compute the inflation, nothing is minted", "err", err)
+		return math.LegacyZeroDec()
+	}
+
+	return inflation
+}
+
+func (k Keeper) inflation(ctx context.Context, mintParams minttypes.Params) (math.LegacyDec, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	supply, err := k.mintKeeper.StakingTokenSupply(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if !supply.IsPositive() {
+		return math.LegacyZeroDec(), nil
+	}
+
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) //nolint:gosec // G115
+	provision, err := k.BlockProvision(ctx, params, height, supply)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	// x/mint mints inflation * supply / blocks per year per block. The quotient
+	// is truncated so the max supply is never exceeded.
+	annualProvisions := provision.Mul(math.NewIntFromUint64(mintParams.BlocksPerYear))
+	return math.LegacyNewDecFromInt(annualProvisions).QuoInt(supply), nil
+}
+
+// ProjectedSupply returns the staking token supply at the given height,
+// assuming the current params.
+func (k Keeper) ProjectedSupply(ctx context.Context, height uint64) (math.Int, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	supply, err := k.mintKeeper.StakingTokenSupply(ctx)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	start, err := k.StartHeight.Get(ctx)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	// the provision of the current block is already minted
+	next := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()) + 1 //nolint:gosec // G115
+	next = max(next, start)
+
+	for epochs := 0; next <= height && supply.LT(params.MaxSupply); epochs++ {
+		if epochs == maxProjectedEpochs {
+			return math.Int{}, types.ErrInvalidProjection.Wrapf("height %d is more than %d epochs away", height, maxProjectedEpochs)
+		}
+
+		epoch := (next - start) / params.EpochBlocks
+		provision := params.BlockProvision(epoch)
+		if provision.IsZero() {
+			break
+		}
+
+		end := min(start+(epoch+1)*params.EpochBlocks-1, height)
+		minted := provision.Mul(math.NewIntFromUint64(end - next + 1))
+		supply = math.MinInt(supply.Add(minted), params.MaxSupply)
+		next = end + 1
+	}
+
+	return supply, nil
+}
@@ -0,0 +1,5 @@
+package types
+
+import "cosmossdk.io/errors"
+
+var ErrInvalidProjection = errors.Register(ModuleName, 2, "invalid supply projection")
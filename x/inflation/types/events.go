@@ -0,0 +1,9 @@
+package types
+
+// inflation module event types and attributes
+const (
+	EventTypeDistributeProvisions = "distribute_provisions"
+
+	AttributeKeyCommunityPool = "community_pool"
+	AttributeKeyEcosystem     = "ecosystem"
+)
@@ -0,0 +1,20 @@
+package types
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, startHeight uint64) *GenesisState {
+	return &GenesisState{
+		Params:      params,
+		StartHeight: startHeight,
+	}
+}
+
+// DefaultGenesis returns the default genesis state.
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(DefaultParams(), 0)
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	return gs.Params.Validate()
+}
@@ -0,0 +1,624 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: flora/inflation/v1/inflation.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the emission schedule of the mint denom. The provisions of an
+// epoch are the provisions of the previous epoch multiplied by the reduction
+// factor, and are minted evenly over the blocks of the epoch until the max
+// supply is reached.
+type Params struct {
+	// epoch_blocks is the length of an epoch in blocks.
+	EpochBlocks uint64 `protobuf:"varint,1,opt,name=epoch_blocks,json=epochBlocks,proto3" json:"epoch_blocks,omitempty"`
+	// initial_epoch_provisions is the amount minted over the first epoch.
+	InitialEpochProvisions cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=initial_epoch_provisions,json=initialEpochProvisions,proto3,customtype=cosmossdk.io/math.Int" json:"initial_epoch_provisions"`
+	// reduction_factor is the ratio between the provisions of two consecutive
+	// epochs. 0.5 halves the provisions every epoch.
+	ReductionFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=reduction_factor,json=reductionFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reduction_factor"`
+	// max_supply caps the total supply of the mint denom.
+	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply"`
+	// community_pool_share is the share of the minted coins sent to the
+	// community pool.
+	CommunityPoolShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=community_pool_share,json=communityPoolShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_pool_share"`
+	// ecosystem_share is the share of the minted coins sent to the ecosystem
+	// address.
+	EcosystemShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=ecosystem_share,json=ecosystemShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"ecosystem_share"`
+	// ecosystem_address receives the ecosystem share. It is required when the
+	// ecosystem share is positive.
+	EcosystemAddress string `protobuf:"bytes,7,opt,name=ecosystem_address,json=ecosystemAddress,proto3" json:"ecosystem_address,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_608285df0ff12266, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEpochBlocks() uint64 {
+	if m != nil {
+		return m.EpochBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetEcosystemAddress() string {
+	if m != nil {
+		return m.EcosystemAddress
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "flora.inflation.v1.Params")
+}
+
+func init() {
+	proto.RegisterFile("flora/inflation/v1/inflation.proto", fileDescriptor_608285df0ff12266)
+}
+
+var fileDescriptor_608285df0ff12266 = []byte{
+	// 460 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0xb3, 0x1a, 0x23, 0x1d, 0xc5, 0xb6, 0x4b, 0x94, 0xb1, 0xe2, 0xb6, 0xf6, 0x54, 0x0a,
+	0xd9, 0xb5, 0x08, 0x1e, 0xbc, 0x19, 0x5a, 0xa5, 0x20, 0x18, 0x92, 0x9b, 0x97, 0x65, 0x32, 0x99,
+	0xec, 0x8e, 0x9d, 0x99, 0xb7, 0xcc, 0x4c, 0x42, 0xf6, 0x2b, 0x78, 0xf2, 0x63, 0x78, 0xec, 0xa1,
+	0x1f, 0xa2, 0xc7, 0xd2, 0x93, 0x78, 0x28, 0x92, 0x1c, 0xfa, 0x25, 0x3c, 0x48, 0x66, 0x96, 0x4d,
+	0xa1, 0xb7, 0xf6, 0xb2, 0xec, 0xfc, 0xdf, 0x9b, 0xdf, 0x6f, 0x1e, 0x3c, 0xb4, 0x3b, 0x16, 0xa0,
+	0x49, 0xc2, 0xd5, 0x58, 0x10, 0xcb, 0x41, 0x25, 0xd3, 0x83, 0xd5, 0x21, 0x2e, 0x34, 0x58, 0x08,
+	0x43, 0xd7, 0x13, 0xaf, 0xe2, 0xe9, 0xc1, 0x56, 0x3b, 0x83, 0x0c, 0x5c, 0x39, 0x59, 0xfe, 0xf9,
+	0xce, 0xad, 0x97, 0x14, 0x8c, 0x04, 0x93, 0xfa, 0x82, 0x3f, 0x54, 0xa5, 0x4d, 0x22, 0xb9, 0x82,
+	0xc4, 0x7d, 0x7d, 0xb4, 0xfb, 0xaf, 0x89, 0x5a, 0x3d, 0xa2, 0x89, 0x34, 0xe1, 0x1b, 0xf4, 0x94,
+	0x15, 0x40, 0xf3, 0x74, 0x28, 0x80, 0x9e, 0x18, 0x1c, 0xec, 0x04, 0x7b, 0xcd, 0xfe, 0x13, 0x97,
+	0x75, 0x5d, 0x14, 0x7e, 0x47, 0x98, 0x2b, 0x6e, 0x39, 0x11, 0xa9, 0x6f, 0x2d, 0x34, 0x4c, 0xb9,
+	0xe1, 0xa0, 0x0c, 0x7e, 0xb0, 0x13, 0xec, 0xad, 0x75, 0xdf, 0x9e, 0x5f, 0x6d, 0x37, 0xfe, 0x5c,
+	0x6d, 0x3f, 0xf7, 0x62, 0x33, 0x3a, 0x89, 0x39, 0x24, 0x92, 0xd8, 0x3c, 0x3e, 0x56, 0xf6, 0xf2,
+	0xac, 0x83, 0xaa, 0x17, 0x1d, 0x2b, 0xfb, 0xeb, 0xfa, 0x74, 0x3f, 0xe8, 0xbf, 0xa8, 0x88, 0x47,
+	0x4b, 0x60, 0xaf, 0xe6, 0x85, 0x04, 0x6d, 0x68, 0x36, 0x9a, 0xd0, 0xe5, 0xb4, 0xe9, 0x98, 0x50,
+	0x0b, 0x1a, 0x3f, 0x74, 0x8e, 0xf7, 0x95, 0xe3, 0xd5, 0x6d, 0xc7, 0x17, 0x96, 0x11, 0x5a, 0x1e,
+	0x32, 0x7a, 0xc3, 0x74, 0xc8, 0xa8, 0x37, 0xad, 0xd7, 0xbc, 0x4f, 0x0e, 0x17, 0x7e, 0x45, 0x48,
+	0x92, 0x59, 0x6a, 0x26, 0x45, 0x21, 0x4a, 0xdc, 0xbc, 0xe3, 0x00, 0x6b, 0x92, 0xcc, 0x06, 0x0e,
+	0x11, 0xe6, 0xa8, 0x4d, 0x41, 0xca, 0x89, 0xe2, 0xb6, 0x4c, 0x0b, 0x00, 0x91, 0x9a, 0x9c, 0x68,
+	0x86, 0x1f, 0xdd, 0xeb, 0xdd, 0x61, 0xcd, 0xec, 0x01, 0x88, 0xc1, 0x92, 0x18, 0xa6, 0x68, 0x9d,
+	0x51, 0x30, 0xa5, 0xb1, 0x4c, 0x56, 0x92, 0xd6, 0xbd, 0x24, 0xcf, 0x6a, 0x9c, 0x17, 0x1c, 0xa1,
+	0xcd, 0x95, 0x80, 0x8c, 0x46, 0x9a, 0x19, 0x83, 0x1f, 0x3b, 0x05, 0xbe, 0x3c, 0xeb, 0xb4, 0xab,
+	0xfb, 0x1f, 0x7d, 0x65, 0x60, 0x35, 0x57, 0x59, 0x7f, 0xa3, 0xbe, 0x52, 0xe5, 0x1f, 0x5e, 0xff,
+	0xb8, 0x3e, 0xdd, 0xc7, 0x7e, 0xc1, 0x67, 0x37, 0x56, 0xdc, 0xef, 0x5c, 0xf7, 0xf3, 0xf9, 0x3c,
+	0x0a, 0x2e, 0xe6, 0x51, 0xf0, 0x77, 0x1e, 0x05, 0x3f, 0x17, 0x51, 0xe3, 0x62, 0x11, 0x35, 0x7e,
+	0x2f, 0xa2, 0xc6, 0xb7, 0x4e, 0xc6, 0x6d, 0x3e, 0x19, 0xc6, 0x14, 0x64, 0xa2, 0x41, 0x08, 0x9a,
+	0x13, 0xae, 0x4c, 0x72, 0x9b, 0x64, 0xcb, 0x82, 0x99, 0x61, 0xcb, 0xad, 0xf3, 0xbb, 0xff, 0x01,
+	0x00, 0x00, 0xff, 0xff, 0x66, 0x6f, 0x74, 0xcb, 0x4c, 0x03, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EcosystemAddress) > 0 {
+		i -= len(m.EcosystemAddress)
+		copy(dAtA[i:], m.EcosystemAddress)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.EcosystemAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.EcosystemShare.Size()
+		i -= size
+		if _, err := m.EcosystemShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.CommunityPoolShare.Size()
+		i -= size
+		if _, err := m.CommunityPoolShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ReductionFactor.Size()
+		i -= size
+		if _, err := m.ReductionFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.InitialEpochProvisions.Size()
+		i -= size
+		if _, err := m.InitialEpochProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.EpochBlocks != 0 {
+		i = encodeVarintInflation(dAtA, i, uint64(m.EpochBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintInflation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovInflation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochBlocks != 0 {
+		n += 1 + sovInflation(uint64(m.EpochBlocks))
+	}
+	l = m.InitialEpochProvisions.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.ReductionFactor.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.CommunityPoolShare.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.EcosystemShare.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = len(m.EcosystemAddress)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	return n
+}
+
+func sovInflation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInflation(x uint64) (n int) {
+	return sovInflation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochBlocks", wireType)
+			}
+			m.EpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialEpochProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialEpochProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReductionFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReductionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPoolShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EcosystemShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EcosystemAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipInflation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthInflation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupInflation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthInflation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthInflation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowInflation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupInflation = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultEpochBlocks is the default epoch length, a year of 5 seconds blocks
+	// as in the x/mint defaults.
+	DefaultEpochBlocks uint64 = 60 * 60 * 8766 / 5
+	// DefaultInitialEpochTokens is the default emission of the first epoch,
+	// 100M tokens.
+	DefaultInitialEpochTokens int64 = 100_000_000
+	// DefaultMaxSupplyTokens is the default supply cap, 1B tokens.
+	DefaultMaxSupplyTokens int64 = 1_000_000_000
+)
+
+var (
+	// DefaultReductionFactor halves the provisions every epoch.
+	DefaultReductionFactor = math.LegacyNewDecWithPrec(5, 1)
+	// DefaultCommunityPoolShare sends 10% of the provisions to the community pool.
+	DefaultCommunityPoolShare = math.LegacyNewDecWithPrec(1, 1)
+	// DefaultEcosystemShare doesn't send anything to the ecosystem address.
+	DefaultEcosystemShare = math.LegacyZeroDec()
+)
+
+// DefaultParams returns default module parameters. The amounts are in base
+// units of the power reduction, which the app sets to a token of the chain
+// denom from its decimals.
+func DefaultParams() Params {
+	return Params{
+		EpochBlocks:            DefaultEpochBlocks,
+		InitialEpochProvisions: sdk.DefaultPowerReduction.MulRaw(DefaultInitialEpochTokens),
+		ReductionFactor:        DefaultReductionFactor,
+		MaxSupply:              sdk.DefaultPowerReduction.MulRaw(DefaultMaxSupplyTokens),
+		CommunityPoolShare:     DefaultCommunityPoolShare,
+		EcosystemShare:         DefaultEcosystemShare,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if p.EpochBlocks == 0 {
+		return errors.New("epoch blocks must be positive")
+	}
+	if p.InitialEpochProvisions.IsNil() || p.InitialEpochProvisions.IsNegative() {
+		return fmt.Errorf("initial epoch provisions cannot be negative: %s", p.InitialEpochProvisions)
+	}
+	if p.ReductionFactor.IsNil() || !p.ReductionFactor.IsPositive() || p.ReductionFactor.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("reduction factor must be positive and at most 1: %s", p.ReductionFactor)
+	}
+	if p.MaxSupply.IsNil() || !p.MaxSupply.IsPositive() {
+		return fmt.Errorf("max supply must be positive: %s", p.MaxSupply)
+	}
+	if err := validateShare(p.CommunityPoolShare); err != nil {
+		return fmt.Errorf("invalid community pool share: %w", err)
+	}
+	if err := validateShare(p.EcosystemShare); err != nil {
+		return fmt.Errorf("invalid ecosystem share: %w", err)
+	}
+	if p.CommunityPoolShare.Add(p.EcosystemShare).GT(math.LegacyOneDec()) {
+		return errors.New("community pool and ecosystem shares cannot exceed 1")
+	}
+	if p.EcosystemShare.IsPositive() || p.EcosystemAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(p.EcosystemAddress); err != nil {
+			return fmt.Errorf("invalid ecosystem address: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func validateShare(share math.LegacyDec) error {
+	if share.IsNil() || share.IsNegative() || share.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("share must be between 0 and 1: %s", share)
+	}
+	return nil
+}
+
+// EpochProvisions returns the amount minted over the given epoch.
+func (p Params) EpochProvisions(epoch uint64) math.Int {
+	return p.ReductionFactor.Power(epoch).MulInt(p.InitialEpochProvisions).TruncateInt()
+}
+
+// BlockProvision returns the amount minted per block over the given epoch.
+func (p Params) BlockProvision(epoch uint64) math.Int {
+	return p.EpochProvisions(epoch).QuoRaw(int64(p.EpochBlocks)) //nolint:gosec // G115
+}
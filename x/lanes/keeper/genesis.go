@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/rollchains/flora/x/lanes/types"
+)
+
+// InitGenesis initializes the module state from a genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error {
+	return k.Params.Set(ctx, gs.Params)
+}
+
+// ExportGenesis exports the module state to a genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(params), nil
+}
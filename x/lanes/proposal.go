@@ -0,0 +1,236 @@
+package lanes
+
+import (
+	"errors"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/hashicorp/go-metrics"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+
+	"github.com/rollchains/flora/x/lanes/keeper"
+	"github.com/rollchains/flora/x/lanes/types"
+)
+
+// ProposalHandler builds and checks proposals laid out in lanes. Each lane has
+// its share of the block gas reserved, that no other lane can use, so EVM txs
+// cannot take the space of relayers and governance. The block gas left
+// unreserved is shared by all the lanes, and is the only space of the txs
+// matching no lane.
+type ProposalHandler struct {
+	keeper     keeper.Keeper
+	mempool    mempool.Mempool
+	txVerifier baseapp.ProposalTxVerifier
+}
+
+// NewProposalHandler returns a new ProposalHandler. When the mempool is a
+// no-op one, the txs sent by CometBFT are used as candidates.
+func NewProposalHandler(k keeper.Keeper, mp mempool.Mempool, txVerifier baseapp.ProposalTxVerifier) *ProposalHandler {
+	return &ProposalHandler{
+		keeper:     k,
+		mempool:    mp,
+		txVerifier: txVerifier,
+	}
+}
+
+// PrepareProposalHandler selects the candidate txs lane by lane, skipping
+// the txs that do not fit in their lane or fail verification.
+func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		params, err := h.keeper.GetParams(ctx)
+		if err != nil {
+			return nil, err
+		}
+		space := newBlockSpace(ctx, params)
+
+		candidates := make([][]sdk.Tx, len(types.Lanes))
+		for _, tx := range h.candidates(ctx, req.Txs) {
+			lane := types.LaneOf(tx)
+			candidates[lane] = append(candidates[lane], tx)
+		}
+
+		var (
+			selected [][]byte
+			size     int64
+		)
+		for _, lane := range types.Lanes {
+			for _, tx := range candidates[lane] {
+				gas := txGas(tx)
+				if !space.fits(lane, gas) {
+					continue
+				}
+
+				bz, err := h.txVerifier.TxEncode(tx)
+				if err != nil || size+int64(len(bz)) > req.MaxTxBytes {
+					continue
+				}
+
+				if _, err := h.txVerifier.PrepareProposalVerifyTx(tx); err != nil {
+					// a skipped tx leaves a nonce gap for the next txs of its
+					// sender, which are still valid
+					if errors.Is(err, sdkerrors.ErrInvalidSequence) || errors.Is(err, sdkerrors.ErrWrongSequence) {
+						continue
+					}
+					if err := h.mempool.Remove(tx); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+						return nil, err
+					}
+					continue
+				}
+
+				space.add(lane, gas)
+				size += int64(len(bz))
+				selected = append(selected, bz)
+			}
+		}
+
+		space.emitMetrics("prepare_proposal")
+
+		return &abci.ResponsePrepareProposal{Txs: selected}, nil
+	}
+}
+
+// ProcessProposalHandler rejects proposals with invalid txs, txs out of lane
+// order or lanes using more than their reserved gas and the shared gas left.
+func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		params, err := h.keeper.GetParams(ctx)
+		if err != nil {
+			return nil, err
+		}
+		space := newBlockSpace(ctx, params)
+
+		reject := func(i int, err error) (*abci.ResponseProcessProposal, error) {
+			h.keeper.Logger(ctx).Info("rejecting proposal", "height", req.Height, "tx", i, "err", err)
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+
+		prev := types.LaneIBC
+		for i, bz := range req.Txs {
+			tx, err := h.txVerifier.ProcessProposalVerifyTx(bz)
+			if err != nil {
+				return reject(i, err)
+			}
+
+			lane := types.LaneOf(tx)
+			if lane < prev {
+				return reject(i, types.ErrLaneOrder.Wrapf("%s tx after %s txs", lane, prev))
+			}
+
+			gas := txGas(tx)
+			if !space.fits(lane, gas) {
+				return reject(i, types.ErrLaneFull.Wrapf("%s lane", lane))
+			}
+			space.add(lane, gas)
+			prev = lane
+		}
+
+		space.emitMetrics("process_proposal")
+
+		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+	}
+}
+
+// candidates returns the txs to build the proposal from.
+func (h *ProposalHandler) candidates(ctx sdk.Context, reqTxs [][]byte) []sdk.Tx {
+	var txs []sdk.Tx
+
+	if _, isNoOp := h.mempool.(mempool.NoOpMempool); isNoOp {
+		for _, bz := range reqTxs {
+			tx, err := h.txVerifier.TxDecode(bz)
+			if err != nil {
+				continue
+			}
+			txs = append(txs, tx)
+		}
+		return txs
+	}
+
+	for it := h.mempool.Select(ctx, reqTxs); it != nil; it = it.Next() {
+		txs = append(txs, it.Tx())
+	}
+	return txs
+}
+
+func txGas(tx sdk.Tx) uint64 {
+	if gasTx, ok := tx.(baseapp.GasTx); ok {
+		return gasTx.GetGas()
+	}
+	return 0
+}
+
+// blockSpace tracks the gas used by each lane of a proposal. A lane uses its
+// reserved gas first, then the shared gas left unreserved by all the lanes.
+// Nothing is reserved when the block gas is unlimited.
+type blockSpace struct {
+	maxBlockGas uint64
+	reserved    map[types.Lane]uint64
+	used        map[types.Lane]uint64
+	shared      uint64
+	sharedUsed  uint64
+}
+
+func newBlockSpace(ctx sdk.Context, params types.Params) *blockSpace {
+	space := &blockSpace{
+		reserved: make(map[types.Lane]uint64),
+		used:     make(map[types.Lane]uint64),
+	}
+	if b := ctx.ConsensusParams().Block; b != nil && b.MaxGas > 0 {
+		space.maxBlockGas = uint64(b.MaxGas)
+	}
+
+	// the shares sum up to 1 at most, so the reservations fit in the block
+	blockGas := math.LegacyNewDecFromInt(math.NewIntFromUint64(space.maxBlockGas))
+	space.shared = space.maxBlockGas
+	for _, lane := range types.Lanes {
+		space.reserved[lane] = blockGas.Mul(params.GasShare(lane)).TruncateInt().Uint64()
+		space.shared -= space.reserved[lane]
+	}
+
+	return space
+}
+
+// fits returns true if a tx using gas can be added to the lane, within the
+// reserved gas of the lane left and the shared gas left.
+func (s *blockSpace) fits(lane types.Lane, gas uint64) bool {
+	if s.maxBlockGas == 0 {
+		return true
+	}
+	return gas <= s.reservedLeft(lane)+s.shared-s.sharedUsed
+}
+
+func (s *blockSpace) add(lane types.Lane, gas uint64) {
+	fromReserved := min(gas, s.reservedLeft(lane))
+	s.used[lane] += gas
+	s.sharedUsed += gas - fromReserved
+}
+
+// reservedLeft returns the reserved gas of a lane not used yet.
+func (s *blockSpace) reservedLeft(lane types.Lane) uint64 {
+	if s.used[lane] >= s.reserved[lane] {
+		return 0
+	}
+	return s.reserved[lane] - s.used[lane]
+}
+
+// emitMetrics reports the gas used by each lane and how full its reserved gas
+// is, above 1 once the lane uses shared gas, and the shared gas used.
+func (s *blockSpace) emitMetrics(stage string) {
+	for _, lane := range types.Lanes {
+		labels := []metrics.Label{
+			telemetry.NewLabel("lane", lane.String()),
+			telemetry.NewLabel("stage", stage),
+		}
+		telemetry.SetGaugeWithLabels([]string{types.ModuleName, "gas_used"}, float32(s.used[lane]), labels)
+		if reserved := s.reserved[lane]; reserved > 0 {
+			telemetry.SetGaugeWithLabels([]string{types.ModuleName, "fill_ratio"}, float32(s.used[lane])/float32(reserved), labels)
+		}
+	}
+	telemetry.SetGaugeWithLabels([]string{types.ModuleName, "shared_gas_used"}, float32(s.sharedUsed),
+		[]metrics.Label{telemetry.NewLabel("stage", stage)})
+}
@@ -0,0 +1,8 @@
+package types
+
+import "cosmossdk.io/errors"
+
+var (
+	ErrLaneOrder = errors.Register(ModuleName, 2, "txs are not ordered by lane")
+	ErrLaneFull  = errors.Register(ModuleName, 3, "lane gas limit exceeded")
+)
@@ -0,0 +1,110 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	poatypes "github.com/rollchains/flora/x/poa/types"
+)
+
+// Lane identifies the block space lane of a tx. Proposals lay the lanes out
+// in this order.
+type Lane int
+
+const (
+	LaneIBC Lane = iota
+	LaneSystem
+	LaneEVM
+	LaneDefault
+)
+
+// Lanes lists all the lanes in block order.
+var Lanes = []Lane{LaneIBC, LaneSystem, LaneEVM, LaneDefault}
+
+// String implements the fmt.Stringer interface.
+func (l Lane) String() string {
+	switch l {
+	case LaneIBC:
+		return "ibc"
+	case LaneSystem:
+		return "system"
+	case LaneEVM:
+		return "evm"
+	default:
+		return "default"
+	}
+}
+
+// ibcMsgs are the IBC client and packet messages sent by relayers.
+var ibcMsgs = msgTypeURLs(
+	&clienttypes.MsgCreateClient{},
+	&clienttypes.MsgUpdateClient{},
+	&clienttypes.MsgUpgradeClient{},
+	&clienttypes.MsgSubmitMisbehaviour{},
+	&channeltypes.MsgRecvPacket{},
+	&channeltypes.MsgAcknowledgement{},
+	&channeltypes.MsgTimeout{},
+	&channeltypes.MsgTimeoutOnClose{},
+)
+
+// systemMsgs are the governance and chain administration messages.
+var systemMsgs = msgTypeURLs(
+	&govv1.MsgSubmitProposal{},
+	&govv1.MsgDeposit{},
+	&govv1.MsgVote{},
+	&govv1.MsgVoteWeighted{},
+	&govv1.MsgExecLegacyContent{},
+	&govv1.MsgCancelProposal{},
+	&govv1beta1.MsgSubmitProposal{},
+	&govv1beta1.MsgDeposit{},
+	&govv1beta1.MsgVote{},
+	&govv1beta1.MsgVoteWeighted{},
+	&poatypes.MsgSetPower{},
+	&poatypes.MsgRemoveValidator{},
+	&poatypes.MsgRemovePending{},
+)
+
+func msgTypeURLs(msgs ...sdk.Msg) map[string]bool {
+	urls := make(map[string]bool, len(msgs))
+	for _, msg := range msgs {
+		urls[sdk.MsgTypeURL(msg)] = true
+	}
+	return urls
+}
+
+// LaneOf returns the lane of a tx. A tx belongs to the IBC or system lane
+// only if all of its messages do, txs that mix lanes use the default one.
+func LaneOf(tx sdk.Tx) Lane {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return LaneDefault
+	}
+	if _, ok := msgs[0].(*evmtypes.MsgEthereumTx); ok {
+		return LaneEVM
+	}
+
+	for _, lane := range []struct {
+		lane Lane
+		msgs map[string]bool
+	}{
+		{LaneIBC, ibcMsgs},
+		{LaneSystem, systemMsgs},
+	} {
+		matches := true
+		for _, msg := range msgs {
+			if !lane.msgs[sdk.MsgTypeURL(msg)] {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return lane.lane
+		}
+	}
+
+	return LaneDefault
+}
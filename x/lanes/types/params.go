@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+var (
+	// DefaultIBCGasShare reserves 20% of the block gas for relayers.
+	DefaultIBCGasShare = math.LegacyNewDecWithPrec(2, 1)
+	// DefaultSystemGasShare reserves 10% of the block gas for governance and
+	// chain administration.
+	DefaultSystemGasShare = math.LegacyNewDecWithPrec(1, 1)
+	// DefaultEVMGasShare reserves 50% of the block gas for EVM txs.
+	DefaultEVMGasShare = math.LegacyNewDecWithPrec(5, 1)
+)
+
+// DefaultParams returns default module parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultIBCGasShare, DefaultSystemGasShare, DefaultEVMGasShare)
+}
+
+// NewParams creates a new Params instance.
+func NewParams(ibcGasShare, systemGasShare, evmGasShare math.LegacyDec) Params {
+	return Params{
+		IbcGasShare:    ibcGasShare,
+		SystemGasShare: systemGasShare,
+		EvmGasShare:    evmGasShare,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	shares := map[string]math.LegacyDec{
+		"ibc":    p.IbcGasShare,
+		"system": p.SystemGasShare,
+		"evm":    p.EvmGasShare,
+	}
+	for name, share := range shares {
+		if share.IsNil() || share.IsNegative() || share.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("%s gas share must be between 0 and 1: %s", name, share)
+		}
+	}
+
+	if total := p.IbcGasShare.Add(p.SystemGasShare).Add(p.EvmGasShare); total.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("total gas share cannot exceed 1: %s", total)
+	}
+
+	return nil
+}
+
+// GasShare returns the block gas share reserved for a lane, that the other
+// lanes cannot use. The default lane has no reserved share.
+func (p Params) GasShare(lane Lane) math.LegacyDec {
+	switch lane {
+	case LaneIBC:
+		return p.IbcGasShare
+	case LaneSystem:
+		return p.SystemGasShare
+	case LaneEVM:
+		return p.EvmGasShare
+	default:
+		return math.LegacyZeroDec()
+	}
+}
@@ -0,0 +1,52 @@
+package oracle
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "flora.oracle.v1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the price pairs and the slashing parameters",
+				},
+				{
+					RpcMethod:      "Median",
+					Use:            "median [key]",
+					Short:          "Query the last median reported for a key",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "key"}},
+				},
+				{
+					RpcMethod: "Medians",
+					Use:       "medians",
+					Short:     "Query the last median reported for all keys",
+				},
+				{
+					RpcMethod:      "Feed",
+					Use:            "feed [key]",
+					Short:          "Query the address of the EVM price feed of a pair",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "key"}},
+				},
+				{
+					RpcMethod: "MissCounters",
+					Use:       "miss-counters",
+					Short:     "Query the reports missed by the validators in the current slash window",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "flora.oracle.v1.Msg",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,127 @@
+package oracle
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const (
+	// ProviderNone runs the node without a provider, for the nodes which
+	// don't validate. A validator without a provider misses every report.
+	ProviderNone = "none"
+	// ProviderHTTP reads the values from an HTTP endpoint.
+	ProviderHTTP = "http"
+
+	// DefaultTimeout is the default time the provider has to return the
+	// values, the vote of the validator waits for it.
+	DefaultTimeout = 500 * time.Millisecond
+
+	// FlagProvider is the app.toml key of the provider.
+	FlagProvider = "oracle.provider"
+	// FlagEndpoint is the app.toml key of the provider endpoint.
+	FlagEndpoint = "oracle.endpoint"
+	// FlagTimeout is the app.toml key of the provider timeout.
+	FlagTimeout = "oracle.timeout"
+)
+
+// DefaultConfigTemplate is the [oracle] section of the app.toml template.
+const DefaultConfigTemplate = `
+###############################################################################
+###                             Oracle Configuration                        ###
+###############################################################################
+
+[oracle]
+
+# Provider of the values the validator reports in its vote extensions: "http"
+# reads them from the endpoint below, "none" runs the node without a provider,
+# e.g. a full node. The node refuses to start while it is unset and the chain
+# has oracle pairs.
+provider = "{{ .Oracle.Provider }}"
+
+# Endpoint of the http provider, it must answer a GET request with a JSON
+# object of the values per pair key, e.g. {"ETH/USD": "3200.5"}.
+endpoint = "{{ .Oracle.Endpoint }}"
+
+# Maximum time the provider has to return the values.
+timeout = "{{ .Oracle.Timeout }}"
+`
+
+// Config defines the oracle provider configuration of the node.
+type Config struct {
+	Provider string        `mapstructure:"provider"`
+	Endpoint string        `mapstructure:"endpoint"`
+	Timeout  time.Duration `mapstructure:"timeout"`
+}
+
+// DefaultConfig returns the default oracle configuration, with the provider
+// left unset.
+func DefaultConfig() Config {
+	return Config{
+		Timeout: DefaultTimeout,
+	}
+}
+
+// IsSet returns true if the provider is configured, "none" included.
+func (c Config) IsSet() bool {
+	return c.Provider != ""
+}
+
+// Validate checks the oracle configuration.
+func (c Config) Validate() error {
+	switch c.Provider {
+	case "", ProviderNone:
+	case ProviderHTTP:
+		u, err := url.Parse(c.Endpoint)
+		if err != nil {
+			return fmt.Errorf("invalid oracle endpoint %q: %w", c.Endpoint, err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("invalid oracle endpoint %q: the scheme must be http or https", c.Endpoint)
+		}
+	default:
+		return fmt.Errorf("unknown oracle provider %q", c.Provider)
+	}
+
+	if c.Timeout <= 0 {
+		return fmt.Errorf("oracle timeout must be positive: %s", c.Timeout)
+	}
+	return nil
+}
+
+// NewProvider returns the provider of the configuration, nil if there is
+// none.
+func (c Config) NewProvider() (Provider, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	switch c.Provider {
+	case ProviderHTTP:
+		return NewHTTPProvider(c.Endpoint, c.Timeout), nil
+	default:
+		return nil, nil
+	}
+}
+
+// ConfigFromAppOptions reads the oracle configuration from app.toml, falling
+// back to the defaults for unset values.
+func ConfigFromAppOptions(appOpts servertypes.AppOptions) Config {
+	cfg := DefaultConfig()
+
+	if v := appOpts.Get(FlagProvider); v != nil {
+		cfg.Provider = cast.ToString(v)
+	}
+	if v := appOpts.Get(FlagEndpoint); v != nil {
+		cfg.Endpoint = cast.ToString(v)
+	}
+	if v := appOpts.Get(FlagTimeout); v != nil {
+		cfg.Timeout = cast.ToDuration(v)
+	}
+
+	return cfg
+}
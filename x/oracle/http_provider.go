@@ -0,0 +1,66 @@
+package oracle
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// maxResponseSize caps the size of the responses of the HTTP provider.
+const maxResponseSize = 1 << 20
+
+var _ Provider = (*HTTPProvider)(nil)
+
+// HTTPProvider reads the values from an endpoint answering a GET request with
+// a JSON object of the values per key, e.g. {"ETH/USD": "3200.5"}.
+type HTTPProvider struct {
+	endpoint string
+	client   *http.Client
+}
+
+// NewHTTPProvider returns a new HTTPProvider.
+func NewHTTPProvider(endpoint string, timeout time.Duration) *HTTPProvider {
+	return &HTTPProvider{
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: timeout},
+	}
+}
+
+// Values implements Provider.
+func (p *HTTPProvider) Values(ctx sdk.Context) (map[string]math.LegacyDec, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status from %s: %s", p.endpoint, res.Status)
+	}
+
+	var raw map[string]string
+	if err := json.NewDecoder(io.LimitReader(res.Body, maxResponseSize)).Decode(&raw); err != nil {
+		return nil, fmt.Errorf("failed to decode the values of %s: %w", p.endpoint, err)
+	}
+
+	values := make(map[string]math.LegacyDec, len(raw))
+	for key, value := range raw {
+		dec, err := math.LegacyNewDecFromStr(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of %s: %w", key, err)
+		}
+		values[key] = dec
+	}
+	return values, nil
+}
@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"errors"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/rollchains/flora/x/oracle/types"
+)
+
+// PreBlocker stores the prices of the vote extensions injected as the first
+// tx of the block, and counts the reports missed by the validators. The
+// injected tx was checked in ProcessProposal, a block without one leaves the
+// prices untouched.
+func (k Keeper) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) error {
+	if !types.VoteExtensionsEnabled(ctx) || len(req.Txs) == 0 {
+		return nil
+	}
+
+	var extCommit abci.ExtendedCommitInfo
+	if err := extCommit.Unmarshal(req.Txs[0]); err != nil {
+		k.Logger(ctx).Error("failed to decode injected vote extensions", "height", ctx.BlockHeight(), "err", err)
+		return nil
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	updated := make(map[string]bool, len(params.Pairs))
+	for _, median := range types.ComputeMedians(extCommit, ctx.BlockHeight()) {
+		if _, found := params.GetPair(median.Key); !found {
+			continue
+		}
+		if err := k.setPrice(ctx, median); err != nil {
+			return err
+		}
+		updated[median.Key] = true
+	}
+
+	if err := k.countMisses(ctx, updated, extCommit); err != nil {
+		return err
+	}
+
+	if uint64(ctx.BlockHeight())%params.SlashWindow == 0 {
+		return k.slashMisses(ctx, params, extCommit)
+	}
+
+	return nil
+}
+
+// setPrice stores a new round of a pair.
+func (k Keeper) setPrice(ctx sdk.Context, median types.Median) error {
+	prev, err := k.Medians.Get(ctx, median.Key)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	median.Round = prev.Round + 1
+	median.Timestamp = ctx.BlockTime()
+
+	if err := k.Medians.Set(ctx, median.Key, median); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePriceUpdate,
+		sdk.NewAttribute(types.AttributeKeyKey, median.Key),
+		sdk.NewAttribute(types.AttributeKeyPrice, median.Value.String()),
+		sdk.NewAttribute(types.AttributeKeyRound, strconv.FormatUint(median.Round, 10)),
+	))
+
+	return nil
+}
+
+// countMisses increments the miss counter of the validators of the commit
+// which did not report all the pairs updated by the block. A pair without
+// enough reports to be updated is not missed by anyone: the reports of the
+// whole set failing, e.g. the providers of the nodes being down or not set
+// up yet for a new pair, must not get all validators slashed and jailed.
+func (k Keeper) countMisses(ctx sdk.Context, updated map[string]bool, extCommit abci.ExtendedCommitInfo) error {
+	if len(updated) == 0 {
+		return nil
+	}
+
+	for _, vote := range extCommit.Votes {
+		if reportsAll(updated, vote) {
+			continue
+		}
+
+		consAddr := sdk.ConsAddress(vote.Validator.Address)
+		misses, err := k.MissCounters.Get(ctx, consAddr)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		if err := k.MissCounters.Set(ctx, consAddr, misses+1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func reportsAll(keys map[string]bool, vote abci.ExtendedVoteInfo) bool {
+	if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit {
+		return false
+	}
+
+	ve, err := types.DecodeVoteExtension(vote.VoteExtension)
+	if err != nil {
+		return false
+	}
+
+	reported := 0
+	for _, v := range ve.Values {
+		if keys[v.Key] {
+			reported++
+		}
+	}
+	return reported == len(keys)
+}
+
+// slashMisses slashes and jails the validators of the commit which reported
+// the updated pairs in less than the min valid share of the slash window, then resets
+// the miss counters.
+func (k Keeper) slashMisses(ctx sdk.Context, params types.Params, extCommit abci.ExtendedCommitInfo) error {
+	window := math.LegacyNewDecFromInt(math.NewIntFromUint64(params.SlashWindow))
+	minValid := window.Mul(params.MinValidPerWindow)
+
+	for _, vote := range extCommit.Votes {
+		consAddr := sdk.ConsAddress(vote.Validator.Address)
+		misses, err := k.MissCounters.Get(ctx, consAddr)
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		valid := math.LegacyZeroDec()
+		if misses < params.SlashWindow {
+			valid = math.LegacyNewDecFromInt(math.NewIntFromUint64(params.SlashWindow - misses))
+		}
+		if valid.GTE(minValid) {
+			continue
+		}
+
+		// a failing validator must not halt the chain, its changes are dropped
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.slash(cacheCtx, params, consAddr, vote.Validator.Power); err != nil {
+			k.Logger(ctx).Error("failed to slash validator", "validator", consAddr, "err", err)
+			continue
+		}
+		write()
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeSlash,
+			sdk.NewAttribute(types.AttributeKeyValidator, consAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyMisses, strconv.FormatUint(misses, 10)),
+		))
+	}
+
+	return k.MissCounters.Clear(ctx, nil)
+}
+
+func (k Keeper) slash(ctx sdk.Context, params types.Params, consAddr sdk.ConsAddress, power int64) error {
+	validator, err := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		return err
+	}
+	if validator == nil || validator.IsJailed() {
+		return nil
+	}
+
+	// the stake at the infraction height is the stake of the previous block
+	distributionHeight := ctx.BlockHeight() - sdk.ValidatorUpdateDelay - 1
+	if err := k.slashingKeeper.Slash(ctx, consAddr, params.SlashFraction, power, distributionHeight); err != nil {
+		return err
+	}
+	if err := k.slashingKeeper.Jail(ctx, consAddr); err != nil {
+		return err
+	}
+
+	jailDuration, err := k.slashingKeeper.DowntimeJailDuration(ctx)
+	if err != nil {
+		return err
+	}
+	return k.slashingKeeper.JailUntil(ctx, consAddr, ctx.BlockTime().Add(jailDuration))
+}
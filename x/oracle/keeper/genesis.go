@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/rollchains/flora/x/oracle/types"
+)
+
+// InitGenesis initializes the module state from a genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error {
+	if err := k.Params.Set(ctx, gs.Params); err != nil {
+		return err
+	}
+
+	for _, median := range gs.Medians {
+		if err := k.Medians.Set(ctx, median.Key, median); err != nil {
+			return err
+		}
+	}
+
+	for _, counter := range gs.MissCounters {
+		consAddr, err := sdk.ConsAddressFromBech32(counter.Validator)
+		if err != nil {
+			return err
+		}
+		if err := k.MissCounters.Set(ctx, consAddr, counter.Misses); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis exports the module state to a genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := k.Medians.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	medians, err := iter.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	var missCounters []types.MissCounter
+	err = k.MissCounters.Walk(ctx, nil, func(consAddr sdk.ConsAddress, misses uint64) (bool, error) {
+		missCounters = append(missCounters, types.MissCounter{Validator: consAddr.String(), Misses: misses})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(medians, params, missCounters), nil
+}
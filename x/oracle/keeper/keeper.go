@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/rollchains/flora/x/oracle/types"
+)
+
+// Keeper of the oracle store.
+type Keeper struct {
+	cdc            codec.BinaryCodec
+	slashingKeeper types.SlashingKeeper
+	stakingKeeper  types.StakingKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically,
+	// this should be the x/gov module account.
+	authority string
+
+	Schema       collections.Schema
+	Medians      collections.Map[string, types.Median]
+	Params       collections.Item[types.Params]
+	MissCounters collections.Map[sdk.ConsAddress, uint64]
+}
+
+// NewKeeper creates a new oracle Keeper instance.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService storetypes.KVStoreService,
+	slashingKeeper types.SlashingKeeper,
+	stakingKeeper types.StakingKeeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := Keeper{
+		cdc:            cdc,
+		slashingKeeper: slashingKeeper,
+		stakingKeeper:  stakingKeeper,
+		authority:      authority,
+		Medians:        collections.NewMap(sb, types.MediansKey, "medians", collections.StringKey, codec.CollValue[types.Median](cdc)),
+		Params:         collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		MissCounters:   collections.NewMap(sb, types.MissCountersKey, "miss_counters", sdk.ConsAddressKey, collections.Uint64Value),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return sdk.UnwrapSDKContext(ctx).Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the module params, falling back to the defaults when they
+// have not been set yet.
+func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
+	params, err := k.Params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DefaultParams(), nil
+	}
+	return params, err
+}
+
+// GetMedian returns the last median of a key.
+func (k Keeper) GetMedian(ctx context.Context, key string) (types.Median, error) {
+	median, err := k.Medians.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Median{}, types.ErrMedianNotFound.Wrap(key)
+	}
+	return median, err
+}
+
+// GetPrice returns the last price of a pair, failing when it is older than
+// the max staleness of the pair.
+func (k Keeper) GetPrice(ctx context.Context, key string) (types.Pair, types.Median, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.Pair{}, types.Median{}, err
+	}
+	pair, found := params.GetPair(key)
+	if !found {
+		return types.Pair{}, types.Median{}, types.ErrPairNotFound.Wrap(key)
+	}
+
+	median, err := k.GetMedian(ctx, key)
+	if err != nil {
+		return types.Pair{}, types.Median{}, err
+	}
+
+	if pair.MaxStaleness > 0 {
+		age := sdk.UnwrapSDKContext(ctx).BlockTime().Sub(median.Timestamp)
+		if age > time.Duration(pair.MaxStaleness)*time.Second {
+			return types.Pair{}, types.Median{}, types.ErrStalePrice.Wrapf("%s updated %s ago", key, age)
+		}
+	}
+
+	return pair, median, nil
+}
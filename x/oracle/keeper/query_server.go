@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/rollchains/flora/x/oracle/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier implements the module gRPC query service.
+type Querier struct {
+	Keeper
+}
+
+// NewQuerier returns an implementation of the module QueryServer interface.
+func NewQuerier(keeper Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Median implements types.QueryServer.
+func (q Querier) Median(ctx context.Context, req *types.QueryMedianRequest) (*types.QueryMedianResponse, error) {
+	median, err := q.GetMedian(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryMedianResponse{Median: median}, nil
+}
+
+// Medians implements types.QueryServer.
+func (q Querier) Medians(ctx context.Context, req *types.QueryMediansRequest) (*types.QueryMediansResponse, error) {
+	medians, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.Medians, req.Pagination,
+		func(_ string, median types.Median) (types.Median, error) {
+			return median, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryMediansResponse{Medians: medians, Pagination: pageRes}, nil
+}
+
+// Params implements types.QueryServer.
+func (q Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Feed implements types.QueryServer.
+func (q Querier) Feed(ctx context.Context, req *types.QueryFeedRequest) (*types.QueryFeedResponse, error) {
+	params, err := q.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pair, found := params.GetPair(req.Key)
+	if !found {
+		return nil, types.ErrPairNotFound.Wrap(req.Key)
+	}
+
+	return &types.QueryFeedResponse{Pair: pair, Address: types.FeedAddress(pair.Key).Hex()}, nil
+}
+
+// MissCounters implements types.QueryServer.
+func (q Querier) MissCounters(ctx context.Context, req *types.QueryMissCountersRequest) (*types.QueryMissCountersResponse, error) {
+	counters, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.MissCounters, req.Pagination,
+		func(consAddr sdk.ConsAddress, misses uint64) (types.MissCounter, error) {
+			return types.MissCounter{Validator: consAddr.String(), Misses: misses}, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryMissCountersResponse{MissCounters: counters, Pagination: pageRes}, nil
+}
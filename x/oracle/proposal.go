@@ -0,0 +1,97 @@
+package oracle
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/rollchains/flora/x/oracle/keeper"
+	"github.com/rollchains/flora/x/oracle/types"
+)
+
+// ProposalHandler injects the vote extensions of the previous block as the
+// first tx of the proposals, and wraps the handlers laying out the other txs.
+// The injected tx is the encoded abci.ExtendedCommitInfo, its signatures are
+// checked by every validator in ProcessProposal.
+type ProposalHandler struct {
+	keeper   keeper.Keeper
+	valStore baseapp.ValidatorStore
+	prepare  sdk.PrepareProposalHandler
+	process  sdk.ProcessProposalHandler
+}
+
+// NewProposalHandler returns a new ProposalHandler wrapping the prepare and
+// process handlers.
+func NewProposalHandler(
+	k keeper.Keeper,
+	valStore baseapp.ValidatorStore,
+	prepare sdk.PrepareProposalHandler,
+	process sdk.ProcessProposalHandler,
+) *ProposalHandler {
+	return &ProposalHandler{
+		keeper:   k,
+		valStore: valStore,
+		prepare:  prepare,
+		process:  process,
+	}
+}
+
+// PrepareProposalHandler prepends the injected tx to the proposal.
+func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		if !types.VoteExtensionsEnabled(ctx) {
+			return h.prepare(ctx, req)
+		}
+
+		if err := baseapp.ValidateVoteExtensions(ctx, h.valStore, req.Height, ctx.ChainID(), req.LocalLastCommit); err != nil {
+			return nil, err
+		}
+
+		injected, err := req.LocalLastCommit.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		inner := *req
+		inner.MaxTxBytes -= int64(len(injected))
+		res, err := h.prepare(ctx, &inner)
+		if err != nil {
+			return nil, err
+		}
+
+		res.Txs = append([][]byte{injected}, res.Txs...)
+		return res, nil
+	}
+}
+
+// ProcessProposalHandler checks the injected tx and passes the other txs to
+// the wrapped handler.
+func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		if !types.VoteExtensionsEnabled(ctx) {
+			return h.process(ctx, req)
+		}
+
+		reject := func(err error) (*abci.ResponseProcessProposal, error) {
+			h.keeper.Logger(ctx).Info("rejecting proposal", "height", req.Height, "err", err)
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+
+		if len(req.Txs) == 0 {
+			return reject(types.ErrInvalidInjectedTx.Wrap("missing"))
+		}
+
+		var extCommit abci.ExtendedCommitInfo
+		if err := extCommit.Unmarshal(req.Txs[0]); err != nil {
+			return reject(types.ErrInvalidInjectedTx.Wrap(err.Error()))
+		}
+		if err := baseapp.ValidateVoteExtensions(ctx, h.valStore, req.Height, ctx.ChainID(), extCommit); err != nil {
+			return reject(types.ErrInvalidInjectedTx.Wrap(err.Error()))
+		}
+
+		inner := *req
+		inner.Txs = req.Txs[1:]
+		return h.process(ctx, &inner)
+	}
+}
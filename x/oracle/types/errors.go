@@ -0,0 +1,11 @@
+package types
+
+import "cosmossdk.io/errors"
+
+var (
+	ErrInvalidVoteExtension = errors.Register(ModuleName, 2, "invalid vote extension")
+	ErrInvalidInjectedTx    = errors.Register(ModuleName, 3, "invalid injected vote extensions tx")
+	ErrMedianNotFound       = errors.Register(ModuleName, 4, "median not found")
+	ErrPairNotFound         = errors.Register(ModuleName, 5, "pair not found")
+	ErrStalePrice           = errors.Register(ModuleName, 6, "stale price")
+)
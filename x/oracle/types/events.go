@@ -0,0 +1,13 @@
+package types
+
+// oracle module event types and attributes
+const (
+	EventTypePriceUpdate = "oracle_price_update"
+	EventTypeSlash       = "oracle_slash"
+
+	AttributeKeyKey       = "key"
+	AttributeKeyPrice     = "price"
+	AttributeKeyRound     = "round"
+	AttributeKeyValidator = "validator"
+	AttributeKeyMisses    = "misses"
+)
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// SlashingKeeper defines the expected slashing keeper penalizing the
+// validators missing reports.
+type SlashingKeeper interface {
+	Slash(ctx context.Context, consAddr sdk.ConsAddress, fraction math.LegacyDec, power, distributionHeight int64) error
+	Jail(ctx context.Context, consAddr sdk.ConsAddress) error
+	JailUntil(ctx context.Context, consAddr sdk.ConsAddress, jailTime time.Time) error
+	DowntimeJailDuration(ctx context.Context) (time.Duration, error)
+}
+
+// StakingKeeper defines the expected staking keeper.
+type StakingKeeper interface {
+	ValidatorByConsAddr(ctx context.Context, consAddr sdk.ConsAddress) (stakingtypes.ValidatorI, error)
+}
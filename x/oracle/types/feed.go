@@ -0,0 +1,22 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// FeedAddress returns the address of the AggregatorV3Interface precompile of
+// a pair, derived from its key.
+func FeedAddress(key string) common.Address {
+	return common.BytesToAddress(crypto.Keccak256([]byte(ModuleName + "/feed/" + key))[12:])
+}
+
+// FeedKey returns the key of the pair whose price feed is at the address.
+func (p Params) FeedKey(addr common.Address) (string, bool) {
+	for _, pair := range p.Pairs {
+		if FeedAddress(pair.Key) == addr {
+			return pair.Key, true
+		}
+	}
+	return "", false
+}
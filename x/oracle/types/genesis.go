@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(medians []Median, params Params, missCounters []MissCounter) *GenesisState {
+	return &GenesisState{
+		Medians:      medians,
+		Params:       params,
+		MissCounters: missCounters,
+	}
+}
+
+// DefaultGenesis returns the default genesis state.
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(nil, DefaultParams(), nil)
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool, len(gs.Medians))
+	for _, median := range gs.Medians {
+		if err := ValidateKey(median.Key); err != nil {
+			return err
+		}
+		if seen[median.Key] {
+			return fmt.Errorf("duplicate median for key %s", median.Key)
+		}
+		seen[median.Key] = true
+
+		if median.Value.IsNil() || !median.Value.IsPositive() {
+			return fmt.Errorf("median of %s must be positive: %s", median.Key, median.Value)
+		}
+	}
+
+	validators := make(map[string]bool, len(gs.MissCounters))
+	for _, counter := range gs.MissCounters {
+		if _, err := sdk.ConsAddressFromBech32(counter.Validator); err != nil {
+			return fmt.Errorf("invalid miss counter validator %s: %w", counter.Validator, err)
+		}
+		if validators[counter.Validator] {
+			return fmt.Errorf("duplicate miss counter for validator %s", counter.Validator)
+		}
+		validators[counter.Validator] = true
+	}
+
+	return gs.Params.Validate()
+}
@@ -0,0 +1,21 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "oracle"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	// MediansKey saves the last median of every key.
+	MediansKey = collections.NewPrefix(0)
+	// ParamsKey saves the current module params.
+	ParamsKey = collections.NewPrefix(1)
+	// MissCountersKey saves the reports missed by every validator in the
+	// current slash window.
+	MissCountersKey = collections.NewPrefix(2)
+)
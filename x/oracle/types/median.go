@@ -0,0 +1,82 @@
+package types
+
+import (
+	"sort"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VoteExtensionsEnabled returns true if the proposal of the current block
+// carries the vote extensions of the previous one.
+func VoteExtensionsEnabled(ctx sdk.Context) bool {
+	cp := ctx.ConsensusParams()
+	return cp.Abci != nil && cp.Abci.VoteExtensionsEnableHeight > 0 &&
+		ctx.BlockHeight() > cp.Abci.VoteExtensionsEnableHeight
+}
+
+type weightedValue struct {
+	value math.LegacyDec
+	power int64
+}
+
+// ComputeMedians returns the stake weighted median of every key reported by
+// validators holding more than 2/3 of the voting power of the commit, sorted
+// by key. Invalid vote extensions are ignored.
+func ComputeMedians(extCommit abci.ExtendedCommitInfo, height int64) []Median {
+	var totalPower int64
+	reports := make(map[string][]weightedValue)
+	for _, vote := range extCommit.Votes {
+		totalPower += vote.Validator.Power
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit || len(vote.VoteExtension) == 0 {
+			continue
+		}
+
+		ve, err := DecodeVoteExtension(vote.VoteExtension)
+		if err != nil {
+			continue
+		}
+		for _, v := range ve.Values {
+			reports[v.Key] = append(reports[v.Key], weightedValue{value: v.Value, power: vote.Validator.Power})
+		}
+	}
+
+	medians := make([]Median, 0, len(reports))
+	for key, values := range reports {
+		var power int64
+		for _, v := range values {
+			power += v.power
+		}
+		if power*3 <= totalPower*2 {
+			continue
+		}
+
+		medians = append(medians, Median{Key: key, Value: weightedMedian(values, power), Height: height})
+	}
+	sort.Slice(medians, func(i, j int) bool {
+		return medians[i].Key < medians[j].Key
+	})
+
+	return medians
+}
+
+// weightedMedian returns the lowest value at which the cumulated power
+// reaches half of the total power.
+func weightedMedian(values []weightedValue, totalPower int64) math.LegacyDec {
+	sort.SliceStable(values, func(i, j int) bool {
+		return values[i].value.LT(values[j].value)
+	})
+
+	var cumulated int64
+	for _, v := range values {
+		cumulated += v.power
+		if cumulated*2 >= totalPower {
+			return v.value
+		}
+	}
+	return values[len(values)-1].value
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+const (
+	// DefaultSlashWindow counts the missed reports over about a day of 6s
+	// blocks.
+	DefaultSlashWindow uint64 = 14_400
+	// MaxDecimals caps the decimals of a pair to keep the answers of the
+	// price feeds below 2^255.
+	MaxDecimals = 18
+)
+
+var (
+	// DefaultMinValidPerWindow requires the validators to report all pairs
+	// in half of the blocks.
+	DefaultMinValidPerWindow = math.LegacyNewDecWithPrec(5, 1)
+	// DefaultSlashFraction slashes 0.01% of the stake.
+	DefaultSlashFraction = math.LegacyNewDecWithPrec(1, 4)
+)
+
+// DefaultParams returns default module parameters.
+func DefaultParams() Params {
+	return NewParams(nil, DefaultSlashWindow, DefaultMinValidPerWindow, DefaultSlashFraction)
+}
+
+// NewParams creates a new Params instance.
+func NewParams(pairs []Pair, slashWindow uint64, minValidPerWindow, slashFraction math.LegacyDec) Params {
+	return Params{
+		Pairs:             pairs,
+		SlashWindow:       slashWindow,
+		MinValidPerWindow: minValidPerWindow,
+		SlashFraction:     slashFraction,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	seen := make(map[string]bool, len(p.Pairs))
+	for _, pair := range p.Pairs {
+		if err := pair.Validate(); err != nil {
+			return err
+		}
+		if seen[pair.Key] {
+			return fmt.Errorf("duplicate pair %s", pair.Key)
+		}
+		seen[pair.Key] = true
+	}
+	if len(p.Pairs) > MaxReportedValues {
+		return fmt.Errorf("%d pairs, at most %d allowed", len(p.Pairs), MaxReportedValues)
+	}
+
+	if p.SlashWindow == 0 {
+		return fmt.Errorf("slash window must be positive")
+	}
+
+	if p.MinValidPerWindow.IsNil() || p.MinValidPerWindow.IsNegative() || p.MinValidPerWindow.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("min valid per window must be between 0 and 1: %s", p.MinValidPerWindow)
+	}
+
+	if p.SlashFraction.IsNil() || p.SlashFraction.IsNegative() || p.SlashFraction.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("slash fraction must be between 0 and 1: %s", p.SlashFraction)
+	}
+
+	return nil
+}
+
+// GetPair returns the pair of a key.
+func (p Params) GetPair(key string) (Pair, bool) {
+	for _, pair := range p.Pairs {
+		if pair.Key == key {
+			return pair, true
+		}
+	}
+	return Pair{}, false
+}
+
+// Validate checks the key and decimals of the pair.
+func (p Pair) Validate() error {
+	if err := ValidateKey(p.Key); err != nil {
+		return err
+	}
+	if p.Decimals > MaxDecimals {
+		return fmt.Errorf("decimals of %s cannot exceed %d: %d", p.Key, MaxDecimals, p.Decimals)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/math"
+)
+
+const (
+	// MaxKeyLength caps the length of a reported key.
+	MaxKeyLength = 64
+	// MaxReportedValues caps the number of values of a vote extension.
+	MaxReportedValues = 256
+)
+
+// ValidateKey checks that a key is made of 1 to MaxKeyLength printable ASCII
+// characters, without spaces.
+func ValidateKey(key string) error {
+	if len(key) == 0 || len(key) > MaxKeyLength {
+		return fmt.Errorf("key length must be between 1 and %d: %q", MaxKeyLength, key)
+	}
+	for _, c := range key {
+		if c <= ' ' || c > '~' {
+			return fmt.Errorf("key must be printable ASCII without spaces: %q", key)
+		}
+	}
+	return nil
+}
+
+// NewVoteExtension returns a vote extension reporting the values, sorted by
+// key.
+func NewVoteExtension(values map[string]math.LegacyDec) VoteExtension {
+	ve := VoteExtension{Values: make([]ReportedValue, 0, len(values))}
+	for key, value := range values {
+		ve.Values = append(ve.Values, ReportedValue{Key: key, Value: value})
+	}
+	sort.Slice(ve.Values, func(i, j int) bool {
+		return ve.Values[i].Key < ve.Values[j].Key
+	})
+	return ve
+}
+
+// Validate checks that the values are positive and sorted by unique keys.
+func (ve VoteExtension) Validate() error {
+	if len(ve.Values) > MaxReportedValues {
+		return ErrInvalidVoteExtension.Wrapf("%d values, at most %d allowed", len(ve.Values), MaxReportedValues)
+	}
+
+	for i, v := range ve.Values {
+		if err := ValidateKey(v.Key); err != nil {
+			return ErrInvalidVoteExtension.Wrap(err.Error())
+		}
+		if i > 0 && ve.Values[i-1].Key >= v.Key {
+			return ErrInvalidVoteExtension.Wrapf("keys must be sorted and unique: %s", v.Key)
+		}
+		if v.Value.IsNil() || !v.Value.IsPositive() {
+			return ErrInvalidVoteExtension.Wrapf("value of %s must be positive: %s", v.Key, v.Value)
+		}
+	}
+
+	return nil
+}
+
+// DecodeVoteExtension decodes and validates a vote extension.
+func DecodeVoteExtension(bz []byte) (VoteExtension, error) {
+	var ve VoteExtension
+	if err := ve.Unmarshal(bz); err != nil {
+		return VoteExtension{}, ErrInvalidVoteExtension.Wrap(err.Error())
+	}
+	return ve, ve.Validate()
+}
@@ -0,0 +1,106 @@
+package oracle
+
+import (
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/rollchains/flora/x/oracle/types"
+)
+
+// Provider supplies the local values a validator reports in its vote
+// extension, e.g. prices read from exchanges.
+type Provider interface {
+	Values(ctx sdk.Context) (map[string]math.LegacyDec, error)
+}
+
+var _ Provider = MockProvider{}
+
+// MockProvider reports a fixed set of values, used in tests.
+type MockProvider map[string]math.LegacyDec
+
+// Values implements Provider.
+func (p MockProvider) Values(sdk.Context) (map[string]math.LegacyDec, error) {
+	return p, nil
+}
+
+// VoteExtensionHandler attaches the values of the local provider to the
+// precommits of the validator and checks the vote extensions of the others.
+// Without a provider the vote extensions are empty.
+type VoteExtensionHandler struct {
+	logger log.Logger
+
+	mtx      sync.RWMutex
+	provider Provider
+}
+
+// NewVoteExtensionHandler returns a new VoteExtensionHandler.
+func NewVoteExtensionHandler(logger log.Logger, provider Provider) *VoteExtensionHandler {
+	return &VoteExtensionHandler{
+		logger:   logger.With("module", "x/"+types.ModuleName),
+		provider: provider,
+	}
+}
+
+// SetProvider replaces the local provider.
+func (h *VoteExtensionHandler) SetProvider(provider Provider) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	h.provider = provider
+}
+
+// ExtendVoteHandler reports the provider values. A provider failure must not
+// prevent the validator from voting, so it results in an empty extension.
+func (h *VoteExtensionHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, req *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+		h.mtx.RLock()
+		provider := h.provider
+		h.mtx.RUnlock()
+
+		if provider == nil {
+			return &abci.ResponseExtendVote{}, nil
+		}
+
+		values, err := provider.Values(ctx)
+		if err != nil {
+			h.logger.Error("failed to read provider values", "height", req.Height, "err", err)
+			return &abci.ResponseExtendVote{}, nil
+		}
+
+		ve := types.NewVoteExtension(values)
+		if err := ve.Validate(); err != nil {
+			h.logger.Error("invalid provider values", "height", req.Height, "err", err)
+			return &abci.ResponseExtendVote{}, nil
+		}
+
+		bz, err := ve.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		return &abci.ResponseExtendVote{VoteExtension: bz}, nil
+	}
+}
+
+// VerifyVoteExtensionHandler rejects malformed vote extensions. Empty vote
+// extensions are accepted.
+func (h *VoteExtensionHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
+	return func(_ sdk.Context, req *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error) {
+		if len(req.VoteExtension) == 0 {
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+		}
+
+		if _, err := types.DecodeVoteExtension(req.VoteExtension); err != nil {
+			h.logger.Info("rejecting vote extension", "height", req.Height, "validator", sdk.ConsAddress(req.ValidatorAddress), "err", err)
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+
+		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+	}
+}
@@ -0,0 +1,69 @@
+package poa
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "flora.poa.v1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current poa parameters",
+				},
+				{
+					RpcMethod: "PendingValidators",
+					Use:       "pending-validators",
+					Short:     "Query the validator applications awaiting admin approval",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "flora.poa.v1.Msg",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "SetPower",
+					Use:       "set-power [validator-address] [power]",
+					Short:     "Approve a pending validator or change the power of an active one",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+						{ProtoField: "power"},
+					},
+				},
+				{
+					RpcMethod: "RemoveValidator",
+					Use:       "remove-validator [validator-address]",
+					Short:     "Remove a validator from the active set",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+					},
+				},
+				{
+					RpcMethod: "RemovePending",
+					Use:       "remove-pending [validator-address]",
+					Short:     "Reject a pending validator application",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+					},
+				},
+				{
+					RpcMethod: "CreateValidator",
+					Use:       "create-validator [pubkey] --description [json] --commission [json]",
+					Short:     "Apply to join the validator set, pending admin approval",
+					Example:   `create-validator '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"..."}' --description '{"moniker":"val"}' --commission '{"rate":"0.1","max_rate":"0.2","max_change_rate":"0.01"}'`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "pubkey"},
+					},
+				},
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/rollchains/flora/x/poa/types"
+)
+
+// GetGenesisCmd returns the genesis commands of the poa module.
+func GetGenesisCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Proof-of-authority genesis subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		SetAdminsCmd(),
+		MigrateFromPoSCmd(),
+	)
+
+	return cmd
+}
+
+// SetAdminsCmd sets the poa admins in genesis.json. Setting at least one admin
+// enables proof-of-authority mode from the first block.
+func SetAdminsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-admins [address] [[address]...]",
+		Short: "Set the admins managing the validator set in genesis.json",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return updateGenesis(cmd, func(cdc codec.JSONCodec, appState map[string]json.RawMessage) error {
+				return setAdmins(cdc, appState, args)
+			})
+		},
+	}
+}
+
+// MigrateFromPoSCmd turns an exported proof-of-stake genesis into a
+// proof-of-authority one. The existing validators and delegations are kept, the
+// admins take over validator set changes and the staking precompile is disabled
+// so delegations can't bypass them through the EVM.
+func MigrateFromPoSCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "migrate-from-pos [admin] [[admin]...]",
+		Short: "Migrate a proof-of-stake genesis.json to proof-of-authority",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return updateGenesis(cmd, func(cdc codec.JSONCodec, appState map[string]json.RawMessage) error {
+				if err := setAdmins(cdc, appState, args); err != nil {
+					return err
+				}
+
+				var evmGenState evmtypes.GenesisState
+				if err := cdc.UnmarshalJSON(appState[evmtypes.ModuleName], &evmGenState); err != nil {
+					return fmt.Errorf("failed to unmarshal %s genesis state: %w", evmtypes.ModuleName, err)
+				}
+
+				evmGenState.Params.ActiveStaticPrecompiles = slices.DeleteFunc(
+					evmGenState.Params.ActiveStaticPrecompiles,
+					func(addr string) bool { return addr == evmtypes.StakingPrecompileAddress },
+				)
+
+				bz, err := cdc.MarshalJSON(&evmGenState)
+				if err != nil {
+					return fmt.Errorf("failed to marshal %s genesis state: %w", evmtypes.ModuleName, err)
+				}
+				appState[evmtypes.ModuleName] = bz
+
+				return nil
+			})
+		},
+	}
+}
+
+func setAdmins(cdc codec.JSONCodec, appState map[string]json.RawMessage, admins []string) error {
+	genState := types.DefaultGenesis()
+	if bz, ok := appState[types.ModuleName]; ok {
+		if err := cdc.UnmarshalJSON(bz, genState); err != nil {
+			return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+		}
+	}
+
+	genState.Params.Admins = admins
+	if err := genState.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.MarshalJSON(genState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s genesis state: %w", types.ModuleName, err)
+	}
+	appState[types.ModuleName] = bz
+
+	return nil
+}
+
+// updateGenesis applies fn to the app state of the genesis file in the home
+// directory of the command and writes the result back.
+func updateGenesis(cmd *cobra.Command, fn func(cdc codec.JSONCodec, appState map[string]json.RawMessage) error) error {
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	config := server.GetServerContextFromCmd(cmd).Config
+	config.SetRoot(clientCtx.HomeDir)
+
+	genFile := config.GenesisFile()
+	appState, appGenesis, err := genutiltypes.GenesisStateFromGenFile(genFile)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+	}
+
+	if err := fn(clientCtx.Codec, appState); err != nil {
+		return err
+	}
+
+	appGenesis.AppState, err = json.Marshal(appState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal application genesis state: %w", err)
+	}
+
+	return genutil.ExportGenesisFile(appGenesis, genFile)
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/rollchains/flora/x/poa/types"
+)
+
+// InitGenesis initializes the module state from a genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, gs *types.GenesisState) error {
+	if err := k.Params.Set(ctx, gs.Params); err != nil {
+		return err
+	}
+
+	for _, pv := range gs.PendingValidators {
+		if err := k.PendingValidators.Set(ctx, pv.OperatorAddress, pv); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis exports the module state to a genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := k.GetPendingValidators(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(params, pending), nil
+}
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/rollchains/flora/x/poa/types"
+)
+
+// Keeper of the poa store.
+type Keeper struct {
+	cdc codec.BinaryCodec
+
+	accountKeeper    types.AccountKeeper
+	bankKeeper       types.BankKeeper
+	stakingKeeper    types.StakingKeeper
+	stakingMsgServer stakingtypes.MsgServer
+
+	// the address capable of executing a MsgUpdateParams message. Typically,
+	// this should be the x/gov module account.
+	authority string
+
+	Schema            collections.Schema
+	Params            collections.Item[types.Params]
+	PendingValidators collections.Map[string, types.PendingValidator]
+}
+
+// NewKeeper creates a new poa Keeper instance.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService storetypes.KVStoreService,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	stakingKeeper types.StakingKeeper,
+	stakingMsgServer stakingtypes.MsgServer,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := Keeper{
+		cdc:               cdc,
+		accountKeeper:     accountKeeper,
+		bankKeeper:        bankKeeper,
+		stakingKeeper:     stakingKeeper,
+		stakingMsgServer:  stakingMsgServer,
+		authority:         authority,
+		Params:            collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		PendingValidators: collections.NewMap(sb, types.PendingValidatorsKey, "pending_validators", collections.StringKey, codec.CollValue[types.PendingValidator](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return sdk.UnwrapSDKContext(ctx).Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the module params, falling back to the defaults when they
+// have not been set yet.
+func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
+	params, err := k.Params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DefaultParams(), nil
+	}
+	return params, err
+}
+
+// IsEnabled returns true when the chain runs in proof-of-authority mode.
+func (k Keeper) IsEnabled(ctx context.Context) bool {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return false
+	}
+	return params.Enabled()
+}
+
+// GetPendingValidators returns all the validator applications awaiting approval.
+func (k Keeper) GetPendingValidators(ctx context.Context) ([]types.PendingValidator, error) {
+	var pending []types.PendingValidator
+	err := k.PendingValidators.Walk(ctx, nil, func(_ string, pv types.PendingValidator) (bool, error) {
+		pending = append(pending, pv)
+		return false, nil
+	})
+	return pending, err
+}
@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/rollchains/flora/x/poa/types"
+)
+
+type msgServer struct {
+	k Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the module MsgServer interface.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{k: keeper}
+}
+
+// CreateValidator implements types.MsgServer.
+func (ms msgServer) CreateValidator(ctx context.Context, msg *types.MsgCreateValidator) (*types.MsgCreateValidatorResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if !ms.k.IsEnabled(ctx) {
+		return nil, types.ErrPOADisabled
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := ms.k.stakingKeeper.GetValidator(ctx, valAddr); err == nil {
+		return nil, errorsmod.Wrap(types.ErrValidatorExists, msg.ValidatorAddress)
+	} else if !errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+		return nil, err
+	}
+
+	has, err := ms.k.PendingValidators.Has(ctx, msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if has {
+		return nil, errorsmod.Wrap(types.ErrPendingValidatorExists, msg.ValidatorAddress)
+	}
+
+	if err := ms.k.PendingValidators.Set(ctx, msg.ValidatorAddress, types.NewPendingValidator(msg)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateValidatorResponse{}, nil
+}
+
+// SetPower implements types.MsgServer.
+func (ms msgServer) SetPower(ctx context.Context, msg *types.MsgSetPower) (*types.MsgSetPowerResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.requireAdmin(ctx, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.SetValidatorPower(ctx, valAddr, msg.Power); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetPowerResponse{}, nil
+}
+
+// RemoveValidator implements types.MsgServer.
+func (ms msgServer) RemoveValidator(ctx context.Context, msg *types.MsgRemoveValidator) (*types.MsgRemoveValidatorResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.requireAdmin(ctx, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.RemoveValidator(ctx, valAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveValidatorResponse{}, nil
+}
+
+// RemovePending implements types.MsgServer.
+func (ms msgServer) RemovePending(ctx context.Context, msg *types.MsgRemovePending) (*types.MsgRemovePendingResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.requireAdmin(ctx, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	has, err := ms.k.PendingValidators.Has(ctx, msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, errorsmod.Wrap(types.ErrPendingValidatorMissing, msg.ValidatorAddress)
+	}
+
+	if err := ms.k.PendingValidators.Remove(ctx, msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemovePendingResponse{}, nil
+}
+
+// UpdateParams implements types.MsgServer.
+func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.k.authority, msg.Authority)
+	}
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// requireAdmin returns an error unless the chain is in proof-of-authority mode
+// and the sender is one of its admins.
+func (ms msgServer) requireAdmin(ctx context.Context, sender string) error {
+	params, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if !params.Enabled() {
+		return types.ErrPOADisabled
+	}
+	if !params.IsAdmin(sender) {
+		return errorsmod.Wrap(types.ErrNotAnAdmin, sender)
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/rollchains/flora/x/poa/types"
+)
+
+// minSelfDelegation is the self delegation validators created by the module
+// are required to keep. Unbonding the whole self delegation jails them.
+var minSelfDelegation = math.OneInt()
+
+// SetValidatorPower sets the consensus power of the validator. A pending
+// validator is created in x/staking with the given power. The tokens backing
+// the power are minted to, or burned from, the self delegation of the operator.
+func (k Keeper) SetValidatorPower(ctx context.Context, valAddr sdk.ValAddress, power uint64) error {
+	pending, err := k.PendingValidators.Get(ctx, valAddr.String())
+	switch {
+	case err == nil:
+		return k.createValidator(ctx, pending, power)
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	target := k.stakingKeeper.PowerReduction(ctx).Mul(math.NewIntFromUint64(power))
+	if err := k.setValidatorTokens(ctx, validator, target); err != nil {
+		return err
+	}
+
+	if validator.IsJailed() {
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			return err
+		}
+		return k.stakingKeeper.Unjail(ctx, consAddr)
+	}
+
+	return nil
+}
+
+// RemoveValidator jails the validator, so it leaves the active set at the end
+// of the block, and unbonds the whole self delegation of its operator. x/staking
+// deletes the validator once no delegation is left and it is fully unbonded.
+func (k Keeper) RemoveValidator(ctx context.Context, valAddr sdk.ValAddress) error {
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if !validator.IsJailed() {
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			return err
+		}
+		if err := k.stakingKeeper.Jail(ctx, consAddr); err != nil {
+			return err
+		}
+
+		if validator, err = k.stakingKeeper.GetValidator(ctx, valAddr); err != nil {
+			return err
+		}
+	}
+
+	delegation, err := k.stakingKeeper.GetDelegation(ctx, sdk.AccAddress(valAddr), valAddr)
+	if errors.Is(err, stakingtypes.ErrNoDelegation) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	return k.unbond(ctx, validator, delegation.Shares)
+}
+
+// createValidator creates a pending validator in x/staking with a self
+// delegation minted for the requested power.
+func (k Keeper) createValidator(ctx context.Context, pending types.PendingValidator, power uint64) error {
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(pending.OperatorAddress)
+	if err != nil {
+		return err
+	}
+
+	tokens := k.stakingKeeper.PowerReduction(ctx).Mul(math.NewIntFromUint64(power))
+	coin := sdk.NewCoin(bondDenom, tokens)
+	if err := k.mintTo(ctx, sdk.AccAddress(valAddr), coin); err != nil {
+		return err
+	}
+
+	msg := &stakingtypes.MsgCreateValidator{
+		Description:       pending.Description,
+		Commission:        pending.Commission,
+		MinSelfDelegation: minSelfDelegation,
+		ValidatorAddress:  pending.OperatorAddress,
+		Pubkey:            pending.ConsensusPubkey,
+		Value:             coin,
+	}
+	if _, err := k.stakingMsgServer.CreateValidator(ctx, msg); err != nil {
+		return err
+	}
+
+	return k.PendingValidators.Remove(ctx, pending.OperatorAddress)
+}
+
+// setValidatorTokens moves the tokens of the validator to target by changing the
+// self delegation of the operator.
+func (k Keeper) setValidatorTokens(ctx context.Context, validator stakingtypes.Validator, target math.Int) error {
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+	if err != nil {
+		return err
+	}
+	operator := sdk.AccAddress(valAddr)
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	current := validator.GetTokens()
+	switch {
+	case target.GT(current):
+		coin := sdk.NewCoin(bondDenom, target.Sub(current))
+		if err := k.mintTo(ctx, operator, coin); err != nil {
+			return err
+		}
+		_, err := k.stakingKeeper.Delegate(ctx, operator, coin.Amount, stakingtypes.Unbonded, validator, true)
+		return err
+
+	case target.LT(current):
+		delegation, err := k.stakingKeeper.GetDelegation(ctx, operator, valAddr)
+		if err != nil && !errors.Is(err, stakingtypes.ErrNoDelegation) {
+			return err
+		}
+
+		shares, err := validator.SharesFromTokens(current.Sub(target))
+		if err != nil {
+			return err
+		}
+		if delegation.Shares.IsNil() || shares.GT(delegation.Shares) {
+			return errorsmod.Wrapf(types.ErrPowerBelowDelegations, "validator %s", validator.GetOperator())
+		}
+
+		return k.unbond(ctx, validator, shares)
+	}
+
+	return nil
+}
+
+// unbond removes shares from the self delegation of the operator and burns the
+// unbonded tokens right away instead of going through an unbonding period.
+func (k Keeper) unbond(ctx context.Context, validator stakingtypes.Validator, shares math.LegacyDec) error {
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	wasBonded := validator.IsBonded()
+	amount, err := k.stakingKeeper.Unbond(ctx, sdk.AccAddress(valAddr), valAddr, shares)
+	if err != nil {
+		return err
+	}
+
+	return k.burnFromPool(ctx, wasBonded, sdk.NewCoins(sdk.NewCoin(bondDenom, amount)))
+}
+
+func (k Keeper) mintTo(ctx context.Context, addr sdk.AccAddress, coin sdk.Coin) error {
+	coins := sdk.NewCoins(coin)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins)
+}
+
+// burnFromPool burns unbonded tokens. x/staking leaves them in the pool matching
+// the status the validator had, so bonded tokens are moved out first.
+func (k Keeper) burnFromPool(ctx context.Context, bonded bool, coins sdk.Coins) error {
+	if coins.IsZero() {
+		return nil
+	}
+	if bonded {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName, coins); err != nil {
+			return err
+		}
+	}
+	return k.bankKeeper.BurnCoins(ctx, stakingtypes.NotBondedPoolName, coins)
+}
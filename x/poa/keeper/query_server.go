@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/rollchains/flora/x/poa/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier implements the module gRPC query service.
+type Querier struct {
+	Keeper
+}
+
+// NewQuerier returns an implementation of the module QueryServer interface.
+func NewQuerier(keeper Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Params implements types.QueryServer.
+func (q Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// PendingValidators implements types.QueryServer.
+func (q Querier) PendingValidators(ctx context.Context, _ *types.QueryPendingValidatorsRequest) (*types.QueryPendingValidatorsResponse, error) {
+	pending, err := q.GetPendingValidators(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPendingValidatorsResponse{Pending: pending}, nil
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the necessary interfaces and concrete types
+// on the provided LegacyAmino codec.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgCreateValidator{}, "flora/x/poa/MsgCreateValidator")
+	legacy.RegisterAminoMsg(cdc, &MsgSetPower{}, "flora/x/poa/MsgSetPower")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveValidator{}, "flora/x/poa/MsgRemoveValidator")
+	legacy.RegisterAminoMsg(cdc, &MsgRemovePending{}, "flora/x/poa/MsgRemovePending")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "flora/x/poa/MsgUpdateParams")
+	cdc.RegisterConcrete(&Params{}, "flora/x/poa/Params", nil)
+}
+
+// RegisterInterfaces registers the module interface types.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgCreateValidator{},
+		&MsgSetPower{},
+		&MsgRemoveValidator{},
+		&MsgRemovePending{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,12 @@
+package types
+
+import "cosmossdk.io/errors"
+
+var (
+	ErrNotAnAdmin              = errors.Register(ModuleName, 2, "sender is not a poa admin")
+	ErrPOADisabled             = errors.Register(ModuleName, 3, "proof-of-authority mode is disabled")
+	ErrPendingValidatorExists  = errors.Register(ModuleName, 4, "validator application already pending")
+	ErrPendingValidatorMissing = errors.Register(ModuleName, 5, "validator application not found")
+	ErrValidatorExists         = errors.Register(ModuleName, 6, "validator already exists")
+	ErrPowerBelowDelegations   = errors.Register(ModuleName, 7, "power is below the tokens delegated by other accounts")
+)
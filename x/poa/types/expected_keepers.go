@@ -0,0 +1,39 @@
+package types
+
+import (
+	"context"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// AccountKeeper defines the expected account keeper.
+type AccountKeeper interface {
+	AddressCodec() address.Codec
+}
+
+// BankKeeper defines the expected bank keeper used to mint and burn the tokens
+// backing validator power.
+type BankKeeper interface {
+	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+}
+
+// StakingKeeper defines the expected staking keeper. Validator set changes are
+// applied through x/staking so its hooks (distribution, slashing) keep firing.
+type StakingKeeper interface {
+	BondDenom(ctx context.Context) (string, error)
+	PowerReduction(ctx context.Context) math.Int
+	GetValidator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error)
+	GetDelegation(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.Delegation, error)
+	Delegate(ctx context.Context, delAddr sdk.AccAddress, bondAmt math.Int, tokenSrc stakingtypes.BondStatus, validator stakingtypes.Validator, subtractAccount bool) (math.LegacyDec, error)
+	Unbond(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec) (math.Int, error)
+	Jail(ctx context.Context, consAddr sdk.ConsAddress) error
+	Unjail(ctx context.Context, consAddr sdk.ConsAddress) error
+	ValidatorAddressCodec() address.Codec
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, pending []PendingValidator) *GenesisState {
+	return &GenesisState{
+		Params:            params,
+		PendingValidators: pending,
+	}
+}
+
+// DefaultGenesis returns the default genesis state.
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(DefaultParams(), []PendingValidator{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.PendingValidators))
+	for _, pv := range gs.PendingValidators {
+		if err := pv.Validate(); err != nil {
+			return err
+		}
+		if seen[pv.OperatorAddress] {
+			return fmt.Errorf("duplicate pending validator %s", pv.OperatorAddress)
+		}
+		seen[pv.OperatorAddress] = true
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for i := range gs.PendingValidators {
+		if err := gs.PendingValidators[i].UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "poa"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	// ParamsKey saves the current module params.
+	ParamsKey = collections.NewPrefix(0)
+
+	// PendingValidatorsKey saves the validator applications awaiting approval.
+	PendingValidatorsKey = collections.NewPrefix(1)
+)
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateValidator{}
+	_ sdk.Msg = &MsgSetPower{}
+	_ sdk.Msg = &MsgRemoveValidator{}
+	_ sdk.Msg = &MsgRemovePending{}
+	_ sdk.Msg = &MsgUpdateParams{}
+
+	_ codectypes.UnpackInterfacesMessage = (*MsgCreateValidator)(nil)
+)
+
+// NewMsgCreateValidator creates a new MsgCreateValidator instance.
+func NewMsgCreateValidator(
+	valAddr string, pubKey cryptotypes.PubKey, description stakingtypes.Description, commission stakingtypes.CommissionRates,
+) (*MsgCreateValidator, error) {
+	var pkAny *codectypes.Any
+	if pubKey != nil {
+		var err error
+		if pkAny, err = codectypes.NewAnyWithValue(pubKey); err != nil {
+			return nil, err
+		}
+	}
+	return &MsgCreateValidator{
+		ValidatorAddress: valAddr,
+		Pubkey:           pkAny,
+		Description:      description,
+		Commission:       commission,
+	}, nil
+}
+
+// Validate performs a stateless check of the validator application.
+func (msg MsgCreateValidator) Validate() error {
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+	if msg.Pubkey == nil {
+		return stakingtypes.ErrEmptyValidatorPubKey
+	}
+	if msg.Description == (stakingtypes.Description{}) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty description")
+	}
+	return msg.Commission.Validate()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgCreateValidator) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.Pubkey, &pubKey)
+}
+
+// Validate performs a stateless check of the MsgSetPower.
+func (msg MsgSetPower) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+	if msg.Power == 0 {
+		return errors.New("power must be positive, use MsgRemoveValidator to remove a validator")
+	}
+	return nil
+}
+
+// Validate performs a stateless check of the MsgRemoveValidator.
+func (msg MsgRemoveValidator) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+	return nil
+}
+
+// Validate performs a stateless check of the MsgRemovePending.
+func (msg MsgRemovePending) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+	return nil
+}
+
+// Validate performs a stateless check of the MsgUpdateParams.
+func (msg MsgUpdateParams) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	return msg.Params.Validate()
+}
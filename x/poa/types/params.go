@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultParams returns default module parameters. No admins are set, which
+// leaves the validator set to regular staking.
+func DefaultParams() Params {
+	return Params{
+		Admins: []string{},
+	}
+}
+
+// NewParams creates a new Params instance.
+func NewParams(admins []string) Params {
+	return Params{
+		Admins: admins,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	seen := make(map[string]bool, len(p.Admins))
+	for _, admin := range p.Admins {
+		if _, err := sdk.AccAddressFromBech32(admin); err != nil {
+			return fmt.Errorf("invalid admin address %s: %w", admin, err)
+		}
+		if seen[admin] {
+			return fmt.Errorf("duplicate admin address %s", admin)
+		}
+		seen[admin] = true
+	}
+
+	return nil
+}
+
+// Enabled returns true when at least one admin is configured.
+func (p Params) Enabled() bool {
+	return len(p.Admins) > 0
+}
+
+// IsAdmin returns true if the address is one of the module admins.
+func (p Params) IsAdmin(addr string) bool {
+	for _, admin := range p.Admins {
+		if admin == addr {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,688 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: flora/poa/v1/poa.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types1 "github.com/cosmos/cosmos-sdk/x/staking/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the poa module.
+type Params struct {
+	// admins are the accounts allowed to approve, remove and set the power of
+	// validators. An empty list disables the proof-of-authority mode and leaves
+	// the validator set to regular staking.
+	Admins []string `protobuf:"bytes,1,rep,name=admins,proto3" json:"admins,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41cd9e8112bcba46, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetAdmins() []string {
+	if m != nil {
+		return m.Admins
+	}
+	return nil
+}
+
+// PendingValidator is a validator application waiting for an admin to set its
+// power.
+type PendingValidator struct {
+	// operator_address is the validator operator address.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// consensus_pubkey is the consensus public key of the validator.
+	ConsensusPubkey *types.Any `protobuf:"bytes,2,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
+	// description defines the description terms for the validator.
+	Description types1.Description `protobuf:"bytes,3,opt,name=description,proto3" json:"description"`
+	// commission defines the commission rates the validator will be created
+	// with.
+	Commission types1.CommissionRates `protobuf:"bytes,4,opt,name=commission,proto3" json:"commission"`
+}
+
+func (m *PendingValidator) Reset()         { *m = PendingValidator{} }
+func (m *PendingValidator) String() string { return proto.CompactTextString(m) }
+func (*PendingValidator) ProtoMessage()    {}
+func (*PendingValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41cd9e8112bcba46, []int{1}
+}
+func (m *PendingValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingValidator.Merge(m, src)
+}
+func (m *PendingValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingValidator proto.InternalMessageInfo
+
+func (m *PendingValidator) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *PendingValidator) GetConsensusPubkey() *types.Any {
+	if m != nil {
+		return m.ConsensusPubkey
+	}
+	return nil
+}
+
+func (m *PendingValidator) GetDescription() types1.Description {
+	if m != nil {
+		return m.Description
+	}
+	return types1.Description{}
+}
+
+func (m *PendingValidator) GetCommission() types1.CommissionRates {
+	if m != nil {
+		return m.Commission
+	}
+	return types1.CommissionRates{}
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "flora.poa.v1.Params")
+	proto.RegisterType((*PendingValidator)(nil), "flora.poa.v1.PendingValidator")
+}
+
+func init() { proto.RegisterFile("flora/poa/v1/poa.proto", fileDescriptor_41cd9e8112bcba46) }
+
+var fileDescriptor_41cd9e8112bcba46 = []byte{
+	// 446 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0xe3, 0x16, 0x45, 0xca, 0x15, 0xa9, 0xc1, 0x8a, 0xc0, 0x54, 0xc2, 0x84, 0x82, 0xd4,
+	0xa8, 0x52, 0xef, 0x08, 0x6c, 0x6c, 0x31, 0x6c, 0x30, 0x58, 0xae, 0x84, 0x04, 0x4b, 0x74, 0xb6,
+	0xaf, 0xee, 0xa9, 0xf6, 0xbd, 0xd6, 0xdd, 0x39, 0xc2, 0x5f, 0x81, 0x89, 0x8f, 0xc1, 0xd8, 0xa1,
+	0x1f, 0xa2, 0x62, 0xaa, 0x3a, 0x31, 0x21, 0x48, 0x86, 0x7e, 0x0d, 0xe4, 0x3b, 0x3b, 0x09, 0x03,
+	0x8b, 0xfd, 0xfe, 0x79, 0xde, 0xdf, 0xab, 0xf7, 0x39, 0xf4, 0xf0, 0x2c, 0x07, 0x49, 0x49, 0x09,
+	0x94, 0x2c, 0xa6, 0xcd, 0x0f, 0x97, 0x12, 0x34, 0xb8, 0xf7, 0x4d, 0x1d, 0x37, 0x85, 0xc5, 0xf4,
+	0x60, 0x94, 0x41, 0x06, 0xa6, 0x41, 0x9a, 0xc8, 0x6a, 0x0e, 0x1e, 0x27, 0xa0, 0x0a, 0x50, 0x73,
+	0xdb, 0xb0, 0x49, 0xdb, 0x7a, 0x40, 0x0b, 0x2e, 0x80, 0x98, 0x6f, 0xa7, 0xce, 0x00, 0xb2, 0x9c,
+	0x11, 0x93, 0xc5, 0xd5, 0x19, 0xa1, 0xa2, 0x6e, 0x5b, 0x2f, 0xec, 0x2c, 0x51, 0x9a, 0x5e, 0x70,
+	0x91, 0x91, 0xc5, 0x34, 0x66, 0x9a, 0x4e, 0xbb, 0xdc, 0xaa, 0x0e, 0x4f, 0x51, 0x3f, 0xa4, 0x92,
+	0x16, 0xca, 0x7d, 0x89, 0xfa, 0x34, 0x2d, 0xb8, 0x50, 0x9e, 0x33, 0xde, 0x9d, 0x0c, 0x02, 0xef,
+	0xf6, 0xea, 0x64, 0xd4, 0xee, 0x9f, 0xa5, 0xa9, 0x64, 0x4a, 0x9d, 0x6a, 0xc9, 0x45, 0x16, 0xb5,
+	0xba, 0x37, 0x8f, 0xbe, 0xde, 0x5d, 0x1e, 0xbb, 0xf6, 0xd6, 0x2f, 0xe6, 0x5a, 0x8b, 0x3a, 0xfc,
+	0xb3, 0x83, 0x86, 0x21, 0x13, 0x29, 0x17, 0xd9, 0x47, 0x9a, 0xf3, 0x94, 0x6a, 0x90, 0xee, 0x07,
+	0x34, 0x84, 0x92, 0xc9, 0x26, 0x9e, 0x53, 0xcb, 0xf3, 0x9c, 0xb1, 0x33, 0x19, 0x04, 0xcf, 0x6e,
+	0xaf, 0x4e, 0x9e, 0xb4, 0x9b, 0xd6, 0xfa, 0x7f, 0x57, 0xee, 0x77, 0xa3, 0x6d, 0xd9, 0xfd, 0x84,
+	0x86, 0x09, 0x08, 0xc5, 0x84, 0xaa, 0xd4, 0xbc, 0xac, 0xe2, 0x0b, 0x56, 0x7b, 0x3b, 0x63, 0x67,
+	0xb2, 0xf7, 0x6a, 0x84, 0xad, 0x27, 0xb8, 0xf3, 0x04, 0xcf, 0x44, 0x1d, 0x78, 0x3f, 0x36, 0xd7,
+	0x24, 0xb2, 0x2e, 0x35, 0xe0, 0xb0, 0x8a, 0xdf, 0xb3, 0x3a, 0xda, 0x5f, 0x73, 0x42, 0x83, 0x71,
+	0x43, 0xb4, 0x97, 0x32, 0x95, 0x48, 0x5e, 0x6a, 0x0e, 0xc2, 0xdb, 0x35, 0xd4, 0xe7, 0xb8, 0x1d,
+	0xee, 0xec, 0x6b, 0xed, 0xc4, 0xef, 0x36, 0xd2, 0x60, 0x70, 0xfd, 0xeb, 0x69, 0xef, 0xfb, 0xdd,
+	0xe5, 0xb1, 0x13, 0x6d, 0x23, 0xdc, 0x08, 0xa1, 0x04, 0x8a, 0x82, 0x2b, 0xd5, 0x00, 0xef, 0x19,
+	0xe0, 0xd1, 0xff, 0x80, 0x6f, 0xd7, 0xca, 0x88, 0x6a, 0xa6, 0xb6, 0xa1, 0x5b, 0x94, 0x60, 0x76,
+	0xbd, 0xf4, 0x9d, 0x9b, 0xa5, 0xef, 0xfc, 0x5e, 0xfa, 0xce, 0xb7, 0x95, 0xdf, 0xbb, 0x59, 0xf9,
+	0xbd, 0x9f, 0x2b, 0xbf, 0xf7, 0xf9, 0x28, 0xe3, 0xfa, 0xbc, 0x8a, 0x71, 0x02, 0x05, 0x91, 0x90,
+	0xe7, 0xc9, 0x39, 0xe5, 0x42, 0x91, 0xed, 0x77, 0xd2, 0x75, 0xc9, 0x54, 0xdc, 0x37, 0x0e, 0xbd,
+	0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x00, 0x5d, 0x2a, 0x80, 0xaf, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Admins) > 0 {
+		for iNdEx := len(m.Admins) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Admins[iNdEx])
+			copy(dAtA[i:], m.Admins[iNdEx])
+			i = encodeVarintPoa(dAtA, i, uint64(len(m.Admins[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPoa(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPoa(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ConsensusPubkey != nil {
+		{
+			size, err := m.ConsensusPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPoa(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintPoa(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPoa(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPoa(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Admins) > 0 {
+		for _, s := range m.Admins {
+			l = len(s)
+			n += 1 + l + sovPoa(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovPoa(uint64(l))
+	}
+	if m.ConsensusPubkey != nil {
+		l = m.ConsensusPubkey.Size()
+		n += 1 + l + sovPoa(uint64(l))
+	}
+	l = m.Description.Size()
+	n += 1 + l + sovPoa(uint64(l))
+	l = m.Commission.Size()
+	n += 1 + l + sovPoa(uint64(l))
+	return n
+}
+
+func sovPoa(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPoa(x uint64) (n int) {
+	return sovPoa(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPoa
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admins", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPoa
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoa
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admins = append(m.Admins, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPoa(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPoa
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPoa
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPoa
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoa
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPoa
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoa
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusPubkey == nil {
+				m.ConsensusPubkey = &types.Any{}
+			}
+			if err := m.ConsensusPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPoa
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoa
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPoa
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoa
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPoa(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPoa
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPoa(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPoa
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPoa
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPoa
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPoa
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPoa
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPoa
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPoa        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPoa          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPoa = fmt.Errorf("proto: unexpected end of group")
+)
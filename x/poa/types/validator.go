@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewPendingValidator creates a pending validator from a validator application.
+func NewPendingValidator(msg *MsgCreateValidator) PendingValidator {
+	return PendingValidator{
+		OperatorAddress: msg.ValidatorAddress,
+		ConsensusPubkey: msg.Pubkey,
+		Description:     msg.Description,
+		Commission:      msg.Commission,
+	}
+}
+
+// Validate performs a stateless check of the pending validator.
+func (pv PendingValidator) Validate() error {
+	if _, err := sdk.ValAddressFromBech32(pv.OperatorAddress); err != nil {
+		return fmt.Errorf("invalid operator address %s: %w", pv.OperatorAddress, err)
+	}
+	if pv.ConsensusPubkey == nil {
+		return fmt.Errorf("missing consensus pubkey for %s", pv.OperatorAddress)
+	}
+	if _, err := pv.Description.EnsureLength(); err != nil {
+		return err
+	}
+	return pv.Commission.Validate()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (pv PendingValidator) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pk cryptotypes.PubKey
+	return unpacker.UnpackAny(pv.ConsensusPubkey, &pk)
+}
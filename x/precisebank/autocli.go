@@ -0,0 +1,32 @@
+package precisebank
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "flora.precisebank.v1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Remainder",
+					Use:       "remainder",
+					Short:     "Query the remainder of the reserve held by no account",
+				},
+				{
+					RpcMethod:      "FractionalBalance",
+					Use:            "fractional-balance [address]",
+					Short:          "Query the fractional balance of an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "TotalFractionalBalances",
+					Use:       "total-fractional-balances",
+					Short:     "Query the sum of the fractional balances",
+				},
+			},
+		},
+	}
+}
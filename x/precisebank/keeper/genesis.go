@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/rollchains/flora/x/precisebank/types"
+)
+
+// InitGenesis initializes the module state from a genesis state. It must run
+// after x/bank, as the reserve balance is checked to back the fractional
+// balances. It isn't a method of the keeper, which embeds the x/bank one.
+func InitGenesis(ctx context.Context, k Keeper, gs *types.GenesisState) error {
+	// the reserve module account is created so that it holds its balance
+	k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+
+	for _, b := range gs.Balances {
+		addr, err := sdk.AccAddressFromBech32(b.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.SetFractionalBalance(ctx, addr, b.Amount); err != nil {
+			return err
+		}
+	}
+
+	if err := k.SetRemainder(ctx, gs.Remainder); err != nil {
+		return err
+	}
+
+	return k.checkReserve(sdk.UnwrapSDKContext(ctx))
+}
+
+// ExportGenesis exports the module state to a genesis state.
+func ExportGenesis(ctx context.Context, k Keeper) (*types.GenesisState, error) {
+	var balances []types.FractionalBalance
+	err := k.FractionalBalances.Walk(ctx, nil, func(addr sdk.AccAddress, amount sdkmath.Int) (bool, error) {
+		balances = append(balances, types.NewFractionalBalance(addr.String(), amount))
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	remainder, err := k.GetRemainder(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(balances, remainder), nil
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/rollchains/flora/x/precisebank/types"
+)
+
+// RegisterInvariants registers the precisebank module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "valid-fractional-balances", ValidFractionalBalancesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "valid-remainder", ValidRemainderInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "reserve-backs-fractions", ReserveBacksFractionsInvariant(k))
+}
+
+// ValidFractionalBalancesInvariant checks the fractional balances are
+// positive and less than one integer unit.
+func ValidFractionalBalancesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+		err := k.FractionalBalances.Walk(ctx, nil, func(addr sdk.AccAddress, amount sdkmath.Int) (bool, error) {
+			if err := types.ValidateFractionalAmount(amount); err != nil {
+				count++
+				msg += fmt.Sprintf("\t%s: %s\n", addr, err)
+			}
+			return false, nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "valid-fractional-balances", err.Error()), true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "valid-fractional-balances",
+			fmt.Sprintf("found %d invalid fractional balances\n%s", count, msg)), count != 0
+	}
+}
+
+// ValidRemainderInvariant checks the remainder is not negative and less than
+// one integer unit.
+func ValidRemainderInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		remainder, err := k.GetRemainder(ctx)
+		if err == nil {
+			err = types.ValidateRemainder(remainder)
+		}
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "valid-remainder", err.Error()), true
+		}
+		return sdk.FormatInvariant(types.ModuleName, "valid-remainder", "remainder is valid"), false
+	}
+}
+
+// ReserveBacksFractionsInvariant checks the integer balance of the reserve
+// times the conversion factor is the sum of the fractional balances and the
+// remainder.
+func ReserveBacksFractionsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if err := k.checkReserve(ctx); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "reserve-backs-fractions", err.Error()), true
+		}
+		return sdk.FormatInvariant(types.ModuleName, "reserve-backs-fractions", "reserve backs the fractional balances"), false
+	}
+}
+
+// checkReserve returns an error if the reserve doesn't back the fractional
+// balances and the remainder.
+func (k Keeper) checkReserve(ctx sdk.Context) error {
+	total, err := k.TotalFractionalBalances(ctx)
+	if err != nil {
+		return err
+	}
+	remainder, err := k.GetRemainder(ctx)
+	if err != nil {
+		return err
+	}
+
+	expected := total.Add(remainder)
+	if k.extendedDenom == "" {
+		if !expected.IsZero() {
+			return types.ErrNotConfigured.Wrapf("found fractional balances and remainder of %s", expected)
+		}
+		return nil
+	}
+
+	reserve := k.Keeper.GetBalance(ctx, k.reserveAddress(), k.integerDenom)
+	if backing := reserve.Amount.Mul(types.ConversionFactor()); !backing.Equal(expected) {
+		return types.ErrReserveMismatch.Wrapf("reserve balance %s backs %s%s, the fractional balances %s and the remainder %s sum to %s",
+			reserve, backing, k.extendedDenom, total, remainder, expected)
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	"github.com/rollchains/flora/x/precisebank/types"
+)
+
+// Keeper wraps the x/bank keeper to keep the balances of the extended denom,
+// the 18 decimals representation of the integer denom used by the EVM. An
+// extended balance is the integer balance in x/bank times the conversion
+// factor plus the fractional balance kept by the module. The integer units
+// backing the fractional balances are held by the reserve, the module
+// account.
+//
+// The other denoms are passed through to x/bank, as is every denom when no
+// extended denom is configured.
+type Keeper struct {
+	bankkeeper.Keeper
+
+	cdc           codec.BinaryCodec
+	accountKeeper types.AccountKeeper
+
+	integerDenom  string
+	extendedDenom string
+
+	Schema             collections.Schema
+	FractionalBalances collections.Map[sdk.AccAddress, sdkmath.Int]
+	Remainder          collections.Item[sdkmath.Int]
+}
+
+// NewKeeper creates a new precisebank Keeper instance. The extended denom is
+// empty on the chains whose denom has 18 decimals.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService storetypes.KVStoreService,
+	bankKeeper bankkeeper.Keeper,
+	accountKeeper types.AccountKeeper,
+	integerDenom string,
+	extendedDenom string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := Keeper{
+		Keeper:             bankKeeper,
+		cdc:                cdc,
+		accountKeeper:      accountKeeper,
+		integerDenom:       integerDenom,
+		extendedDenom:      extendedDenom,
+		FractionalBalances: collections.NewMap(sb, types.FractionalBalancePrefix, "fractional_balances", sdk.AccAddressKey, sdk.IntValue),
+		Remainder:          collections.NewItem(sb, types.RemainderKey, "remainder", sdk.IntValue),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return sdk.UnwrapSDKContext(ctx).Logger().With("module", "x/"+types.ModuleName)
+}
+
+// IntegerDenom returns the denom of the integer balances kept by x/bank.
+func (k Keeper) IntegerDenom() string {
+	return k.integerDenom
+}
+
+// ExtendedDenom returns the extended denom, empty when not configured.
+func (k Keeper) ExtendedDenom() string {
+	return k.extendedDenom
+}
+
+// isExtended returns true if the denom is the configured extended denom.
+func (k Keeper) isExtended(denom string) bool {
+	return k.extendedDenom != "" && denom == k.extendedDenom
+}
+
+// GetFractionalBalance returns the fractional balance of an account, zero when
+// not set.
+func (k Keeper) GetFractionalBalance(ctx context.Context, addr sdk.AccAddress) (sdkmath.Int, error) {
+	amount, err := k.FractionalBalances.Get(ctx, addr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return sdkmath.ZeroInt(), nil
+	}
+	return amount, err
+}
+
+// SetFractionalBalance sets the fractional balance of an account, removing it
+// when zero.
+func (k Keeper) SetFractionalBalance(ctx context.Context, addr sdk.AccAddress, amount sdkmath.Int) error {
+	if amount.IsZero() {
+		return k.FractionalBalances.Remove(ctx, addr)
+	}
+	if err := types.ValidateFractionalAmount(amount); err != nil {
+		return err
+	}
+	return k.FractionalBalances.Set(ctx, addr, amount)
+}
+
+// GetRemainder returns the remainder of the reserve, zero when not set.
+func (k Keeper) GetRemainder(ctx context.Context) (sdkmath.Int, error) {
+	remainder, err := k.Remainder.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return sdkmath.ZeroInt(), nil
+	}
+	return remainder, err
+}
+
+// SetRemainder sets the remainder of the reserve.
+func (k Keeper) SetRemainder(ctx context.Context, remainder sdkmath.Int) error {
+	if err := types.ValidateRemainder(remainder); err != nil {
+		return err
+	}
+	return k.Remainder.Set(ctx, remainder)
+}
+
+// TotalFractionalBalances returns the sum of the fractional balances.
+func (k Keeper) TotalFractionalBalances(ctx context.Context) (sdkmath.Int, error) {
+	total := sdkmath.ZeroInt()
+	err := k.FractionalBalances.Walk(ctx, nil, func(_ sdk.AccAddress, amount sdkmath.Int) (bool, error) {
+		total = total.Add(amount)
+		return false, nil
+	})
+	return total, err
+}
+
+// reserveAddress returns the address of the reserve module account.
+func (k Keeper) reserveAddress() sdk.AccAddress {
+	return k.accountKeeper.GetModuleAddress(types.ModuleName)
+}
+
+// splitCoins splits the coins into the coins passed through to x/bank and the
+// amount of the extended denom.
+func (k Keeper) splitCoins(coins sdk.Coins) (sdk.Coins, sdkmath.Int) {
+	extended := sdkmath.ZeroInt()
+	if k.extendedDenom == "" {
+		return coins, extended
+	}
+
+	passthrough := make(sdk.Coins, 0, len(coins))
+	for _, coin := range coins {
+		if coin.Denom == k.extendedDenom {
+			extended = coin.Amount
+			continue
+		}
+		passthrough = append(passthrough, coin)
+	}
+	return passthrough, extended
+}
+
+// integerCoins returns the coins of the amount of the integer denom.
+func (k Keeper) integerCoins(amount sdkmath.Int) sdk.Coins {
+	if !amount.IsPositive() {
+		return sdk.Coins{}
+	}
+	return sdk.NewCoins(sdk.NewCoin(k.integerDenom, amount))
+}
@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/rollchains/flora/x/precisebank/types"
+)
+
+// MintCoins mints the coins to the module account. The fractional part of the
+// extended amount is taken from the remainder of the reserve, minting an
+// integer unit to the reserve when the remainder isn't enough, and the module
+// carries an integer unit from the reserve when its fractional balance
+// overflows.
+func (k Keeper) MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error {
+	passthrough, extended := k.splitCoins(amt)
+	if extended.IsZero() {
+		return k.Keeper.MintCoins(ctx, moduleName, amt)
+	}
+	if !amt.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	moduleAddr, err := k.moduleAddressWithPermission(ctx, moduleName, authtypes.Minter)
+	if err != nil {
+		return err
+	}
+
+	cf := types.ConversionFactor()
+	integer, fractional := extended.Quo(cf), extended.Mod(cf)
+
+	remainder, err := k.GetRemainder(ctx)
+	if err != nil {
+		return err
+	}
+	moduleFractional, err := k.GetFractionalBalance(ctx, moduleAddr)
+	if err != nil {
+		return err
+	}
+
+	remainder = remainder.Sub(fractional)
+	reserveMint := remainder.IsNegative()
+	if reserveMint {
+		remainder = remainder.Add(cf)
+	}
+	moduleFractional = moduleFractional.Add(fractional)
+	carry := moduleFractional.GTE(cf)
+	if carry {
+		moduleFractional = moduleFractional.Sub(cf)
+	}
+
+	// the unit minted to the reserve is the one carried by the module
+	if reserveMint && carry {
+		integer = integer.AddRaw(1)
+	}
+
+	if mint := passthrough.Add(k.integerCoins(integer)...); !mint.IsZero() {
+		if err := k.Keeper.MintCoins(ctx, moduleName, mint); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case reserveMint && !carry:
+		if err := k.Keeper.MintCoins(ctx, types.ModuleName, k.integerCoins(sdkmath.OneInt())); err != nil {
+			return err
+		}
+	case carry && !reserveMint:
+		if err := k.Keeper.SendCoins(ctx, k.reserveAddress(), moduleAddr, k.integerCoins(sdkmath.OneInt())); err != nil {
+			return err
+		}
+	}
+
+	if err := k.SetRemainder(ctx, remainder); err != nil {
+		return err
+	}
+	if err := k.SetFractionalBalance(ctx, moduleAddr, moduleFractional); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(k.extendedDenom, extended))
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvents(sdk.Events{
+		banktypes.NewCoinMintEvent(moduleAddr, coins),
+		banktypes.NewCoinReceivedEvent(moduleAddr, coins),
+	})
+
+	return nil
+}
+
+// BurnCoins burns the coins of the module account. The fractional part of the
+// extended amount is added to the remainder of the reserve, burning an integer
+// unit of the reserve when the remainder overflows, and the module borrows an
+// integer unit to the reserve when its fractional balance isn't enough.
+func (k Keeper) BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error {
+	passthrough, extended := k.splitCoins(amt)
+	if extended.IsZero() {
+		return k.Keeper.BurnCoins(ctx, moduleName, amt)
+	}
+	if !amt.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	moduleAddr, err := k.moduleAddressWithPermission(ctx, moduleName, authtypes.Burner)
+	if err != nil {
+		return err
+	}
+	if err := k.checkSpendable(ctx, moduleAddr, extended); err != nil {
+		return err
+	}
+
+	cf := types.ConversionFactor()
+	integer, fractional := extended.Quo(cf), extended.Mod(cf)
+
+	remainder, err := k.GetRemainder(ctx)
+	if err != nil {
+		return err
+	}
+	moduleFractional, err := k.GetFractionalBalance(ctx, moduleAddr)
+	if err != nil {
+		return err
+	}
+
+	moduleFractional = moduleFractional.Sub(fractional)
+	borrow := moduleFractional.IsNegative()
+	if borrow {
+		moduleFractional = moduleFractional.Add(cf)
+	}
+	remainder = remainder.Add(fractional)
+	reserveBurn := remainder.GTE(cf)
+	if reserveBurn {
+		remainder = remainder.Sub(cf)
+	}
+
+	// the unit borrowed by the module is the one burned from the reserve
+	if borrow && reserveBurn {
+		integer = integer.AddRaw(1)
+	}
+
+	if burn := passthrough.Add(k.integerCoins(integer)...); !burn.IsZero() {
+		if err := k.Keeper.BurnCoins(ctx, moduleName, burn); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case borrow && !reserveBurn:
+		if err := k.Keeper.SendCoins(ctx, moduleAddr, k.reserveAddress(), k.integerCoins(sdkmath.OneInt())); err != nil {
+			return err
+		}
+	case reserveBurn && !borrow:
+		if err := k.Keeper.BurnCoins(ctx, types.ModuleName, k.integerCoins(sdkmath.OneInt())); err != nil {
+			return err
+		}
+	}
+
+	if err := k.SetRemainder(ctx, remainder); err != nil {
+		return err
+	}
+	if err := k.SetFractionalBalance(ctx, moduleAddr, moduleFractional); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(k.extendedDenom, extended))
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvents(sdk.Events{
+		banktypes.NewCoinSpentEvent(moduleAddr, coins),
+		banktypes.NewCoinBurnEvent(moduleAddr, coins),
+	})
+
+	return nil
+}
+
+// moduleAddressWithPermission returns the address of the module account,
+// checking it has the permission.
+func (k Keeper) moduleAddressWithPermission(ctx context.Context, moduleName, permission string) (sdk.AccAddress, error) {
+	acc := k.accountKeeper.GetModuleAccount(ctx, moduleName)
+	if acc == nil {
+		panic(errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", moduleName))
+	}
+	if !acc.HasPermission(permission) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "module account %s does not have permissions to %s tokens", moduleName, permission)
+	}
+	return acc.GetAddress(), nil
+}
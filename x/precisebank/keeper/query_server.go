@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/rollchains/flora/x/precisebank/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier implements the module gRPC query service.
+type Querier struct {
+	Keeper
+}
+
+// NewQuerier returns an implementation of the module QueryServer interface.
+func NewQuerier(keeper Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Remainder implements types.QueryServer.
+func (q Querier) Remainder(ctx context.Context, _ *types.QueryRemainderRequest) (*types.QueryRemainderResponse, error) {
+	if q.extendedDenom == "" {
+		return nil, types.ErrNotConfigured
+	}
+
+	remainder, err := q.GetRemainder(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRemainderResponse{Remainder: sdk.NewCoin(q.extendedDenom, remainder)}, nil
+}
+
+// FractionalBalance implements types.QueryServer.
+func (q Querier) FractionalBalance(ctx context.Context, req *types.QueryFractionalBalanceRequest) (*types.QueryFractionalBalanceResponse, error) {
+	if q.extendedDenom == "" {
+		return nil, types.ErrNotConfigured
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := q.GetFractionalBalance(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFractionalBalanceResponse{FractionalBalance: sdk.NewCoin(q.extendedDenom, amount)}, nil
+}
+
+// TotalFractionalBalances implements types.QueryServer.
+func (q Querier) TotalFractionalBalances(ctx context.Context, _ *types.QueryTotalFractionalBalancesRequest) (*types.QueryTotalFractionalBalancesResponse, error) {
+	if q.extendedDenom == "" {
+		return nil, types.ErrNotConfigured
+	}
+
+	total, err := q.Keeper.TotalFractionalBalances(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTotalFractionalBalancesResponse{Total: sdk.NewCoin(q.extendedDenom, total)}, nil
+}
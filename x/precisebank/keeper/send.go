@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/rollchains/flora/x/precisebank/types"
+)
+
+// SendCoins transfers the coins between the accounts. The extended amount is
+// moved as whole integer units in x/bank and fractional balances: the sender
+// borrows an integer unit from its balance when its fractional balance isn't
+// enough and the recipient carries an integer unit when its fractional balance
+// overflows, exchanged with the reserve.
+func (k Keeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	passthrough, extended := k.splitCoins(amt)
+	if extended.IsZero() {
+		return k.Keeper.SendCoins(ctx, fromAddr, toAddr, amt)
+	}
+	if !amt.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	if err := k.checkSpendable(ctx, fromAddr, extended); err != nil {
+		return err
+	}
+	if fromAddr.Equals(toAddr) {
+		return k.Keeper.SendCoins(ctx, fromAddr, toAddr, passthrough)
+	}
+
+	cf := types.ConversionFactor()
+	integer, fractional := extended.Quo(cf), extended.Mod(cf)
+
+	senderFractional, err := k.GetFractionalBalance(ctx, fromAddr)
+	if err != nil {
+		return err
+	}
+	recipientFractional, err := k.GetFractionalBalance(ctx, toAddr)
+	if err != nil {
+		return err
+	}
+
+	senderFractional = senderFractional.Sub(fractional)
+	borrow := senderFractional.IsNegative()
+	if borrow {
+		senderFractional = senderFractional.Add(cf)
+	}
+	recipientFractional = recipientFractional.Add(fractional)
+	carry := recipientFractional.GTE(cf)
+	if carry {
+		recipientFractional = recipientFractional.Sub(cf)
+	}
+
+	// the unit borrowed by the sender is the one carried by the recipient
+	if borrow && carry {
+		integer = integer.AddRaw(1)
+	}
+
+	// x/bank applies the send restrictions even when only fractional amounts
+	// are moved
+	if err := k.Keeper.SendCoins(ctx, fromAddr, toAddr, passthrough.Add(k.integerCoins(integer)...)); err != nil {
+		return err
+	}
+
+	switch {
+	case borrow && !carry:
+		if err := k.Keeper.SendCoins(ctx, fromAddr, k.reserveAddress(), k.integerCoins(sdkmath.OneInt())); err != nil {
+			return err
+		}
+	case carry && !borrow:
+		if err := k.Keeper.SendCoins(ctx, k.reserveAddress(), toAddr, k.integerCoins(sdkmath.OneInt())); err != nil {
+			return err
+		}
+	}
+
+	if err := k.SetFractionalBalance(ctx, fromAddr, senderFractional); err != nil {
+		return err
+	}
+	if err := k.SetFractionalBalance(ctx, toAddr, recipientFractional); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(k.extendedDenom, extended))
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvents(sdk.Events{
+		banktypes.NewCoinSpentEvent(fromAddr, coins),
+		banktypes.NewCoinReceivedEvent(toAddr, coins),
+		sdk.NewEvent(
+			banktypes.EventTypeTransfer,
+			sdk.NewAttribute(banktypes.AttributeKeyRecipient, toAddr.String()),
+			sdk.NewAttribute(banktypes.AttributeKeySender, fromAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+		),
+	})
+
+	return nil
+}
+
+// SendCoinsFromAccountToModule transfers the coins from an account to a module
+// account.
+func (k Keeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if _, extended := k.splitCoins(amt); extended.IsZero() {
+		return k.Keeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+	}
+
+	recipientAcc := k.accountKeeper.GetModuleAccount(ctx, recipientModule)
+	if recipientAcc == nil {
+		panic(errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipientModule))
+	}
+	return k.SendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
+}
+
+// SendCoinsFromModuleToAccount transfers the coins from a module account to an
+// account.
+func (k Keeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if _, extended := k.splitCoins(amt); extended.IsZero() {
+		return k.Keeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+	}
+
+	senderAddr := k.accountKeeper.GetModuleAddress(senderModule)
+	if senderAddr == nil {
+		panic(errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", senderModule))
+	}
+	if k.BlockedAddr(recipientAddr) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipientAddr)
+	}
+	return k.SendCoins(ctx, senderAddr, recipientAddr, amt)
+}
+
+// SendCoinsFromModuleToModule transfers the coins between module accounts.
+func (k Keeper) SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	if _, extended := k.splitCoins(amt); extended.IsZero() {
+		return k.Keeper.SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt)
+	}
+
+	senderAddr := k.accountKeeper.GetModuleAddress(senderModule)
+	if senderAddr == nil {
+		panic(errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", senderModule))
+	}
+	recipientAcc := k.accountKeeper.GetModuleAccount(ctx, recipientModule)
+	if recipientAcc == nil {
+		panic(errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipientModule))
+	}
+	return k.SendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
+}
+
+// checkSpendable returns an error if the extended spendable balance of the
+// account is less than the amount.
+func (k Keeper) checkSpendable(ctx context.Context, addr sdk.AccAddress, amount sdkmath.Int) error {
+	spendable := k.SpendableCoin(ctx, addr, k.extendedDenom)
+	if spendable.Amount.LT(amount) {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "spendable balance %s is smaller than %s", spendable, sdk.NewCoin(k.extendedDenom, amount))
+	}
+	return nil
+}
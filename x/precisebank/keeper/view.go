@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/rollchains/flora/x/precisebank/types"
+)
+
+// GetBalance returns the balance of the account, the extended balance for the
+// extended denom.
+func (k Keeper) GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	if !k.isExtended(denom) {
+		return k.Keeper.GetBalance(ctx, addr, denom)
+	}
+
+	integer := k.Keeper.GetBalance(ctx, addr, k.integerDenom)
+	return k.extendedCoin(ctx, addr, integer)
+}
+
+// SpendableCoin returns the spendable balance of the account, the extended
+// spendable balance for the extended denom.
+func (k Keeper) SpendableCoin(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	if !k.isExtended(denom) {
+		return k.Keeper.SpendableCoin(ctx, addr, denom)
+	}
+
+	integer := k.Keeper.SpendableCoin(ctx, addr, k.integerDenom)
+	return k.extendedCoin(ctx, addr, integer)
+}
+
+// GetSupply returns the supply of the denom. The supply of the extended denom
+// is the integer supply less the remainder of the reserve, as the remainder is
+// backed by the reserve but held by no account.
+func (k Keeper) GetSupply(ctx context.Context, denom string) sdk.Coin {
+	if !k.isExtended(denom) {
+		return k.Keeper.GetSupply(ctx, denom)
+	}
+
+	remainder, err := k.GetRemainder(ctx)
+	if err != nil {
+		panic(err)
+	}
+	integer := k.Keeper.GetSupply(ctx, k.integerDenom)
+	return sdk.NewCoin(k.extendedDenom, integer.Amount.Mul(types.ConversionFactor()).Sub(remainder))
+}
+
+// IsSendEnabledCoins checks the coins can be sent, the extended denom when its
+// integer denom can.
+func (k Keeper) IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error {
+	converted := make([]sdk.Coin, len(coins))
+	for i, coin := range coins {
+		if k.isExtended(coin.Denom) {
+			coin = sdk.NewCoin(k.integerDenom, coin.Amount)
+		}
+		converted[i] = coin
+	}
+	return k.Keeper.IsSendEnabledCoins(ctx, converted...)
+}
+
+// extendedCoin returns the extended balance of the account with the integer
+// balance.
+func (k Keeper) extendedCoin(ctx context.Context, addr sdk.AccAddress, integer sdk.Coin) sdk.Coin {
+	fractional, err := k.GetFractionalBalance(ctx, addr)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.NewCoin(k.extendedDenom, integer.Amount.Mul(types.ConversionFactor()).Add(fractional))
+}
@@ -0,0 +1,10 @@
+package types
+
+import "cosmossdk.io/errors"
+
+var (
+	ErrInvalidFractionalBalance = errors.Register(ModuleName, 2, "invalid fractional balance")
+	ErrInvalidRemainder         = errors.Register(ModuleName, 3, "invalid remainder")
+	ErrReserveMismatch          = errors.Register(ModuleName, 4, "reserve doesn't back the fractional balances")
+	ErrNotConfigured            = errors.Register(ModuleName, 5, "extended denom is not configured")
+)
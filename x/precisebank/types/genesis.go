@@ -0,0 +1,94 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(balances []FractionalBalance, remainder sdkmath.Int) *GenesisState {
+	return &GenesisState{
+		Balances:  balances,
+		Remainder: remainder,
+	}
+}
+
+// DefaultGenesis returns the default genesis state.
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(nil, sdkmath.ZeroInt())
+}
+
+// NewFractionalBalance creates a new fractional balance.
+func NewFractionalBalance(address string, amount sdkmath.Int) FractionalBalance {
+	return FractionalBalance{
+		Address: address,
+		Amount:  amount,
+	}
+}
+
+// ValidateFractionalAmount checks an amount is a valid fractional balance,
+// positive and less than one integer unit.
+func ValidateFractionalAmount(amount sdkmath.Int) error {
+	if amount.IsNil() || !amount.IsPositive() {
+		return ErrInvalidFractionalBalance.Wrapf("%s must be positive", amount)
+	}
+	if amount.GTE(ConversionFactor()) {
+		return ErrInvalidFractionalBalance.Wrapf("%s must be less than %s", amount, ConversionFactor())
+	}
+	return nil
+}
+
+// ValidateRemainder checks an amount is a valid remainder, not negative and
+// less than one integer unit.
+func ValidateRemainder(remainder sdkmath.Int) error {
+	if remainder.IsNil() || remainder.IsNegative() {
+		return ErrInvalidRemainder.Wrapf("%s cannot be negative", remainder)
+	}
+	if remainder.GTE(ConversionFactor()) {
+		return ErrInvalidRemainder.Wrapf("%s must be less than %s", remainder, ConversionFactor())
+	}
+	return nil
+}
+
+// TotalAmount returns the sum of the fractional balances.
+func (gs GenesisState) TotalAmount() sdkmath.Int {
+	total := sdkmath.ZeroInt()
+	for _, b := range gs.Balances {
+		total = total.Add(b.Amount)
+	}
+	return total
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool, len(gs.Balances))
+	for _, b := range gs.Balances {
+		addr, err := sdk.AccAddressFromBech32(b.Address)
+		if err != nil {
+			return fmt.Errorf("invalid address %s: %w", b.Address, err)
+		}
+		if seen[string(addr)] {
+			return fmt.Errorf("duplicate fractional balance of %s", b.Address)
+		}
+		seen[string(addr)] = true
+
+		if err := ValidateFractionalAmount(b.Amount); err != nil {
+			return fmt.Errorf("fractional balance of %s: %w", b.Address, err)
+		}
+	}
+
+	if err := ValidateRemainder(gs.Remainder); err != nil {
+		return err
+	}
+
+	// the reserve holds whole integer units
+	if total := gs.TotalAmount().Add(gs.Remainder); !total.Mod(ConversionFactor()).IsZero() {
+		return ErrReserveMismatch.Wrapf("sum %s of the fractional balances and the remainder is not a multiple of %s", total, ConversionFactor())
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+)
+
+const (
+	// ModuleName defines the module name, also the name of the reserve module
+	// account holding the integer units backing the fractional balances.
+	ModuleName = "precisebank"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	// FractionalBalancePrefix saves the fractional balances of the accounts.
+	FractionalBalancePrefix = collections.NewPrefix(0)
+	// RemainderKey saves the remainder of the reserve.
+	RemainderKey = collections.NewPrefix(1)
+)
+
+// conversionFactor is the amount of the extended denom in one unit of the
+// integer denom, between the 6 decimals of the Cosmos denom and the 18 of the
+// EVM.
+var conversionFactor = sdkmath.NewIntWithDecimal(1, 12)
+
+// ConversionFactor returns the amount of the extended denom in one unit of the
+// integer denom.
+func ConversionFactor() sdkmath.Int {
+	return conversionFactor
+}
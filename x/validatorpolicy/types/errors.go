@@ -0,0 +1,10 @@
+package types
+
+import "cosmossdk.io/errors"
+
+var (
+	ErrCommissionBelowFloor       = errors.Register(ModuleName, 2, "commission rate is below the minimum")
+	ErrCommissionChangeTooLarge   = errors.Register(ModuleName, 3, "commission change rate is above the maximum")
+	ErrMinSelfDelegationTooLow    = errors.Register(ModuleName, 4, "min self delegation is below the minimum")
+	ErrInvalidStakingPrecompileTx = errors.Register(ModuleName, 5, "invalid staking precompile call")
+)
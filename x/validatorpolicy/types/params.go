@@ -0,0 +1,80 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	// DefaultMinCommissionRate is the default commission floor of 5%.
+	DefaultMinCommissionRate = math.LegacyNewDecWithPrec(5, 2)
+	// DefaultMaxCommissionChangeRate is the default max commission change of 1%.
+	DefaultMaxCommissionChangeRate = math.LegacyNewDecWithPrec(1, 2)
+	// DefaultMinSelfDelegation matches the lowest value accepted by x/staking.
+	DefaultMinSelfDelegation = math.OneInt()
+)
+
+// DefaultParams returns default module parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultMinCommissionRate, DefaultMaxCommissionChangeRate, DefaultMinSelfDelegation)
+}
+
+// NewParams creates a new Params instance.
+func NewParams(minCommissionRate, maxCommissionChangeRate math.LegacyDec, minSelfDelegation math.Int) Params {
+	return Params{
+		MinCommissionRate:       minCommissionRate,
+		MaxCommissionChangeRate: maxCommissionChangeRate,
+		MinSelfDelegation:       minSelfDelegation,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if p.MinCommissionRate.IsNil() || p.MinCommissionRate.IsNegative() || p.MinCommissionRate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("min commission rate must be between 0 and 1: %s", p.MinCommissionRate)
+	}
+	if p.MaxCommissionChangeRate.IsNil() || !p.MaxCommissionChangeRate.IsPositive() || p.MaxCommissionChangeRate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max commission change rate must be positive and at most 1: %s", p.MaxCommissionChangeRate)
+	}
+	if p.MinSelfDelegation.IsNil() || !p.MinSelfDelegation.IsPositive() {
+		return fmt.Errorf("min self delegation must be positive: %s", p.MinSelfDelegation)
+	}
+
+	return nil
+}
+
+// CheckCreateValidator returns an error if the new validator doesn't follow the
+// policy.
+func (p Params) CheckCreateValidator(msg *stakingtypes.MsgCreateValidator) error {
+	if msg.Commission.Rate.LT(p.MinCommissionRate) {
+		return ErrCommissionBelowFloor.Wrapf("%s < %s", msg.Commission.Rate, p.MinCommissionRate)
+	}
+	if msg.MinSelfDelegation.LT(p.MinSelfDelegation) {
+		return ErrMinSelfDelegationTooLow.Wrapf("%s < %s", msg.MinSelfDelegation, p.MinSelfDelegation)
+	}
+
+	return nil
+}
+
+// CheckEditValidator returns an error if the edit of the validator doesn't
+// follow the policy.
+func (p Params) CheckEditValidator(msg *stakingtypes.MsgEditValidator, validator stakingtypes.Validator) error {
+	if msg.CommissionRate != nil {
+		if msg.CommissionRate.LT(p.MinCommissionRate) {
+			return ErrCommissionBelowFloor.Wrapf("%s < %s", msg.CommissionRate, p.MinCommissionRate)
+		}
+
+		change := msg.CommissionRate.Sub(validator.Commission.Rate).Abs()
+		if change.GT(p.MaxCommissionChangeRate) {
+			return ErrCommissionChangeTooLarge.Wrapf("%s > %s", change, p.MaxCommissionChangeRate)
+		}
+	}
+	if msg.MinSelfDelegation != nil && msg.MinSelfDelegation.LT(p.MinSelfDelegation) {
+		return ErrMinSelfDelegationTooLow.Wrapf("%s < %s", msg.MinSelfDelegation, p.MinSelfDelegation)
+	}
+
+	return nil
+}
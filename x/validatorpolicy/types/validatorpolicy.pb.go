@@ -0,0 +1,430 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: flora/validatorpolicy/v1/validatorpolicy.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the validatorpolicy module.
+type Params struct {
+	// min_commission_rate is the lowest commission rate a validator can be
+	// created with or edit its commission to.
+	MinCommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_commission_rate"`
+	// max_commission_change_rate caps the commission change of a single edit,
+	// on top of the max_change_rate of the validator.
+	MaxCommissionChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=max_commission_change_rate,json=maxCommissionChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission_change_rate"`
+	// min_self_delegation is the lowest min_self_delegation a validator can
+	// declare.
+	MinSelfDelegation cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=min_self_delegation,json=minSelfDelegation,proto3,customtype=cosmossdk.io/math.Int" json:"min_self_delegation"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_613665cc1c79dc2b, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Params)(nil), "flora.validatorpolicy.v1.Params")
+}
+
+func init() {
+	proto.RegisterFile("flora/validatorpolicy/v1/validatorpolicy.proto", fileDescriptor_613665cc1c79dc2b)
+}
+
+var fileDescriptor_613665cc1c79dc2b = []byte{
+	// 358 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x91, 0x41, 0x4b, 0x3a, 0x41,
+	0x18, 0xc6, 0x77, 0xfd, 0x83, 0xf0, 0xdf, 0x9b, 0x56, 0x64, 0x06, 0x6b, 0xd4, 0x25, 0x84, 0x76,
+	0x13, 0xa1, 0x43, 0x47, 0xf5, 0x22, 0x14, 0x84, 0xdd, 0xba, 0x6c, 0xaf, 0xe3, 0xec, 0xee, 0xd0,
+	0xcc, 0xbc, 0xb2, 0x33, 0x89, 0x7e, 0x85, 0x4e, 0x7d, 0x8c, 0x8e, 0x1e, 0xfa, 0x10, 0x1e, 0xa5,
+	0x53, 0x74, 0x90, 0xd0, 0x83, 0x97, 0x3e, 0x44, 0xb4, 0x23, 0x2a, 0x4a, 0xa7, 0x2e, 0xc3, 0xcc,
+	0xfb, 0x3c, 0x3c, 0x3f, 0xde, 0x79, 0x1c, 0x2f, 0xe4, 0x98, 0x80, 0xdf, 0x03, 0xce, 0x3a, 0xa0,
+	0x31, 0xe9, 0x22, 0x67, 0x64, 0xe0, 0xf7, 0x2a, 0x9b, 0x23, 0xaf, 0x9b, 0xa0, 0xc6, 0x7c, 0x21,
+	0xf5, 0x7b, 0x9b, 0x62, 0xaf, 0x52, 0xdc, 0x8d, 0x30, 0xc2, 0xd4, 0xe4, 0xff, 0xdc, 0x8c, 0xbf,
+	0x78, 0x40, 0x50, 0x09, 0x54, 0x81, 0x11, 0xcc, 0x63, 0x21, 0xe5, 0x40, 0x30, 0x89, 0x7e, 0x7a,
+	0x9a, 0xd1, 0xf1, 0x57, 0xc6, 0xc9, 0xde, 0x40, 0x02, 0x42, 0xe5, 0x43, 0x67, 0x47, 0x30, 0x19,
+	0x10, 0x14, 0x82, 0x29, 0xc5, 0x50, 0x06, 0x09, 0x68, 0x5a, 0xb0, 0x8f, 0xec, 0xd3, 0xff, 0xb5,
+	0x8b, 0xd1, 0xa4, 0x64, 0x7d, 0x4c, 0x4a, 0x87, 0x26, 0x50, 0x75, 0x1e, 0x3c, 0x86, 0xbe, 0x00,
+	0x1d, 0x7b, 0x57, 0x34, 0x02, 0x32, 0x68, 0x50, 0xf2, 0xf6, 0x7a, 0xe6, 0x2c, 0x78, 0x0d, 0x4a,
+	0x5e, 0xe6, 0xc3, 0xb2, 0xdd, 0xca, 0x09, 0x26, 0xeb, 0xcb, 0xc4, 0x16, 0x68, 0x9a, 0x57, 0x4e,
+	0x51, 0x40, 0x7f, 0x9d, 0x43, 0x62, 0x90, 0x11, 0x35, 0xb8, 0xcc, 0x9f, 0x70, 0xfb, 0x02, 0xfa,
+	0x2b, 0x5c, 0x3d, 0xcd, 0x4d, 0xa1, 0xf7, 0x66, 0x39, 0x45, 0x79, 0x18, 0x74, 0x28, 0xa7, 0x11,
+	0x68, 0x86, 0xb2, 0xf0, 0x2f, 0xa5, 0x9d, 0x2f, 0x68, 0x7b, 0xdb, 0xb4, 0xa6, 0xd4, 0x6b, 0x9c,
+	0xa6, 0xd4, 0xab, 0xb5, 0x6e, 0x29, 0x0f, 0x1b, 0xcb, 0xa8, 0xcb, 0x93, 0xa7, 0xf9, 0xb0, 0xec,
+	0x9a, 0x72, 0xfb, 0x5b, 0xf5, 0x9a, 0x3f, 0xae, 0x5d, 0x8f, 0xa6, 0xae, 0x3d, 0x9e, 0xba, 0xf6,
+	0xe7, 0xd4, 0xb5, 0x9f, 0x67, 0xae, 0x35, 0x9e, 0xb9, 0xd6, 0xfb, 0xcc, 0xb5, 0xee, 0xaa, 0x11,
+	0xd3, 0xf1, 0x63, 0xdb, 0x23, 0x28, 0xfc, 0x04, 0x39, 0x27, 0x31, 0x30, 0xa9, 0xfc, 0xdf, 0xf2,
+	0xf4, 0xa0, 0x4b, 0x55, 0x3b, 0x9b, 0x96, 0x58, 0xfd, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x96, 0x94,
+	0x92, 0x9a, 0x54, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinSelfDelegation.Size()
+		i -= size
+		if _, err := m.MinSelfDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintValidatorpolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxCommissionChangeRate.Size()
+		i -= size
+		if _, err := m.MaxCommissionChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintValidatorpolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MinCommissionRate.Size()
+		i -= size
+		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintValidatorpolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintValidatorpolicy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovValidatorpolicy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinCommissionRate.Size()
+	n += 1 + l + sovValidatorpolicy(uint64(l))
+	l = m.MaxCommissionChangeRate.Size()
+	n += 1 + l + sovValidatorpolicy(uint64(l))
+	l = m.MinSelfDelegation.Size()
+	n += 1 + l + sovValidatorpolicy(uint64(l))
+	return n
+}
+
+func sovValidatorpolicy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozValidatorpolicy(x uint64) (n int) {
+	return sovValidatorpolicy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorpolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorpolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorpolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommissionChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorpolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorpolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidatorpolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipValidatorpolicy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowValidatorpolicy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidatorpolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidatorpolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthValidatorpolicy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupValidatorpolicy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthValidatorpolicy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthValidatorpolicy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowValidatorpolicy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupValidatorpolicy = fmt.Errorf("proto: unexpected end of group")
+)